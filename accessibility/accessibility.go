@@ -0,0 +1,76 @@
+// Package accessibility holds global switches that make bubbles easier to use
+// for people who are sensitive to motion or color, and their output easier to
+// record, such as in CI.
+//
+// With reduced motion, bubbles skip their animations: progress bars jump to
+// their new percentage, spinners show a static glyph, carousels and
+// notification panels appear without sliding and cursors don't blink. With
+// no color, styles are rendered without colors, keeping bold, underline and
+// the like. No color is enabled from the start if the NO_COLOR environment
+// variable is set, as described at https://no-color.org, or CLICOLOR is set
+// to 0.
+//
+//	accessibility.SetReducedMotion(true)
+//	accessibility.SetNoColor(true)
+package accessibility
+
+import (
+	"sync"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+var (
+	mtx           sync.RWMutex
+	reducedMotion bool
+	noColor       = termenv.EnvNoColor()
+
+	// The color profile to restore when colors are enabled again.
+	colorProfile termenv.Profile = -1
+)
+
+// SetReducedMotion enables or disables reduced motion.
+func SetReducedMotion(v bool) {
+	mtx.Lock()
+	defer mtx.Unlock()
+	reducedMotion = v
+}
+
+// ReducedMotion returns whether animations should be skipped.
+func ReducedMotion() bool {
+	mtx.RLock()
+	defer mtx.RUnlock()
+	return reducedMotion
+}
+
+// SetNoColor enables or disables rendering without colors. It sets the color
+// profile of lipgloss, so it applies to all styles, not only to those of
+// bubbles.
+func SetNoColor(v bool) {
+	mtx.Lock()
+	defer mtx.Unlock()
+	if v == noColor {
+		return
+	}
+	noColor = v
+
+	if v {
+		colorProfile = lipgloss.ColorProfile()
+		lipgloss.SetColorProfile(termenv.Ascii)
+		return
+	}
+	if colorProfile < 0 || colorProfile == termenv.Ascii {
+		// Colors were disabled with NO_COLOR, which the detected profile
+		// reflects.
+		colorProfile = termenv.ColorProfile()
+	}
+	lipgloss.SetColorProfile(colorProfile)
+}
+
+// NoColor returns whether styles should be rendered without colors.
+func NoColor() bool {
+	mtx.RLock()
+	defer mtx.RUnlock()
+	return noColor
+}
@@ -0,0 +1,39 @@
+package accessibility
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+func TestNoColor(t *testing.T) {
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer SetNoColor(false)
+
+	style := lipgloss.NewStyle().Foreground(lipgloss.Color("#ff0000")).Bold(true)
+	colored := style.Render("x")
+
+	SetNoColor(true)
+	if !NoColor() {
+		t.Fatal("expected no color to be enabled")
+	}
+	if got := style.Render("x"); got == colored || strings.Contains(got, "38;") {
+		t.Fatalf("expected the style to be rendered without its color, got %q", got)
+	}
+
+	SetNoColor(false)
+	if got := style.Render("x"); got != colored {
+		t.Fatalf("expected colors to be restored, got %q", got)
+	}
+}
+
+func TestReducedMotion(t *testing.T) {
+	defer SetReducedMotion(false)
+
+	SetReducedMotion(true)
+	if !ReducedMotion() {
+		t.Fatal("expected reduced motion to be enabled")
+	}
+}
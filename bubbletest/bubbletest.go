@@ -0,0 +1,262 @@
+// Package bubbletest helps testing bubbles. A Harness drives a bubble with
+// key presses, mouse events and window sizes, runs the commands it returns
+// and feeds their messages back to it, the way a Bubble Tea program would.
+// Views are captured as plain text and can be compared with golden files:
+//
+//	func TestSearch(t *testing.T) {
+//	    h := bubbletest.New(t, list.New(items, list.NewDefaultDelegate(), 20, 10))
+//	    h.Press("/")
+//	    h.Type("foo")
+//	    h.Press("enter")
+//	    h.AssertView("search")
+//
+//	    m := h.Model().(list.Model)
+//	    // ...
+//	}
+//
+// Golden files are kept in testdata, named after the golden passed to
+// AssertView with a .golden extension. Run the tests with -update to write
+// them from the current views.
+package bubbletest
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/muesli/reflow/ansi"
+)
+
+const (
+	defaultTimeout = 10 * time.Millisecond
+	defaultMaxMsgs = 1000
+)
+
+var (
+	msgType = reflect.TypeOf((*tea.Msg)(nil)).Elem()
+	cmdType = reflect.TypeOf(tea.Cmd(nil))
+)
+
+// Harness drives a bubble in tests.
+type Harness struct {
+	// Timeout is how long a command may take before its message is dropped.
+	// It keeps ticks, such as the blinking of a cursor, from running forever.
+	Timeout time.Duration
+
+	// MaxMsgs is the number of messages a single call may feed back to the
+	// bubble. The test fails past it, as the bubble likely loops.
+	MaxMsgs int
+
+	tb    testing.TB
+	model reflect.Value
+	msgs  []tea.Msg
+}
+
+// New creates a harness for a bubble. The bubble must have a View method
+// returning a string and an Update method taking a tea.Msg and returning the
+// updated bubble and a tea.Cmd, such as a tea.Model or any of the bubbles in
+// this repository.
+func New(tb testing.TB, model interface{}) *Harness {
+	tb.Helper()
+
+	v := reflect.ValueOf(model)
+	update := v.MethodByName("Update")
+	if !update.IsValid() || update.Type().NumIn() != 1 || update.Type().In(0) != msgType ||
+		update.Type().NumOut() != 2 || update.Type().Out(1) != cmdType {
+		tb.Fatalf("bubbletest: %T has no Update(tea.Msg) (%T, tea.Cmd) method", model, model)
+	}
+	if _, ok := model.(interface{ View() string }); !ok {
+		tb.Fatalf("bubbletest: %T has no View() string method", model)
+	}
+
+	return &Harness{
+		Timeout: defaultTimeout,
+		MaxMsgs: defaultMaxMsgs,
+		tb:      tb,
+		model:   v,
+	}
+}
+
+// Model returns the bubble as it is after the messages sent so far.
+func (h *Harness) Model() interface{} {
+	return h.model.Interface()
+}
+
+// Send updates the bubble with the given messages, and with the messages of
+// the commands it returns, until there are no more messages.
+func (h *Harness) Send(msgs ...tea.Msg) {
+	h.tb.Helper()
+
+	var n int
+	for len(msgs) > 0 {
+		msg := msgs[0]
+		msgs = msgs[1:]
+
+		if n++; h.MaxMsgs > 0 && n > h.MaxMsgs {
+			h.tb.Fatalf("bubbletest: more than %d messages, the bubble likely loops", h.MaxMsgs)
+		}
+
+		out := h.model.MethodByName("Update").Call([]reflect.Value{reflect.ValueOf(msg)})
+		model := out[0]
+		if model.Kind() == reflect.Interface {
+			model = model.Elem()
+		}
+		if model.Type() != h.model.Type() {
+			h.tb.Fatalf("bubbletest: Update returned a %s, expected a %s", model.Type(), h.model.Type())
+		}
+		h.model = model
+
+		cmd, _ := out[1].Interface().(tea.Cmd)
+		res := h.run(cmd)
+		h.msgs = append(h.msgs, res...)
+		msgs = append(msgs, res...)
+	}
+}
+
+// Press sends key presses, given by their names such as "a", "enter" or
+// "ctrl+c". See Key.
+func (h *Harness) Press(keys ...string) {
+	h.tb.Helper()
+	for _, k := range keys {
+		h.Send(Key(k))
+	}
+}
+
+// Type sends the runes of a string as key presses, one at a time.
+func (h *Harness) Type(s string) {
+	h.tb.Helper()
+	for _, k := range Runes(s) {
+		h.Send(k)
+	}
+}
+
+// Click sends a left click at the given coordinates.
+func (h *Harness) Click(x, y int) {
+	h.tb.Helper()
+	h.Send(tea.MouseMsg{X: x, Y: y, Type: tea.MouseLeft})
+}
+
+// Scroll sends mouse wheel events at the given coordinates: one for each of
+// lines, scrolling up if lines is negative and down otherwise.
+func (h *Harness) Scroll(x, y, lines int) {
+	h.tb.Helper()
+	typ := tea.MouseWheelDown
+	if lines < 0 {
+		typ, lines = tea.MouseWheelUp, -lines
+	}
+	for i := 0; i < lines; i++ {
+		h.Send(tea.MouseMsg{X: x, Y: y, Type: typ})
+	}
+}
+
+// Resize sends a window size.
+func (h *Harness) Resize(width, height int) {
+	h.tb.Helper()
+	h.Send(tea.WindowSizeMsg{Width: width, Height: height})
+}
+
+// Msgs returns the messages of the commands the bubble returned since the
+// last call to Msgs, in the order they were sent.
+func (h *Harness) Msgs() []tea.Msg {
+	msgs := h.msgs
+	h.msgs = nil
+	return msgs
+}
+
+// View returns the bubble's view as plain text. See Plain.
+func (h *Harness) View() string {
+	return Plain(h.model.Interface().(interface{ View() string }).View())
+}
+
+// AssertView compares the bubble's view, as plain text, with a golden file.
+func (h *Harness) AssertView(golden string) {
+	h.tb.Helper()
+	AssertGolden(h.tb, golden, h.View())
+}
+
+// run runs a command and returns its messages, unpacking batches. Commands
+// that take longer than the timeout are dropped.
+func (h *Harness) run(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+
+	done := make(chan tea.Msg, 1)
+	go func() {
+		done <- cmd()
+	}()
+
+	select {
+	case msg := <-done:
+		if cmds, ok := batch(msg); ok {
+			var msgs []tea.Msg
+			for _, c := range cmds {
+				msgs = append(msgs, h.run(c)...)
+			}
+			return msgs
+		}
+		if msg == nil {
+			return nil
+		}
+		return []tea.Msg{msg}
+	case <-time.After(h.Timeout):
+		return nil
+	}
+}
+
+// Collect runs a command and returns its messages, unpacking batches. Unlike
+// a Harness, it waits for every command to finish.
+func Collect(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	msg := cmd()
+	if cmds, ok := batch(msg); ok {
+		var msgs []tea.Msg
+		for _, c := range cmds {
+			msgs = append(msgs, Collect(c)...)
+		}
+		return msgs
+	}
+	if msg == nil {
+		return nil
+	}
+	return []tea.Msg{msg}
+}
+
+// batch returns the commands of a batch. Batches are sent as an unexported
+// slice of commands.
+func batch(msg tea.Msg) ([]tea.Cmd, bool) {
+	v := reflect.ValueOf(msg)
+	if !v.IsValid() || v.Kind() != reflect.Slice || v.Type().Elem() != cmdType {
+		return nil, false
+	}
+	cmds := make([]tea.Cmd, v.Len())
+	for i := range cmds {
+		cmds[i], _ = v.Index(i).Interface().(tea.Cmd)
+	}
+	return cmds, true
+}
+
+// Plain returns a view as plain text: without escape sequences, such as
+// colors, and without trailing spaces on its lines.
+func Plain(view string) string {
+	var b strings.Builder
+	for i := 0; i < len(view); i++ {
+		if view[i] != ansi.Marker {
+			b.WriteByte(view[i])
+			continue
+		}
+		for i < len(view) && !ansi.IsTerminator(rune(view[i])) {
+			i++
+		}
+	}
+
+	lines := strings.Split(b.String(), "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, " ")
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,133 @@
+package bubbletest
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+)
+
+type countMsg int
+
+// counter is a tea.Model that counts the runes typed, and sends a countMsg
+// for each of them along with a tick that takes too long.
+type counter struct {
+	typed  string
+	counts []int
+	width  int
+}
+
+func (c counter) Init() tea.Cmd { return nil }
+
+func (c counter) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		c.typed += msg.String()
+		n := len(c.typed)
+		return c, tea.Batch(
+			func() tea.Msg { return countMsg(n) },
+			tea.Tick(time.Second, func(time.Time) tea.Msg { return countMsg(-1) }),
+		)
+	case countMsg:
+		c.counts = append(c.counts, int(msg))
+	case tea.WindowSizeMsg:
+		c.width = msg.Width
+	}
+	return c, nil
+}
+
+func (c counter) View() string {
+	return lipgloss.NewStyle().Bold(true).Render(c.typed) + "  \n" + fmt.Sprint(c.width)
+}
+
+func TestHarness(t *testing.T) {
+	h := New(t, counter{})
+	h.Type("ab")
+	h.Resize(80, 24)
+
+	c := h.Model().(counter)
+	if c.typed != "ab" || !reflect.DeepEqual(c.counts, []int{1, 2}) {
+		t.Fatalf("expected the count messages to be fed back, got %+v", c)
+	}
+	if msgs := h.Msgs(); !reflect.DeepEqual(msgs, []tea.Msg{countMsg(1), countMsg(2)}) {
+		t.Fatalf("unexpected messages %v", msgs)
+	}
+	if msgs := h.Msgs(); len(msgs) != 0 {
+		t.Fatalf("expected the messages to be cleared, got %v", msgs)
+	}
+	if c.width != 80 {
+		t.Fatalf("expected the window size to be sent, got %d", c.width)
+	}
+}
+
+func TestHarnessView(t *testing.T) {
+	lipgloss.SetColorProfile(termenv.TrueColor)
+	defer lipgloss.SetColorProfile(termenv.ColorProfile())
+
+	h := New(t, counter{})
+	h.Press("x")
+	if got := h.View(); got != "x\n0" {
+		t.Fatalf("expected the view as plain text, got %q", got)
+	}
+}
+
+func TestKey(t *testing.T) {
+	tests := []struct {
+		name string
+		key  tea.KeyMsg
+	}{
+		{"a", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("a")}},
+		{"enter", tea.KeyMsg{Type: tea.KeyEnter}},
+		{"ctrl+c", tea.KeyMsg{Type: tea.KeyCtrlC}},
+		{"alt+up", tea.KeyMsg{Type: tea.KeyUp, Alt: true}},
+		{"space", tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")}},
+		{"alt+", tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("alt+")}},
+	}
+	for _, tt := range tests {
+		if got := Key(tt.name); !reflect.DeepEqual(got, tt.key) {
+			t.Errorf("Key(%q): expected %#v, got %#v", tt.name, tt.key, got)
+		}
+		if tt.name != "space" && Key(tt.name).String() != tt.name {
+			t.Errorf("Key(%q) doesn't round trip, got %q", tt.name, Key(tt.name).String())
+		}
+	}
+}
+
+func TestTypeString(t *testing.T) {
+	c := TypeString(counter{}, "a b").(counter)
+	if c.typed != "a b" {
+		t.Fatalf("expected the runes to be typed, got %q", c.typed)
+	}
+	if len(c.counts) != 0 {
+		t.Fatalf("expected the commands to be dropped, got %v", c.counts)
+	}
+}
+
+func TestPlain(t *testing.T) {
+	view := "\x1b[1;38;5;212mhello\x1b[0m   \n  world  "
+	if got := Plain(view); got != "hello\n  world" {
+		t.Fatalf("unexpected plain text %q", got)
+	}
+}
+
+func TestCollect(t *testing.T) {
+	cmd := tea.Batch(
+		func() tea.Msg { return countMsg(1) },
+		nil,
+		tea.Batch(func() tea.Msg { return countMsg(2) }),
+	)
+	if msgs := Collect(cmd); !reflect.DeepEqual(msgs, []tea.Msg{countMsg(1), countMsg(2)}) {
+		t.Fatalf("unexpected messages %v", msgs)
+	}
+	if msgs := Collect(nil); msgs != nil {
+		t.Fatalf("expected no messages, got %v", msgs)
+	}
+}
+
+func TestAssertGolden(t *testing.T) {
+	AssertGolden(t, "golden", "hello\nworld")
+}
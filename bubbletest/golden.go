@@ -0,0 +1,37 @@
+package bubbletest
+
+import (
+	"flag"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var update = flag.Bool("update", false, "update the golden files of bubbletest")
+
+// AssertGolden compares got with the golden file testdata/<golden>.golden and
+// fails the test if they differ. With -update it writes got to the file
+// instead.
+func AssertGolden(tb testing.TB, golden, got string) {
+	tb.Helper()
+
+	path := filepath.Join("testdata", golden+".golden")
+	if *update {
+		if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+			tb.Fatalf("bubbletest: %v", err)
+		}
+		if err := ioutil.WriteFile(path, []byte(got), 0o644); err != nil {
+			tb.Fatalf("bubbletest: %v", err)
+		}
+		return
+	}
+
+	want, err := ioutil.ReadFile(path)
+	if err != nil {
+		tb.Fatalf("bubbletest: %v; run the tests with -update to create it", err)
+	}
+	if got != string(want) {
+		tb.Fatalf("view doesn't match %s:\n--- got\n%s\n--- want\n%s", path, got, want)
+	}
+}
@@ -0,0 +1,66 @@
+package bubbletest
+
+import (
+	"reflect"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// keyTypes maps key names, such as "enter", to their key types.
+var keyTypes = func() map[string]tea.KeyType {
+	types := make(map[string]tea.KeyType)
+	for k := tea.KeyType(-128); k < 128; k++ { //nolint:gomnd
+		if name := k.String(); name != "" && k != tea.KeyRunes {
+			types[name] = k
+		}
+	}
+	types["space"] = tea.KeySpace
+	return types
+}()
+
+// Key returns the key press with the given name, as returned by the String
+// method of tea.KeyMsg, such as "enter", "ctrl+c", "alt+up" or "a". Names
+// that aren't special keys are sent as runes; "space" is the space bar.
+func Key(name string) tea.KeyMsg {
+	var k tea.Key
+	if name != "alt+" && strings.HasPrefix(name, "alt+") {
+		k.Alt = true
+		name = strings.TrimPrefix(name, "alt+")
+	}
+	if t, ok := keyTypes[name]; ok {
+		k.Type = t
+		if t == tea.KeySpace {
+			k.Runes = []rune{' '}
+		}
+		return tea.KeyMsg(k)
+	}
+	k.Type = tea.KeyRunes
+	k.Runes = []rune(name)
+	return tea.KeyMsg(k)
+}
+
+// Runes returns the runes of a string as key presses, one for each rune.
+func Runes(s string) []tea.KeyMsg {
+	msgs := make([]tea.KeyMsg, 0, len(s))
+	for _, r := range s {
+		msgs = append(msgs, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return msgs
+}
+
+// TypeString updates a bubble with the runes of a string as key presses and
+// returns the updated bubble. Unlike Harness.Type, it drops the commands the
+// bubble returns. The bubble must have an Update method as described in New:
+//
+//	m = bubbletest.TypeString(m, "git").(historypicker.Model)
+func TypeString(model interface{}, s string) interface{} {
+	v := reflect.ValueOf(model)
+	for _, k := range Runes(s) {
+		v = v.MethodByName("Update").Call([]reflect.Value{reflect.ValueOf(k)})[0]
+		if v.Kind() == reflect.Interface {
+			v = v.Elem()
+		}
+	}
+	return v.Interface()
+}
@@ -0,0 +1,442 @@
+// Package calendar provides a Bubble Tea component for browsing a month grid
+// with event markers and an agenda of the events on the selected day.
+package calendar
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/i18n"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	daysPerWeek = 7
+	cellWidth   = 4
+)
+
+// Event is a single entry shown in the calendar.
+type Event struct {
+	Title string
+	Time  time.Time
+
+	// AllDay events are listed without a time in the agenda.
+	AllDay bool
+}
+
+// EventSource supplies events to the calendar. It's queried for every day
+// that's rendered, so implementations should be reasonably fast.
+type EventSource interface {
+	// EventsOn returns the events occurring on the given day. The time of
+	// day on the argument should be ignored.
+	EventsOn(day time.Time) []Event
+}
+
+// Events is a simple EventSource backed by a slice. For large sets of events
+// you'll probably want to implement EventSource with an index of your own.
+type Events []Event
+
+// EventsOn returns the events in the slice that fall on the given day, sorted
+// by time.
+func (e Events) EventsOn(day time.Time) []Event {
+	var found []Event
+	for _, ev := range e {
+		if sameDay(ev.Time, day) {
+			found = append(found, ev)
+		}
+	}
+	sort.SliceStable(found, func(i, j int) bool {
+		return found[i].Time.Before(found[j].Time)
+	})
+	return found
+}
+
+// KeyMap defines the keybindings that move the selected date by a day, a
+// week or a month, and back to today. It satisfies the help.KeyMap interface,
+// so the bindings can be listed with the help bubble.
+type KeyMap struct {
+	PrevDay   key.Binding
+	NextDay   key.Binding
+	PrevWeek  key.Binding
+	NextWeek  key.Binding
+	PrevMonth key.Binding
+	NextMonth key.Binding
+	Today     key.Binding
+}
+
+// DefaultKeyMap returns a default set of keybindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		PrevDay: key.NewBinding(
+			key.WithKeys("left", "h"),
+			key.WithHelp("←/h", "prev day"),
+		),
+		NextDay: key.NewBinding(
+			key.WithKeys("right", "l"),
+			key.WithHelp("→/l", "next day"),
+		),
+		PrevWeek: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "prev week"),
+		),
+		NextWeek: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "next week"),
+		),
+		PrevMonth: key.NewBinding(
+			key.WithKeys("pgup", "b", "["),
+			key.WithHelp("b/pgup", "prev month"),
+		),
+		NextMonth: key.NewBinding(
+			key.WithKeys("pgdown", "f", "]"),
+			key.WithHelp("f/pgdn", "next month"),
+		),
+		Today: key.NewBinding(
+			key.WithKeys("t", "home"),
+			key.WithHelp("t", "today"),
+		),
+	}
+}
+
+// ShortHelp implements the KeyMap interface.
+func (km KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{km.PrevDay, km.NextDay, km.PrevMonth, km.NextMonth, km.Today}
+}
+
+// FullHelp implements the KeyMap interface.
+func (km KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{km.PrevDay, km.NextDay, km.PrevWeek, km.NextWeek},
+		{km.PrevMonth, km.NextMonth, km.Today},
+	}
+}
+
+// Styles contains style definitions for this calendar component. By default,
+// these values are generated by DefaultStyles.
+type Styles struct {
+	Title    lipgloss.Style
+	Weekday  lipgloss.Style
+	Day      lipgloss.Style
+	Outside  lipgloss.Style // days belonging to the previous or next month
+	Today    lipgloss.Style
+	Selected lipgloss.Style
+	Marker   lipgloss.Style
+
+	Agenda        lipgloss.Style
+	AgendaTitle   lipgloss.Style
+	AgendaTime    lipgloss.Style
+	AgendaEvent   lipgloss.Style
+	AgendaNoItems lipgloss.Style
+}
+
+// DefaultStyles returns a set of default style definitions for this calendar.
+func DefaultStyles() Styles {
+	subdued := lipgloss.AdaptiveColor{Light: "#9B9B9B", Dark: "#5C5C5C"}
+	return Styles{
+		Title:    lipgloss.NewStyle().Bold(true),
+		Weekday:  lipgloss.NewStyle().Foreground(subdued),
+		Day:      lipgloss.NewStyle(),
+		Outside:  lipgloss.NewStyle().Foreground(subdued),
+		Today:    lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("212")),
+		Selected: lipgloss.NewStyle().Reverse(true),
+		Marker:   lipgloss.NewStyle().Foreground(lipgloss.Color("212")),
+
+		Agenda:        lipgloss.NewStyle().PaddingLeft(2),
+		AgendaTitle:   lipgloss.NewStyle().Bold(true),
+		AgendaTime:    lipgloss.NewStyle().Foreground(subdued),
+		AgendaEvent:   lipgloss.NewStyle(),
+		AgendaNoItems: lipgloss.NewStyle().Foreground(subdued),
+	}
+}
+
+// Model defines a state for the calendar widget.
+type Model struct {
+	KeyMap KeyMap
+	Styles Styles
+
+	// Events supplies the events to mark on the grid and list in the agenda.
+	// It may be nil.
+	Events EventSource
+
+	// FirstWeekday is the day the weeks in the grid start with. Sunday by
+	// default.
+	FirstWeekday time.Weekday
+
+	// ShowAgenda sets whether or not the agenda for the selected day is
+	// rendered next to the month grid.
+	ShowAgenda bool
+
+	// EventMarker is rendered next to days that have events.
+	EventMarker string
+
+	// TimeFormat is the layout used for event times in the agenda.
+	TimeFormat string
+
+	selected time.Time
+	focus    bool
+
+	// now returns the current time. It's a field so it can be replaced in
+	// tests.
+	now func() time.Time
+}
+
+// Option is used to set options in New. For example:
+//
+//	cal := New(WithEvents(calendar.Events{...}))
+type Option func(*Model)
+
+// New creates a new model for the calendar widget. The selected day is
+// initialized to today.
+func New(opts ...Option) Model {
+	m := Model{
+		KeyMap:       DefaultKeyMap(),
+		Styles:       DefaultStyles(),
+		FirstWeekday: time.Sunday,
+		ShowAgenda:   true,
+		EventMarker:  "•",
+		TimeFormat:   "15:04",
+		now:          time.Now,
+	}
+	m.selected = truncateDay(m.now())
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
+}
+
+// WithEvents sets the event source.
+func WithEvents(src EventSource) Option {
+	return func(m *Model) {
+		m.Events = src
+	}
+}
+
+// WithDate sets the initially selected day.
+func WithDate(t time.Time) Option {
+	return func(m *Model) {
+		m.selected = truncateDay(t)
+	}
+}
+
+// WithFirstWeekday sets the day weeks start with.
+func WithFirstWeekday(d time.Weekday) Option {
+	return func(m *Model) {
+		m.FirstWeekday = d
+	}
+}
+
+// WithFocused sets the focus state of the calendar.
+func WithFocused(f bool) Option {
+	return func(m *Model) {
+		m.focus = f
+	}
+}
+
+// WithStyles sets the calendar styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) {
+		m.Styles = s
+	}
+}
+
+// WithKeyMap sets the key map.
+func WithKeyMap(km KeyMap) Option {
+	return func(m *Model) {
+		m.KeyMap = km
+	}
+}
+
+// Update is the Bubble Tea update loop.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.focus {
+		return m, nil
+	}
+
+	switch msg := msg.(type) {
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, m.KeyMap.PrevDay):
+			m.MoveDays(-1)
+		case key.Matches(msg, m.KeyMap.NextDay):
+			m.MoveDays(1)
+		case key.Matches(msg, m.KeyMap.PrevWeek):
+			m.MoveDays(-daysPerWeek)
+		case key.Matches(msg, m.KeyMap.NextWeek):
+			m.MoveDays(daysPerWeek)
+		case key.Matches(msg, m.KeyMap.PrevMonth):
+			m.MoveMonths(-1)
+		case key.Matches(msg, m.KeyMap.NextMonth):
+			m.MoveMonths(1)
+		case key.Matches(msg, m.KeyMap.Today):
+			m.GotoToday()
+		}
+	}
+
+	return m, nil
+}
+
+// Focused returns the focus state of the calendar.
+func (m Model) Focused() bool {
+	return m.focus
+}
+
+// Focus focusses the calendar, allowing the user to move around the days.
+func (m *Model) Focus() {
+	m.focus = true
+}
+
+// Blur blurs the calendar, preventing selection or movement.
+func (m *Model) Blur() {
+	m.focus = false
+}
+
+// Selected returns the selected day.
+func (m Model) Selected() time.Time {
+	return m.selected
+}
+
+// SetSelected selects the given day, moving the grid to its month.
+func (m *Model) SetSelected(t time.Time) {
+	m.selected = truncateDay(t)
+}
+
+// SelectedEvents returns the events on the selected day.
+func (m Model) SelectedEvents() []Event {
+	return m.eventsOn(m.selected)
+}
+
+// MoveDays moves the selection by the given number of days. Negative values
+// move backward.
+func (m *Model) MoveDays(n int) {
+	m.selected = m.selected.AddDate(0, 0, n)
+}
+
+// MoveMonths moves the selection by the given number of months. Negative
+// values move backward. If the selected day doesn't exist in the target month
+// the last day of that month is selected instead, so that moving from January
+// 31st lands on the end of February rather than in March.
+func (m *Model) MoveMonths(n int) {
+	y, mo, d := m.selected.Date()
+	first := time.Date(y, mo+time.Month(n), 1, 0, 0, 0, 0, m.selected.Location())
+	m.selected = first.AddDate(0, 0, min(d, daysIn(first))-1)
+}
+
+// GotoToday selects the current day.
+func (m *Model) GotoToday() {
+	m.selected = truncateDay(m.now())
+}
+
+// View renders the component.
+func (m Model) View() string {
+	grid := m.monthView()
+	if !m.ShowAgenda {
+		return grid
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, grid, m.agendaView())
+}
+
+func (m Model) monthView() string {
+	var b strings.Builder
+
+	width := cellWidth * daysPerWeek
+	title := m.selected.Format("January 2006")
+	b.WriteString(lipgloss.PlaceHorizontal(width, lipgloss.Center, m.Styles.Title.Render(title)))
+	b.WriteString("\n")
+
+	for i := 0; i < daysPerWeek; i++ {
+		wd := time.Weekday((int(m.FirstWeekday) + i) % daysPerWeek)
+		b.WriteString(" " + m.Styles.Weekday.Render(wd.String()[:2]) + " ")
+	}
+
+	first := time.Date(m.selected.Year(), m.selected.Month(), 1, 0, 0, 0, 0, m.selected.Location())
+	lead := (int(first.Weekday()) - int(m.FirstWeekday) + daysPerWeek) % daysPerWeek
+	day := first.AddDate(0, 0, -lead)
+	today := m.now()
+
+	// Always render six weeks so the grid doesn't change height between
+	// months.
+	for week := 0; week < 6; week++ {
+		b.WriteString("\n")
+		for i := 0; i < daysPerWeek; i++ {
+			b.WriteString(m.dayView(day, today))
+			day = day.AddDate(0, 0, 1)
+		}
+	}
+
+	return b.String()
+}
+
+func (m Model) dayView(day, today time.Time) string {
+	style := m.Styles.Day
+	switch {
+	case day.Month() != m.selected.Month():
+		style = m.Styles.Outside
+	case sameDay(day, today):
+		style = m.Styles.Today
+	}
+	if sameDay(day, m.selected) {
+		style = m.Styles.Selected.Copy().Inherit(style)
+	}
+
+	marker := " "
+	if len(m.eventsOn(day)) > 0 {
+		marker = m.Styles.Marker.Render(m.EventMarker)
+	}
+
+	return " " + style.Render(fmt.Sprintf("%2d", day.Day())) + marker
+}
+
+func (m Model) agendaView() string {
+	var b strings.Builder
+	b.WriteString(m.Styles.AgendaTitle.Render(m.selected.Format("Mon, Jan 2")))
+
+	events := m.SelectedEvents()
+	if len(events) == 0 {
+		b.WriteString("\n" + m.Styles.AgendaNoItems.Render(i18n.Text("No events")))
+	}
+	for _, ev := range events {
+		when := i18n.Text("all day")
+		if !ev.AllDay {
+			when = ev.Time.Format(m.TimeFormat)
+		}
+		b.WriteString("\n" + m.Styles.AgendaTime.Render(when) + " " + m.Styles.AgendaEvent.Render(ev.Title))
+	}
+
+	return m.Styles.Agenda.Render(b.String())
+}
+
+func (m Model) eventsOn(day time.Time) []Event {
+	if m.Events == nil {
+		return nil
+	}
+	return m.Events.EventsOn(day)
+}
+
+func truncateDay(t time.Time) time.Time {
+	y, mo, d := t.Date()
+	return time.Date(y, mo, d, 0, 0, 0, 0, t.Location())
+}
+
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
+
+// daysIn returns the number of days in the month of the given time.
+func daysIn(t time.Time) int {
+	return time.Date(t.Year(), t.Month()+1, 0, 0, 0, 0, 0, t.Location()).Day()
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
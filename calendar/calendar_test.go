@@ -0,0 +1,49 @@
+package calendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func date(y int, m time.Month, d int) time.Time {
+	return time.Date(y, m, d, 0, 0, 0, 0, time.UTC)
+}
+
+func TestMoveMonthsClampsDay(t *testing.T) {
+	cal := New(WithDate(date(2023, time.January, 31)))
+
+	cal.MoveMonths(1)
+	if got, want := cal.Selected(), date(2023, time.February, 28); !got.Equal(want) {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+
+	cal.MoveMonths(-2)
+	if got, want := cal.Selected(), date(2022, time.December, 28); !got.Equal(want) {
+		t.Fatalf("expected %s, got %s", want, got)
+	}
+}
+
+func TestEventsOn(t *testing.T) {
+	events := Events{
+		{Title: "late", Time: time.Date(2023, time.March, 3, 18, 0, 0, 0, time.UTC)},
+		{Title: "other day", Time: time.Date(2023, time.March, 4, 9, 0, 0, 0, time.UTC)},
+		{Title: "early", Time: time.Date(2023, time.March, 3, 8, 30, 0, 0, time.UTC)},
+	}
+
+	cal := New(WithEvents(events), WithDate(date(2023, time.March, 3)))
+	got := cal.SelectedEvents()
+	if len(got) != 2 {
+		t.Fatalf("expected 2 events, got %d", len(got))
+	}
+	if got[0].Title != "early" || got[1].Title != "late" {
+		t.Fatalf("expected events sorted by time, got %q, %q", got[0].Title, got[1].Title)
+	}
+
+	view := cal.View()
+	for _, s := range []string{"March 2023", "08:30 early", "18:00 late"} {
+		if !strings.Contains(view, s) {
+			t.Errorf("expected view to contain %q", s)
+		}
+	}
+}
@@ -0,0 +1,524 @@
+// Package carousel provides a carousel that cycles horizontally between
+// panels, for dashboards with more panels than fit on the screen at once. As
+// many panels as fit are shown side by side, with dots indicating the
+// position below them, and switching to a panel out of view slides it in.
+//
+// Key presses go to the active panel, other messages to all of them:
+//
+//	m.carousel = carousel.New([]carousel.Panel{
+//	    {Title: "CPU", Model: cpu},
+//	    {Title: "Memory", Model: memory},
+//	    {Title: "Disks", Model: disks},
+//	}, carousel.WithMinPanelWidth(40))
+package carousel
+
+import (
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/accessibility"
+	"github.com/charmbracelet/bubbles/event"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/paginator"
+	"github.com/charmbracelet/bubbles/route"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+	"github.com/muesli/reflow/ansi"
+)
+
+const (
+	slideFrames = 8
+	fps         = 60
+)
+
+// Panel is a panel of the carousel.
+type Panel struct {
+	Title string
+	Model tea.Model
+}
+
+// FrameMsg indicates that a step of the slide animation should occur.
+type FrameMsg struct {
+	id  int
+	tag int
+}
+
+// KeyMap defines the keybindings that cycle to the previous and the next
+// panel. It satisfies the help.KeyMap interface, so the bindings can be
+// listed with the help bubble.
+type KeyMap struct {
+	Prev key.Binding
+	Next key.Binding
+}
+
+// DefaultKeyMap returns a default set of keybindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Prev: key.NewBinding(
+			key.WithKeys("[", "shift+tab"),
+			key.WithHelp("[", "previous panel"),
+		),
+		Next: key.NewBinding(
+			key.WithKeys("]", "tab"),
+			key.WithHelp("]", "next panel"),
+		),
+	}
+}
+
+// ShortHelp implements the KeyMap interface.
+func (km KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{km.Prev, km.Next}
+}
+
+// FullHelp implements the KeyMap interface.
+func (km KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{km.ShortHelp()}
+}
+
+// Styles contains the styles used to render the carousel.
+type Styles struct {
+	// Panel and ActivePanel frame the panels. Their borders and padding are
+	// taken from the panel's size.
+	Panel       lipgloss.Style
+	ActivePanel lipgloss.Style
+
+	// Title is the title of the active panel, shown next to the dots.
+	Title lipgloss.Style
+}
+
+// DefaultStyles returns a set of default style definitions for the carousel.
+func DefaultStyles() Styles {
+	return Styles{
+		Panel: lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("240")),
+		ActivePanel: lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("212")),
+		Title: lipgloss.NewStyle().Bold(true).MarginLeft(2),
+	}
+}
+
+// Model is the carousel.
+type Model struct {
+	KeyMap KeyMap
+	Styles Styles
+
+	// Width and Height are the size of the carousel, including the
+	// indicator.
+	Width  int
+	Height int
+
+	// MinPanelWidth is the width panels need at least. As many panels as fit
+	// the width at this width are shown at once, sharing the width equally.
+	// Only one panel is shown at a time if it's 0.
+	MinPanelWidth int
+
+	// Wrap makes moving past the last panel move to the first one, and the
+	// other way around.
+	Wrap bool
+
+	// Indicator renders the dots below the panels. Set its Type to another
+	// type of paginator to indicate the position differently. Hide it with
+	// ShowIndicator.
+	Indicator     paginator.Model
+	ShowIndicator bool
+
+	id     int
+	panels []Panel
+	active int
+
+	// The first panel in view, and the first one in view when the slide
+	// animation started.
+	offset int
+	from   int
+	frame  int
+	tag    int
+}
+
+// Option is used to set options in New.
+type Option func(*Model)
+
+// New creates a new carousel of the given panels.
+func New(panels []Panel, opts ...Option) Model {
+	indicator := paginator.New()
+	indicator.Type = paginator.Dots
+
+	m := Model{
+		KeyMap:        DefaultKeyMap(),
+		Styles:        DefaultStyles(),
+		Indicator:     indicator,
+		ShowIndicator: true,
+		id:            route.NextID(),
+		panels:        panels,
+		frame:         slideFrames,
+	}
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
+}
+
+// WithSize sets the size of the carousel.
+func WithSize(width, height int) Option {
+	return func(m *Model) {
+		m.SetSize(width, height)
+	}
+}
+
+// WithMinPanelWidth sets the width panels need at least.
+func WithMinPanelWidth(w int) Option {
+	return func(m *Model) {
+		m.MinPanelWidth = w
+	}
+}
+
+// WithWrap makes moving past the last panel move to the first one, and the
+// other way around.
+func WithWrap() Option {
+	return func(m *Model) {
+		m.Wrap = true
+	}
+}
+
+// WithStyles sets the styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) {
+		m.Styles = s
+	}
+}
+
+// WithKeyMap sets the key map.
+func WithKeyMap(km KeyMap) Option {
+	return func(m *Model) {
+		m.KeyMap = km
+	}
+}
+
+// ID returns the carousel's unique ID.
+func (m Model) ID() int {
+	return m.id
+}
+
+// SetSize sets the size of the carousel, keeping the active panel in view.
+func (m *Model) SetSize(width, height int) {
+	m.Width, m.Height = width, height
+	m.offset = m.clampOffset(m.offset)
+	m.from, m.frame = m.offset, slideFrames
+}
+
+// MinSize returns the size of a single panel of MinPanelWidth and a line,
+// and the indicator if it's shown. It satisfies the layout.Sizable
+// interface along with SetSize.
+func (m Model) MinSize() (width, height int) {
+	height = 1
+	if m.ShowIndicator {
+		height++
+	}
+	return max(1, m.MinPanelWidth), height
+}
+
+// PreferredSize returns the width that shows every panel at MinPanelWidth.
+// The carousel would rather fill the height, and the width too if
+// MinPanelWidth isn't set.
+func (m Model) PreferredSize() (width, height int) {
+	return m.MinPanelWidth * len(m.panels), 0
+}
+
+// Panels returns the panels.
+func (m Model) Panels() []Panel {
+	return m.panels
+}
+
+// SetPanels replaces the panels.
+func (m *Model) SetPanels(panels []Panel) {
+	m.panels = panels
+	m.active = clamp(m.active, 0, len(panels)-1)
+	m.offset = m.clampOffset(m.offset)
+	m.from, m.frame = m.offset, slideFrames
+}
+
+// Active returns the index of the active panel.
+func (m Model) Active() int {
+	return m.active
+}
+
+// InView returns the number of panels shown at once.
+func (m Model) InView() int {
+	n := 1
+	if m.MinPanelWidth > 0 {
+		n = max(1, m.Width/m.MinPanelWidth)
+	}
+	return max(1, min(n, len(m.panels)))
+}
+
+// PanelSize returns the size of the content of a panel, without its frame,
+// to size the panels to.
+func (m Model) PanelSize() (width, height int) {
+	width = m.Width / m.InView()
+	height = m.Height
+	if m.ShowIndicator {
+		height--
+	}
+	return max(0, width-m.Styles.Panel.GetHorizontalFrameSize()),
+		max(0, height-m.Styles.Panel.GetVerticalFrameSize())
+}
+
+// SetActive makes a panel the active one. It returns the command that slides
+// it into view if it was out of view.
+func (m *Model) SetActive(i int) tea.Cmd {
+	if len(m.panels) == 0 {
+		return nil
+	}
+	m.active = clamp(i, 0, len(m.panels)-1)
+
+	offset := m.clampOffset(m.offset)
+	if offset == m.offset {
+		return nil
+	}
+	m.from = m.offset
+	m.offset = offset
+	m.tag++
+	if accessibility.ReducedMotion() {
+		m.frame = slideFrames
+		return nil
+	}
+	m.frame = 0
+	return m.nextFrame()
+}
+
+// Next makes the next panel the active one.
+func (m *Model) Next() tea.Cmd {
+	if m.Wrap && m.active == len(m.panels)-1 {
+		return m.SetActive(0)
+	}
+	return m.SetActive(m.active + 1)
+}
+
+// Prev makes the previous panel the active one.
+func (m *Model) Prev() tea.Cmd {
+	if m.Wrap && m.active == 0 {
+		return m.SetActive(len(m.panels) - 1)
+	}
+	return m.SetActive(m.active - 1)
+}
+
+// clampOffset returns the offset closest to the given one that keeps the
+// active panel in view.
+func (m Model) clampOffset(offset int) int {
+	n := m.InView()
+	offset = clamp(offset, m.active-n+1, m.active)
+	return clamp(offset, 0, max(0, len(m.panels)-n))
+}
+
+// Update handles the slide animation and the keybindings, and passes other
+// key presses on to the active panel and other messages on to all panels.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if msg, ok := msg.(FrameMsg); ok {
+		if msg.id != m.id || msg.tag != m.tag {
+			return m, nil
+		}
+		m.frame++
+		if m.frame >= slideFrames {
+			return m, nil
+		}
+		return m, m.nextFrame()
+	}
+
+	if len(m.panels) == 0 {
+		return m, nil
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		prev := m.active
+		var cmd tea.Cmd
+		switch {
+		case key.Matches(keyMsg, m.KeyMap.Prev):
+			cmd = m.Prev()
+		case key.Matches(keyMsg, m.KeyMap.Next):
+			cmd = m.Next()
+		default:
+			return m, m.updatePanel(m.active, msg)
+		}
+		if m.active == prev {
+			return m, cmd
+		}
+		id, active := m.id, m.active
+		return m, tea.Batch(cmd, func() tea.Msg {
+			return event.SelectionChangedMsg{ID: id, Index: active, Previous: prev}
+		})
+	}
+
+	cmds := make([]tea.Cmd, len(m.panels))
+	for i := range m.panels {
+		cmds[i] = m.updatePanel(i, msg)
+	}
+	return m, tea.Batch(cmds...)
+}
+
+func (m *Model) updatePanel(i int, msg tea.Msg) tea.Cmd {
+	if m.panels[i].Model == nil {
+		return nil
+	}
+	var cmd tea.Cmd
+	m.panels[i].Model, cmd = m.panels[i].Model.Update(msg)
+	return cmd
+}
+
+func (m Model) nextFrame() tea.Cmd {
+	id, tag := m.id, m.tag
+	return tea.Tick(time.Second/fps, func(time.Time) tea.Msg {
+		return FrameMsg{id: id, tag: tag}
+	})
+}
+
+// slideX returns how many columns of the panels from the first of from and
+// offset on are scrolled out of view while sliding between them.
+func (m Model) slideX(panelWidth int) int {
+	distance := abs(m.offset-m.from) * panelWidth
+	t := float64(m.frame) / float64(slideFrames)
+	eased := 1 - (1-t)*(1-t)*(1-t)
+	x := int(eased * float64(distance))
+	if m.offset < m.from {
+		return distance - x
+	}
+	return x
+}
+
+// View renders the panels in view, and the indicator below them.
+func (m Model) View() string {
+	if len(m.panels) == 0 {
+		return ""
+	}
+
+	n := m.InView()
+	panelWidth := m.Width / n
+	height := m.Height
+	if m.ShowIndicator {
+		height--
+	}
+
+	first, last := m.offset, m.offset+n
+	x := 0
+	if m.frame < slideFrames {
+		first = min(m.from, m.offset)
+		last = max(m.from, m.offset) + n
+		x = m.slideX(panelWidth)
+	}
+
+	views := make([]string, 0, last-first)
+	for i := first; i < last && i < len(m.panels); i++ {
+		views = append(views, m.panelView(i, panelWidth, height))
+	}
+	strip := lipgloss.JoinHorizontal(lipgloss.Top, views...)
+
+	view := strip
+	if x > 0 || len(views) > n {
+		lines := strings.Split(strip, "\n")
+		for i, line := range lines {
+			lines[i] = cut(line, x, panelWidth*n)
+		}
+		view = strings.Join(lines, "\n")
+	}
+
+	if !m.ShowIndicator {
+		return view
+	}
+	return view + "\n" + m.indicatorView()
+}
+
+func (m Model) panelView(i, width, height int) string {
+	style := m.Styles.Panel
+	if i == m.active {
+		style = m.Styles.ActivePanel
+	}
+	innerWidth := max(0, width-style.GetHorizontalFrameSize())
+	innerHeight := max(0, height-style.GetVerticalFrameSize())
+
+	var content string
+	if model := m.panels[i].Model; model != nil {
+		content = model.View()
+	}
+	content = lipgloss.NewStyle().
+		Width(innerWidth).MaxWidth(innerWidth).
+		Height(innerHeight).MaxHeight(innerHeight).
+		Render(content)
+	return style.Render(content)
+}
+
+func (m Model) indicatorView() string {
+	p := m.Indicator
+	p.TotalPages = len(m.panels)
+	p.Page = m.active
+	view := p.View() + m.Styles.Title.Render(m.panels[m.active].Title)
+	return lipgloss.PlaceHorizontal(m.Width, lipgloss.Center, view)
+}
+
+// cut returns width columns of a line starting from column x, keeping the
+// line's escape sequences so its styles carry over.
+func cut(line string, x, width int) string {
+	var (
+		b      strings.Builder
+		col    int
+		escape bool
+	)
+	for _, r := range line {
+		if r == ansi.Marker {
+			escape = true
+		}
+		if escape {
+			b.WriteRune(r)
+			if ansi.IsTerminator(r) {
+				escape = false
+			}
+			continue
+		}
+
+		w := runewidth.RuneWidth(r)
+		if col >= x && col+w <= x+width {
+			b.WriteRune(r)
+		} else if col < x && col+w > x || col < x+width && col+w > x+width {
+			// A wide rune cut in half.
+			b.WriteByte(' ')
+		}
+		col += w
+	}
+	return b.String()
+}
+
+func clamp(v, low, high int) int {
+	if high < low {
+		return low
+	}
+	if v < low {
+		return low
+	}
+	if v > high {
+		return high
+	}
+	return v
+}
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
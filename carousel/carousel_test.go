@@ -0,0 +1,100 @@
+package carousel
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// text is a panel showing a text, which counts the keys it received.
+type text struct {
+	s    string
+	keys int
+}
+
+func (t text) Init() tea.Cmd { return nil }
+
+func (t text) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	if _, ok := msg.(tea.KeyMsg); ok {
+		t.keys++
+	}
+	return t, nil
+}
+
+func (t text) View() string { return t.s }
+
+func newCarousel() Model {
+	var panels []Panel
+	for _, s := range []string{"aaaa", "bbbb", "cccc"} {
+		panels = append(panels, Panel{Title: s, Model: text{s: s}})
+	}
+	m := New(panels, WithSize(8, 2), WithMinPanelWidth(4))
+	m.Styles = Styles{}
+	m.ShowIndicator = false
+	return m
+}
+
+func TestCarousel(t *testing.T) {
+	m := newCarousel()
+	if m.InView() != 2 {
+		t.Fatalf("expected 2 panels in view, got %d", m.InView())
+	}
+	if exp, got := "aaaabbbb\n        ", m.View(); exp != got {
+		t.Fatalf("expected view %q, got %q", exp, got)
+	}
+
+	// Key presses go to the active panel.
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+	if m.Panels()[0].Model.(text).keys != 1 || m.Panels()[1].Model.(text).keys != 0 {
+		t.Fatal("expected only the active panel to receive the key press")
+	}
+
+	// Moving to a panel in view doesn't slide.
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{']'}})
+	if m.Active() != 1 || m.frame < slideFrames {
+		t.Fatalf("expected panel 1 to be active without sliding, got %d", m.Active())
+	}
+
+	cmd := m.Next()
+	if cmd == nil {
+		t.Fatal("expected the third panel to slide in")
+	}
+	m, _ = m.Update(FrameMsg{id: m.id, tag: m.tag})
+	if view := m.View(); strings.HasPrefix(view, "aaaa") || strings.HasPrefix(view, "bbbb") {
+		t.Errorf("expected the panels to be halfway through sliding, got %q", view)
+	}
+	for m.frame < slideFrames {
+		m, _ = m.Update(FrameMsg{id: m.id, tag: m.tag})
+	}
+	if exp, got := "bbbbcccc\n        ", m.View(); exp != got {
+		t.Errorf("expected view %q, got %q", exp, got)
+	}
+}
+
+func TestWrap(t *testing.T) {
+	m := newCarousel()
+	m.Wrap = true
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	if m.Active() != 2 {
+		t.Fatalf("expected the last panel to be active, got %d", m.Active())
+	}
+	if cmd == nil {
+		t.Fatal("expected a command")
+	}
+}
+
+func TestIndicator(t *testing.T) {
+	m := newCarousel()
+	m.ShowIndicator = true
+	m.SetSize(8, 3)
+	m.Styles.Title = lipgloss.NewStyle().MarginLeft(1)
+	m.SetActive(1)
+
+	lines := strings.Split(m.View(), "\n")
+	if exp, got := "○•○ bbbb", lines[len(lines)-1]; exp != got {
+		t.Errorf("expected indicator %q, got %q", exp, got)
+	}
+}
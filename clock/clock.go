@@ -0,0 +1,249 @@
+// Package clock provides a display of large digits for clocks, countdowns and
+// pomodoro timers. It renders the time left on a timer, the time elapsed on a
+// stopwatch or any time or duration, and can blink once a timer runs out:
+//
+//	m.clock = clock.New(clock.WithTimer(m.timer), clock.WithBlinkOnExpiry())
+//
+//	func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+//	    var cmds [2]tea.Cmd
+//	    m.timer, cmds[0] = m.timer.Update(msg)
+//	    m.clock, cmds[1] = m.clock.Update(msg)
+//	    return m, tea.Batch(cmds[:]...)
+//	}
+//
+//	func (m model) View() string {
+//	    return m.clock.ViewTimer(m.timer)
+//	}
+package clock
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/accessibility"
+	"github.com/charmbracelet/bubbles/route"
+	"github.com/charmbracelet/bubbles/stopwatch"
+	"github.com/charmbracelet/bubbles/timer"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+)
+
+const (
+	defaultBlinkInterval = 500 * time.Millisecond
+	defaultBlinkCount    = 10
+)
+
+// BlinkMsg indicates that the display should turn on or off while blinking.
+type BlinkMsg struct {
+	id  int
+	tag int
+}
+
+// FormatDuration formats a duration as minutes and seconds, and hours if it's
+// an hour or longer, such as "04:05" or "1:02:03". Negative durations are
+// shown as zero.
+func FormatDuration(d time.Duration) string {
+	if d < 0 {
+		d = 0
+	}
+	d = d.Round(time.Second)
+	h, m, s := int(d/time.Hour), int(d%time.Hour/time.Minute), int(d%time.Minute/time.Second)
+	if h > 0 {
+		return fmt.Sprintf("%d:%02d:%02d", h, m, s)
+	}
+	return fmt.Sprintf("%02d:%02d", m, s)
+}
+
+// Model is the clock display.
+type Model struct {
+	Font  Font
+	Style lipgloss.Style
+
+	// Format formats durations. It's FormatDuration by default.
+	Format func(time.Duration) string
+
+	// BlinkOnExpiry makes the display blink when the timer it's showing runs
+	// out, BlinkCount times every BlinkInterval. It blinks until StopBlinking
+	// is called if BlinkCount is 0.
+	BlinkOnExpiry bool
+	BlinkInterval time.Duration
+	BlinkCount    int
+
+	id      int
+	timerID int
+	tag     int
+	blinks  int
+	hidden  bool
+}
+
+// Option is used to set options in New.
+type Option func(*Model)
+
+// New creates a new clock display.
+func New(opts ...Option) Model {
+	m := Model{
+		Font:          Block,
+		Format:        FormatDuration,
+		BlinkInterval: defaultBlinkInterval,
+		BlinkCount:    defaultBlinkCount,
+		id:            route.NextID(),
+	}
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
+}
+
+// WithFont sets the font.
+func WithFont(f Font) Option {
+	return func(m *Model) {
+		m.Font = f
+	}
+}
+
+// WithStyle sets the style.
+func WithStyle(s lipgloss.Style) Option {
+	return func(m *Model) {
+		m.Style = s
+	}
+}
+
+// WithTimer sets the timer whose expiry makes the display blink.
+func WithTimer(t timer.Model) Option {
+	return func(m *Model) {
+		m.timerID = t.ID()
+	}
+}
+
+// WithBlinkOnExpiry makes the display blink when its timer runs out.
+func WithBlinkOnExpiry() Option {
+	return func(m *Model) {
+		m.BlinkOnExpiry = true
+	}
+}
+
+// ID returns the clock display's unique ID.
+func (m Model) ID() int {
+	return m.id
+}
+
+// Blink starts blinking the display. It does nothing with reduced motion.
+func (m *Model) Blink() tea.Cmd {
+	m.tag++
+	m.blinks = 0
+	if accessibility.ReducedMotion() {
+		m.hidden = false
+		return nil
+	}
+	m.hidden = true
+	return m.blink()
+}
+
+// StopBlinking stops blinking the display.
+func (m *Model) StopBlinking() {
+	m.tag++
+	m.hidden = false
+}
+
+// Blinking returns whether the display is blinking.
+func (m Model) Blinking() bool {
+	return m.hidden || m.blinks > 0 && (m.BlinkCount == 0 || m.blinks < m.BlinkCount*2)
+}
+
+func (m Model) blink() tea.Cmd {
+	id, tag := m.id, m.tag
+	return tea.Tick(m.BlinkInterval, func(time.Time) tea.Msg {
+		return BlinkMsg{id: id, tag: tag}
+	})
+}
+
+// Update starts blinking the display when its timer runs out, and turns it on
+// and off while it blinks.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case timer.TimeoutMsg:
+		if m.BlinkOnExpiry && m.timerID != 0 && msg.ID == m.timerID {
+			return m, m.Blink()
+		}
+
+	case BlinkMsg:
+		if msg.id != m.id || msg.tag != m.tag {
+			return m, nil
+		}
+		m.hidden = !m.hidden
+		m.blinks++
+		if m.BlinkCount > 0 && m.blinks >= m.BlinkCount*2 {
+			m.hidden = false
+			return m, nil
+		}
+		return m, m.blink()
+	}
+	return m, nil
+}
+
+// ViewTimer renders the time left on a timer.
+func (m Model) ViewTimer(t timer.Model) string {
+	return m.ViewDuration(t.Timeout)
+}
+
+// ViewStopwatch renders the time elapsed on a stopwatch.
+func (m Model) ViewStopwatch(s stopwatch.Model) string {
+	return m.ViewDuration(s.Elapsed())
+}
+
+// ViewDuration renders a duration.
+func (m Model) ViewDuration(d time.Duration) string {
+	return m.Render(m.Format(d))
+}
+
+// ViewTime renders the time of day in the given layout, such as "15:04".
+func (m Model) ViewTime(t time.Time, layout string) string {
+	return m.Render(t.Format(layout))
+}
+
+// Render renders text in the font. Characters the font has no glyph for are
+// drawn in the middle row. While the display blinks off, it renders blank
+// space of the same size.
+func (m Model) Render(s string) string {
+	rows := make([]strings.Builder, max(1, m.Font.Height))
+	spacing := strings.Repeat(" ", m.Font.Spacing)
+	for i, r := range []rune(s) {
+		glyph, ok := m.Font.Glyphs[r]
+		if !ok {
+			glyph = make([]string, len(rows))
+			w := runewidth.RuneWidth(r)
+			for j := range glyph {
+				glyph[j] = strings.Repeat(" ", w)
+			}
+			glyph[len(rows)/2] = string(r)
+		}
+		for j := range rows {
+			if i > 0 {
+				rows[j].WriteString(spacing)
+			}
+			if j < len(glyph) {
+				rows[j].WriteString(glyph[j])
+			}
+		}
+	}
+
+	lines := make([]string, len(rows))
+	for i := range rows {
+		lines[i] = rows[i].String()
+		if m.hidden {
+			lines[i] = strings.Repeat(" ", runewidth.StringWidth(lines[i]))
+		}
+	}
+	return m.Style.Render(strings.Join(lines, "\n"))
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
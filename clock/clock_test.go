@@ -0,0 +1,59 @@
+package clock
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/timer"
+)
+
+func TestFormatDuration(t *testing.T) {
+	tests := map[time.Duration]string{
+		-time.Second:                          "00:00",
+		4*time.Minute + 5*time.Second:         "04:05",
+		62*time.Minute + 3*time.Second:        "1:02:03",
+		59*time.Second + 600*time.Millisecond: "01:00",
+	}
+	for d, exp := range tests {
+		if got := FormatDuration(d); got != exp {
+			t.Errorf("expected %s to be formatted as %q, got %q", d, exp, got)
+		}
+	}
+}
+
+func TestRender(t *testing.T) {
+	m := New(WithFont(Thin))
+	exp := strings.Join([]string{
+		"  ╷ ╻ ╶─┐",
+		"  │     │",
+		"  ╵ ╹   ╵",
+	}, "\n")
+	if got := m.ViewDuration(0); got != m.Render("00:00") {
+		t.Errorf("expected the duration to be rendered as 00:00, got\n%s", got)
+	}
+	if got := m.Render("1:7"); got != exp {
+		t.Errorf("expected\n%s\ngot\n%s", exp, got)
+	}
+}
+
+func TestBlink(t *testing.T) {
+	tm := timer.New(time.Second)
+	m := New(WithTimer(tm), WithBlinkOnExpiry())
+	m.BlinkCount = 1
+
+	on := m.Render("12")
+	m, cmd := m.Update(timer.TimeoutMsg{ID: tm.ID()})
+	if cmd == nil || !m.Blinking() {
+		t.Fatal("expected the display to blink when the timer runs out")
+	}
+	if got := m.Render("12"); strings.TrimSpace(got) != "" || len(strings.Split(got, "\n")) != Block.Height {
+		t.Errorf("expected blank space while off, got %q", got)
+	}
+
+	m, _ = m.Update(BlinkMsg{id: m.id, tag: m.tag})
+	m, cmd = m.Update(BlinkMsg{id: m.id, tag: m.tag})
+	if cmd != nil || m.Blinking() || m.Render("12") != on {
+		t.Error("expected the display to stop blinking on")
+	}
+}
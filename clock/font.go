@@ -0,0 +1,58 @@
+package clock
+
+// Font is a font of large glyphs, all as tall as the font's height.
+type Font struct {
+	Height int
+
+	// Glyphs are the rows of every glyph. The rows of a glyph are equally
+	// wide.
+	Glyphs map[rune][]string
+
+	// Spacing is the number of columns between glyphs.
+	Spacing int
+}
+
+// Block is a font of glyphs made of full blocks, five rows tall.
+var Block = Font{
+	Height:  5,
+	Spacing: 1,
+	Glyphs: map[rune][]string{
+		'0': {"███", "█ █", "█ █", "█ █", "███"},
+		'1': {" █ ", "██ ", " █ ", " █ ", "███"},
+		'2': {"███", "  █", "███", "█  ", "███"},
+		'3': {"███", "  █", "███", "  █", "███"},
+		'4': {"█ █", "█ █", "███", "  █", "  █"},
+		'5': {"███", "█  ", "███", "  █", "███"},
+		'6': {"███", "█  ", "███", "█ █", "███"},
+		'7': {"███", "  █", "  █", "  █", "  █"},
+		'8': {"███", "█ █", "███", "█ █", "███"},
+		'9': {"███", "█ █", "███", "  █", "███"},
+		':': {" ", "█", " ", "█", " "},
+		'.': {" ", " ", " ", " ", "█"},
+		'-': {"   ", "   ", "███", "   ", "   "},
+		' ': {" ", " ", " ", " ", " "},
+	},
+}
+
+// Thin is a font of glyphs drawn with box-drawing characters, like seven
+// segment displays, three rows tall.
+var Thin = Font{
+	Height:  3,
+	Spacing: 1,
+	Glyphs: map[rune][]string{
+		'0': {"┌─┐", "│ │", "└─┘"},
+		'1': {"  ╷", "  │", "  ╵"},
+		'2': {"╶─┐", "┌─┘", "└─╴"},
+		'3': {"╶─┐", " ─┤", "╶─┘"},
+		'4': {"╷ ╷", "└─┤", "  ╵"},
+		'5': {"┌─╴", "└─┐", "╶─┘"},
+		'6': {"┌─╴", "├─┐", "└─┘"},
+		'7': {"╶─┐", "  │", "  ╵"},
+		'8': {"┌─┐", "├─┤", "└─┘"},
+		'9': {"┌─┐", "└─┤", "╶─┘"},
+		':': {"╻", " ", "╹"},
+		'.': {" ", " ", "╻"},
+		'-': {"   ", "╶─╴", "   "},
+		' ': {" ", " ", " "},
+	},
+}
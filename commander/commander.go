@@ -0,0 +1,363 @@
+// Package commander provides a dual-pane file manager scaffold in the style of
+// Midnight Commander, built from a table per pane, a confirmation prompt and
+// an action bar rendered with help.
+//
+// The active pane is switched with tab. Enter opens the selected directory,
+// backspace goes to the parent directory, and the selected file or directory
+// is copied or moved to the directory of the other pane once the user
+// confirms. Every operation sends an OperationMsg when it's done:
+//
+//	func (m model) Init() tea.Cmd {
+//	    return m.commander.Init()
+//	}
+//
+//	case commander.OperationMsg:
+//	    if msg.Err != nil {
+//	        m.status = msg.Err.Error()
+//	    }
+package commander
+
+import (
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/charmbracelet/bubbles/confirm"
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/route"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// KeyMap defines keybindings. It satisfies to the help.KeyMap interface, which
+// is used to render the action bar.
+type KeyMap struct {
+	SwitchPane key.Binding
+	Open       key.Binding
+	Parent     key.Binding
+	Copy       key.Binding
+	Move       key.Binding
+	Refresh    key.Binding
+}
+
+// DefaultKeyMap returns a default set of keybindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		SwitchPane: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "switch pane"),
+		),
+		Open: key.NewBinding(
+			key.WithKeys("enter", "right"),
+			key.WithHelp("enter", "open"),
+		),
+		Parent: key.NewBinding(
+			key.WithKeys("backspace", "left"),
+			key.WithHelp("backspace", "parent"),
+		),
+		Copy: key.NewBinding(
+			key.WithKeys("f5", "c"),
+			key.WithHelp("F5", "copy"),
+		),
+		Move: key.NewBinding(
+			key.WithKeys("f6", "M"),
+			key.WithHelp("F6", "move"),
+		),
+		Refresh: key.NewBinding(
+			key.WithKeys("ctrl+r"),
+			key.WithHelp("ctrl+r", "refresh"),
+		),
+	}
+}
+
+// ShortHelp implements the KeyMap interface.
+func (km KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{km.SwitchPane, km.Open, km.Parent, km.Copy, km.Move}
+}
+
+// FullHelp implements the KeyMap interface.
+func (km KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{km.ShortHelp(), {km.Refresh}}
+}
+
+// Styles contains the styles used to render the commander.
+type Styles struct {
+	// The path above every pane, for the active pane and the other one.
+	ActivePath lipgloss.Style
+	Path       lipgloss.Style
+
+	// The error shown in place of a pane's entries.
+	Error lipgloss.Style
+}
+
+// DefaultStyles returns a set of default style definitions for the commander.
+func DefaultStyles() Styles {
+	return Styles{
+		ActivePath: lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("212")),
+		Path:       lipgloss.NewStyle().Foreground(lipgloss.Color("240")),
+		Error:      lipgloss.NewStyle().Foreground(lipgloss.Color("9")),
+	}
+}
+
+// Model is the dual-pane file manager.
+type Model struct {
+	KeyMap KeyMap
+	Styles Styles
+
+	// Help renders the action bar.
+	Help help.Model
+
+	id     int
+	panes  [2]pane
+	active int
+	width  int
+	height int
+
+	confirm confirm.Model
+	pending *operation
+}
+
+// Option is used to set options in New.
+type Option func(*Model)
+
+// New creates a new commander with both panes in the given directories.
+// Call Init to list them.
+func New(left, right string, opts ...Option) Model {
+	m := Model{
+		KeyMap:  DefaultKeyMap(),
+		Styles:  DefaultStyles(),
+		Help:    help.New(),
+		id:      route.NextID(),
+		confirm: confirm.New(),
+	}
+	m.panes[0] = newPane(left)
+	m.panes[1] = newPane(right)
+	m.panes[0].table.Focus()
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
+}
+
+// WithSize sets the size of the commander.
+func WithSize(width, height int) Option {
+	return func(m *Model) {
+		m.SetSize(width, height)
+	}
+}
+
+// WithStyles sets the styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) {
+		m.Styles = s
+	}
+}
+
+// WithKeyMap sets the key map.
+func WithKeyMap(km KeyMap) Option {
+	return func(m *Model) {
+		m.KeyMap = km
+	}
+}
+
+// ID returns the commander's unique ID.
+func (m Model) ID() int {
+	return m.id
+}
+
+// Init lists the directories of both panes.
+func (m Model) Init() tea.Cmd {
+	return m.load(0, 1)
+}
+
+// SetSize sets the size of the commander, which is split between the panes
+// and the action bar.
+func (m *Model) SetSize(width, height int) {
+	m.width, m.height = width, height
+	m.Help.Width = width
+	for i := range m.panes {
+		m.panes[i].table.SetWidth(m.paneWidth())
+		m.panes[i].table.SetHeight(max(1, height-2)) //nolint:gomnd // path and action bar
+	}
+}
+
+// Dir returns the directory of a pane: 0 for the left one, 1 for the right.
+func (m Model) Dir(pane int) string {
+	return m.panes[pane].dir
+}
+
+// ActivePane returns the index of the active pane.
+func (m Model) ActivePane() int {
+	return m.active
+}
+
+// Selected returns the path of the entry selected in the active pane, if any.
+func (m Model) Selected() (string, bool) {
+	return m.panes[m.active].selected()
+}
+
+// Update handles key presses and the results of file operations.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case dirLoadedMsg:
+		if msg.id != m.id {
+			return m, nil
+		}
+		for _, l := range msg.listings {
+			m.panes[l.pane].loaded(l)
+		}
+		return m, nil
+	case OperationMsg:
+		if msg.ID != m.id {
+			return m, nil
+		}
+		return m, m.load(0, 1)
+	case confirm.ResultMsg:
+		if msg.ID != m.confirm.ID() || m.pending == nil {
+			return m, nil
+		}
+		op := *m.pending
+		m.pending = nil
+		if !msg.Confirmed {
+			return m, nil
+		}
+		return m, op.run(m.id)
+	}
+
+	if m.confirm.Active() {
+		var cmd tea.Cmd
+		m.confirm, cmd = m.confirm.Update(msg)
+		return m, cmd
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.KeyMap.SwitchPane):
+			m.panes[m.active].table.Blur()
+			m.active = 1 - m.active
+			m.panes[m.active].table.Focus()
+			return m, nil
+		case key.Matches(msg, m.KeyMap.Open):
+			if path, ok := m.panes[m.active].selectedDir(); ok {
+				return m, m.open(m.active, path)
+			}
+			return m, nil
+		case key.Matches(msg, m.KeyMap.Parent):
+			return m, m.open(m.active, filepath.Dir(m.panes[m.active].dir))
+		case key.Matches(msg, m.KeyMap.Copy):
+			m.ask(OperationCopy)
+			return m, nil
+		case key.Matches(msg, m.KeyMap.Move):
+			m.ask(OperationMove)
+			return m, nil
+		case key.Matches(msg, m.KeyMap.Refresh):
+			return m, m.load(0, 1)
+		}
+	}
+
+	var cmd tea.Cmd
+	m.panes[m.active].table, cmd = m.panes[m.active].table.Update(msg)
+	return m, cmd
+}
+
+// View renders both panes side by side above the action bar, or the
+// confirmation prompt while an operation waits to be confirmed.
+func (m Model) View() string {
+	panes := make([]string, len(m.panes))
+	for i, p := range m.panes {
+		style := m.Styles.Path
+		if i == m.active {
+			style = m.Styles.ActivePath
+		}
+		body := p.table.View()
+		if p.err != nil {
+			body = m.Styles.Error.Render(p.err.Error())
+		}
+		panes[i] = lipgloss.JoinVertical(lipgloss.Left,
+			style.Copy().MaxWidth(m.paneWidth()).Render(p.dir),
+			body,
+		)
+	}
+
+	bar := m.Help.View(m.KeyMap)
+	if m.confirm.Active() {
+		bar = m.confirm.View()
+	}
+	return lipgloss.JoinVertical(lipgloss.Left,
+		lipgloss.JoinHorizontal(lipgloss.Top, panes[0], " ", panes[1]),
+		bar,
+	)
+}
+
+// open lists a directory in a pane.
+func (m *Model) open(pane int, dir string) tea.Cmd {
+	m.panes[pane].dir = dir
+	return m.load(pane)
+}
+
+// ask asks the user to confirm copying or moving the selected entry to the
+// directory of the other pane.
+func (m *Model) ask(kind Operation) {
+	source, ok := m.Selected()
+	if !ok {
+		return
+	}
+	op := operation{
+		kind:   kind,
+		source: source,
+		target: filepath.Join(m.panes[1-m.active].dir, filepath.Base(source)),
+	}
+	m.pending = &op
+	m.confirm.Ask(kind.String() + " " + filepath.Base(source) + " to " + m.panes[1-m.active].dir + "?")
+}
+
+// paneWidth returns the width of a pane.
+func (m Model) paneWidth() int {
+	return max(0, (m.width-1)/2) //nolint:gomnd
+}
+
+// load returns the command that lists the directories of panes.
+func (m Model) load(panes ...int) tea.Cmd {
+	id := m.id
+	listings := make([]listing, len(panes))
+	for i, pane := range panes {
+		listings[i] = listing{pane: pane, dir: m.panes[pane].dir}
+	}
+	return func() tea.Msg {
+		for i := range listings {
+			listings[i].entries, listings[i].err = readDir(listings[i].dir)
+		}
+		return dirLoadedMsg{id: id, listings: listings}
+	}
+}
+
+// dirLoadedMsg is sent when the directories of panes were listed.
+type dirLoadedMsg struct {
+	id       int
+	listings []listing
+}
+
+// listing is the listed directory of a pane.
+type listing struct {
+	pane    int
+	dir     string
+	entries []os.FileInfo
+	err     error
+}
+
+func formatSize(f os.FileInfo) string {
+	if f.IsDir() {
+		return ""
+	}
+	return strconv.FormatInt(f.Size(), 10)
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,86 @@
+package commander
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/confirm"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func tempDirs(t *testing.T) (root, left, right string) {
+	t.Helper()
+	root, err := ioutil.TempDir("", "commander")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	left, right = filepath.Join(root, "left"), filepath.Join(root, "right")
+	for _, dir := range []string{filepath.Join(left, "sub"), right} {
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := ioutil.WriteFile(filepath.Join(left, "a.txt"), []byte("hello"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	return root, left, right
+}
+
+// run passes a message to the commander and then the message of the command
+// it returns, as the Bubble Tea runtime would.
+func run(m Model, msg tea.Msg) Model {
+	m, cmd := m.Update(msg)
+	if cmd != nil {
+		return run(m, cmd())
+	}
+	return m
+}
+
+func TestCopy(t *testing.T) {
+	root, left, right := tempDirs(t)
+	defer os.RemoveAll(root)
+	m := New(left, right, WithSize(80, 10))
+	m = run(m, m.Init()())
+
+	// The parent directory, then sub/ and a.txt.
+	m = run(m, tea.KeyMsg{Type: tea.KeyDown})
+	m = run(m, tea.KeyMsg{Type: tea.KeyDown})
+	if path, _ := m.Selected(); path != filepath.Join(left, "a.txt") {
+		t.Fatalf("expected a.txt to be selected, got %q", path)
+	}
+
+	m = run(m, tea.KeyMsg{Type: tea.KeyF5})
+	m = run(m, confirm.ResultMsg{ID: m.confirm.ID(), Confirmed: true})
+	data, err := ioutil.ReadFile(filepath.Join(right, "a.txt"))
+	if err != nil || string(data) != "hello" {
+		t.Fatalf("expected a.txt to be copied, got %q, %v", data, err)
+	}
+	if n := len(m.panes[1].entries); n != 1 {
+		t.Fatalf("expected the right pane to be listed again, got %d entries", n)
+	}
+}
+
+func TestOpen(t *testing.T) {
+	root, left, right := tempDirs(t)
+	defer os.RemoveAll(root)
+	m := New(left, right, WithSize(80, 10))
+	m = run(m, m.Init()())
+
+	m = run(m, tea.KeyMsg{Type: tea.KeyDown})
+	m = run(m, tea.KeyMsg{Type: tea.KeyEnter})
+	if m.Dir(0) != filepath.Join(left, "sub") {
+		t.Fatalf("expected to open sub, got %q", m.Dir(0))
+	}
+	m = run(m, tea.KeyMsg{Type: tea.KeyBackspace})
+	if m.Dir(0) != left {
+		t.Fatalf("expected to go back to the parent, got %q", m.Dir(0))
+	}
+
+	m = run(m, tea.KeyMsg{Type: tea.KeyTab})
+	if m.ActivePane() != 1 {
+		t.Fatal("expected the right pane to be active")
+	}
+}
@@ -0,0 +1,121 @@
+package commander
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Operation is a file operation between the panes.
+type Operation int
+
+// Available operations.
+const (
+	// OperationCopy copies the selected file or directory to the other
+	// pane's directory.
+	OperationCopy Operation = iota
+
+	// OperationMove moves the selected file or directory to the other pane's
+	// directory.
+	OperationMove
+)
+
+// String returns the name of the operation.
+func (o Operation) String() string {
+	switch o {
+	case OperationCopy:
+		return "Copy"
+	case OperationMove:
+		return "Move"
+	}
+	return fmt.Sprintf("Operation(%d)", int(o))
+}
+
+// OperationMsg is sent when a file operation is done. Both panes are listed
+// again when the commander receives it.
+type OperationMsg struct {
+	// ID is the ID of the commander that sent the message.
+	ID int
+
+	Operation Operation
+	Source    string
+	Target    string
+
+	// Err is the error the operation failed with, if any.
+	Err error
+}
+
+// operation is an operation waiting to be confirmed.
+type operation struct {
+	kind           Operation
+	source, target string
+}
+
+// run returns the command that performs the operation.
+func (o operation) run(id int) tea.Cmd {
+	return func() tea.Msg {
+		var err error
+		if _, statErr := os.Lstat(o.target); statErr == nil {
+			err = fmt.Errorf("%s already exists", o.target)
+		} else if o.kind == OperationMove {
+			err = os.Rename(o.source, o.target)
+		} else {
+			err = copyPath(o.source, o.target)
+		}
+		return OperationMsg{
+			ID:        id,
+			Operation: o.kind,
+			Source:    o.source,
+			Target:    o.target,
+			Err:       err,
+		}
+	}
+}
+
+// copyPath copies a file, or a directory and everything in it.
+func copyPath(source, target string) error {
+	return filepath.Walk(source, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(source, path)
+		if err != nil {
+			return err
+		}
+		dst := filepath.Join(target, rel)
+
+		switch {
+		case info.IsDir():
+			return os.MkdirAll(dst, info.Mode().Perm())
+		case info.Mode()&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			return os.Symlink(link, dst)
+		default:
+			return copyFile(path, dst, info.Mode().Perm())
+		}
+	})
+}
+
+func copyFile(source, target string, perm os.FileMode) error {
+	in, err := os.Open(source)
+	if err != nil {
+		return err
+	}
+	defer in.Close() //nolint:errcheck
+
+	out, err := os.OpenFile(target, os.O_WRONLY|os.O_CREATE|os.O_EXCL, perm)
+	if err != nil {
+		return err
+	}
+	if _, err := io.Copy(out, in); err != nil {
+		out.Close() //nolint:errcheck
+		return err
+	}
+	return out.Close()
+}
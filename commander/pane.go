@@ -0,0 +1,111 @@
+package commander
+
+import (
+	"github.com/charmbracelet/bubbles/i18n"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/charmbracelet/bubbles/table"
+)
+
+// parentEntry is the name of the entry that goes to the parent directory.
+const parentEntry = ".."
+
+// pane is one of the two panes: a directory listed in a table.
+type pane struct {
+	dir     string
+	entries []os.FileInfo
+	err     error
+	table   table.Model
+}
+
+func newPane(dir string) pane {
+	if abs, err := filepath.Abs(dir); err == nil {
+		dir = abs
+	}
+	return pane{
+		dir: dir,
+		table: table.New(table.WithColumns([]table.Column{
+			{Title: i18n.Text("Name"), WidthPercent: 100},
+			{Title: i18n.Text("Size"), Width: 9, Kind: table.KindBytes},
+			{Title: i18n.Text("Modified"), Width: 16, Kind: table.KindTimestamp},
+		})),
+	}
+}
+
+// loaded shows the listed entries of the pane's directory, unless it was
+// changed since.
+func (p *pane) loaded(l listing) {
+	if l.dir != p.dir {
+		return
+	}
+	p.entries, p.err = l.entries, l.err
+
+	rows := make([]table.Row, 0, len(p.entries)+1)
+	if filepath.Dir(p.dir) != p.dir {
+		rows = append(rows, table.Row{parentEntry + "/", "", ""})
+	}
+	for _, f := range p.entries {
+		name := f.Name()
+		if f.IsDir() {
+			name += "/"
+		}
+		rows = append(rows, table.Row{name, formatSize(f), f.ModTime().Format(table.TimestampLayout)})
+	}
+	cursor := p.table.Cursor()
+	p.table.SetRows(rows)
+	p.table.SetCursor(cursor)
+}
+
+// selected returns the path of the selected entry, if it's a file or
+// directory in the pane's directory.
+func (p pane) selected() (string, bool) {
+	f, ok := p.selectedEntry()
+	if !ok {
+		return "", false
+	}
+	return filepath.Join(p.dir, f.Name()), true
+}
+
+// selectedDir returns the path of the selected directory, if a directory or
+// the parent directory is selected.
+func (p pane) selectedDir() (string, bool) {
+	if p.parentSelected() {
+		return filepath.Dir(p.dir), true
+	}
+	f, ok := p.selectedEntry()
+	if !ok || !f.IsDir() {
+		return "", false
+	}
+	return filepath.Join(p.dir, f.Name()), true
+}
+
+func (p pane) parentSelected() bool {
+	row := p.table.SelectedRow()
+	return len(row) > 0 && row[0] == parentEntry+"/"
+}
+
+func (p pane) selectedEntry() (os.FileInfo, bool) {
+	i := p.table.Cursor()
+	if filepath.Dir(p.dir) != p.dir {
+		i-- // the parent entry
+	}
+	if i < 0 || i >= len(p.entries) {
+		return nil, false
+	}
+	return p.entries[i], true
+}
+
+// readDir lists a directory, directories first.
+func readDir(dir string) ([]os.FileInfo, error) {
+	entries, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		return entries[i].IsDir() && !entries[j].IsDir()
+	})
+	return entries, nil
+}
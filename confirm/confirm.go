@@ -0,0 +1,253 @@
+// Package confirm provides a yes/no confirmation prompt, typically shown
+// before destructive actions such as deleting something.
+//
+// The prompt is inactive until Ask is called. While it's active it handles
+// key presses and, once the user has made a choice, sends a ResultMsg and
+// becomes inactive again:
+//
+//	case tea.KeyMsg:
+//	    if key.Matches(msg, m.keys.Delete) {
+//	        m.confirm.Ask("Delete this file?")
+//	        return m, nil
+//	    }
+//	case confirm.ResultMsg:
+//	    if msg.ID == m.confirm.ID() && msg.Confirmed {
+//	        return m, deleteFile(m.selected)
+//	    }
+package confirm
+
+import (
+	"github.com/charmbracelet/bubbles/i18n"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/route"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// ResultMsg is sent when the user has answered the prompt.
+type ResultMsg struct {
+	// ID is the ID of the prompt that sent the message.
+	ID int
+
+	// Confirmed is whether the user has confirmed.
+	Confirmed bool
+}
+
+// KeyMap defines the keybindings of the prompt: Yes and No answer it right
+// away, while Toggle switches the highlighted choice and Submit picks it. It
+// satisfies the help.KeyMap interface, so the prompt's keys can be shown with
+// the help bubble.
+type KeyMap struct {
+	Yes    key.Binding
+	No     key.Binding
+	Toggle key.Binding
+	Submit key.Binding
+}
+
+// DefaultKeyMap returns a default set of keybindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Yes: key.NewBinding(
+			key.WithKeys("y", "Y"),
+			key.WithHelp("y", "yes"),
+		),
+		No: key.NewBinding(
+			key.WithKeys("n", "N", "esc"),
+			key.WithHelp("n/esc", "no"),
+		),
+		Toggle: key.NewBinding(
+			key.WithKeys("left", "right", "h", "l", "tab", "shift+tab"),
+			key.WithHelp("←/→", "toggle"),
+		),
+		Submit: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "choose"),
+		),
+	}
+}
+
+// ShortHelp implements the KeyMap interface.
+func (km KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{km.Yes, km.No, km.Toggle, km.Submit}
+}
+
+// FullHelp implements the KeyMap interface.
+func (km KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{km.ShortHelp()}
+}
+
+// Styles contains the styles used to render the prompt.
+type Styles struct {
+	Prompt       lipgloss.Style
+	Button       lipgloss.Style
+	ActiveButton lipgloss.Style
+}
+
+// DefaultStyles returns a set of default style definitions for the prompt.
+func DefaultStyles() Styles {
+	return Styles{
+		Prompt: lipgloss.NewStyle().Bold(true).MarginRight(2),
+		Button: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("252")).
+			Padding(0, 2),
+		ActiveButton: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("230")).
+			Background(lipgloss.Color("204")).
+			Padding(0, 2),
+	}
+}
+
+// Model is the confirmation prompt.
+type Model struct {
+	KeyMap KeyMap
+	Styles Styles
+
+	// Affirmative and Negative are the labels of the buttons.
+	Affirmative string
+	Negative    string
+
+	// DefaultYes sets whether the affirmative button is focused when the
+	// prompt opens. It's off by default, so that pressing enter twice by
+	// accident doesn't confirm anything.
+	DefaultYes bool
+
+	id     int
+	prompt string
+	active bool
+	yes    bool
+}
+
+// Option is used to set options in New.
+type Option func(*Model)
+
+// New creates a new confirmation prompt.
+func New(opts ...Option) Model {
+	m := Model{
+		KeyMap:      DefaultKeyMap(),
+		Styles:      DefaultStyles(),
+		Affirmative: i18n.Text("Yes"),
+		Negative:    i18n.Text("No"),
+		id:          route.NextID(),
+	}
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
+}
+
+// WithLabels sets the labels of the affirmative and negative buttons.
+func WithLabels(affirmative, negative string) Option {
+	return func(m *Model) {
+		m.Affirmative = affirmative
+		m.Negative = negative
+	}
+}
+
+// WithDefaultYes focuses the affirmative button when the prompt opens.
+func WithDefaultYes() Option {
+	return func(m *Model) {
+		m.DefaultYes = true
+	}
+}
+
+// WithStyles sets the styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) {
+		m.Styles = s
+	}
+}
+
+// WithKeyMap sets the key map.
+func WithKeyMap(km KeyMap) Option {
+	return func(m *Model) {
+		m.KeyMap = km
+	}
+}
+
+// ID returns the prompt's unique ID.
+func (m Model) ID() int {
+	return m.id
+}
+
+// Ask opens the prompt with the given question.
+func (m *Model) Ask(prompt string) {
+	m.prompt = prompt
+	m.active = true
+	m.yes = m.DefaultYes
+}
+
+// Cancel closes the prompt without sending a result.
+func (m *Model) Cancel() {
+	m.active = false
+}
+
+// Active returns whether the prompt is open.
+func (m Model) Active() bool {
+	return m.active
+}
+
+// Prompt returns the question of the prompt.
+func (m Model) Prompt() string {
+	return m.prompt
+}
+
+// Update handles key presses while the prompt is open.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.active {
+		return m, nil
+	}
+
+	msg, ok := route.Accept(m.id, msg)
+	if !ok {
+		return m, nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, m.KeyMap.Yes):
+		return m.answer(true)
+	case key.Matches(keyMsg, m.KeyMap.No):
+		return m.answer(false)
+	case key.Matches(keyMsg, m.KeyMap.Toggle):
+		m.yes = !m.yes
+	case key.Matches(keyMsg, m.KeyMap.Submit):
+		return m.answer(m.yes)
+	}
+
+	return m, nil
+}
+
+func (m Model) answer(yes bool) (Model, tea.Cmd) {
+	m.active = false
+	id := m.id
+	return m, func() tea.Msg {
+		return ResultMsg{ID: id, Confirmed: yes}
+	}
+}
+
+// View renders the prompt. It renders nothing if the prompt isn't open.
+func (m Model) View() string {
+	if !m.active {
+		return ""
+	}
+
+	yes, no := m.Styles.Button, m.Styles.Button
+	if m.yes {
+		yes = m.Styles.ActiveButton
+	} else {
+		no = m.Styles.ActiveButton
+	}
+
+	return lipgloss.JoinHorizontal(lipgloss.Center,
+		m.Styles.Prompt.Render(m.prompt),
+		yes.Render(m.Affirmative),
+		" ",
+		no.Render(m.Negative),
+	)
+}
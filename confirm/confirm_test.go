@@ -0,0 +1,50 @@
+package confirm
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestAnswer(t *testing.T) {
+	tests := []struct {
+		name string
+		keys []tea.KeyMsg
+		want bool
+	}{
+		{"yes", []tea.KeyMsg{{Type: tea.KeyRunes, Runes: []rune("y")}}, true},
+		{"no", []tea.KeyMsg{{Type: tea.KeyEsc}}, false},
+		{"enter defaults to no", []tea.KeyMsg{{Type: tea.KeyEnter}}, false},
+		{"toggle and enter", []tea.KeyMsg{{Type: tea.KeyLeft}, {Type: tea.KeyEnter}}, true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			m := New()
+			m.Ask("Sure?")
+
+			var cmd tea.Cmd
+			for _, k := range tc.keys {
+				m, cmd = m.Update(k)
+			}
+			if m.Active() {
+				t.Fatal("expected prompt to be closed")
+			}
+			res, ok := cmd().(ResultMsg)
+			if !ok {
+				t.Fatal("expected a result")
+			}
+			if res.ID != m.ID() || res.Confirmed != tc.want {
+				t.Fatalf("expected confirmed=%v, got %+v", tc.want, res)
+			}
+		})
+	}
+}
+
+func TestInactive(t *testing.T) {
+	m := New()
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if cmd != nil || m.View() != "" {
+		t.Fatal("expected inactive prompt to ignore keys and render nothing")
+	}
+}
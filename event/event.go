@@ -0,0 +1,67 @@
+// Package event defines messages that bubbles send to report what happened to
+// them, such as a selection moving or a row being activated. Because all
+// bubbles use the same message types, parent models can handle events from
+// several components in one place and use the ID to tell them apart:
+//
+//	switch msg := msg.(type) {
+//	case event.SelectionChangedMsg:
+//	    if msg.ID == m.table.ID() {
+//	        m.preview = m.table.SelectedRow()
+//	    }
+//	}
+package event
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// SelectionChangedMsg is sent when the selected item of a component changes.
+type SelectionChangedMsg struct {
+	// ID is the identifier of the component that sent the message.
+	ID int
+
+	// Index is the index of the newly selected item.
+	Index int
+
+	// Previous is the index of the item that was selected before.
+	Previous int
+}
+
+// ActivatedMsg is sent when an item is activated, usually by pressing enter
+// on it.
+type ActivatedMsg struct {
+	// ID is the identifier of the component that sent the message.
+	ID int
+
+	// Index is the index of the activated item.
+	Index int
+}
+
+// EditedMsg is sent when the user has changed a value in a component.
+type EditedMsg struct {
+	// ID is the identifier of the component that sent the message.
+	ID int
+
+	// Row and Column locate the edited value in components that have more
+	// than one, like the table. They're 0 otherwise.
+	Row    int
+	Column int
+
+	// Old is the value before the edit and Value the one after.
+	Old   string
+	Value string
+}
+
+// FocusChangedMsg is sent when a component gains or loses focus.
+type FocusChangedMsg struct {
+	// ID is the identifier of the component that sent the message.
+	ID int
+
+	// Focused is whether the component is now focused.
+	Focused bool
+}
+
+// Cmd returns a command that sends the given event message.
+func Cmd(msg tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		return msg
+	}
+}
@@ -0,0 +1,374 @@
+// Package focus provides a focus manager for composing several bubbles in one
+// view. It routes tab/shift+tab and directional focus movement between child
+// models, calls Focus and Blur on them as focus moves and renders a focus
+// indicator around each child.
+//
+// The manager doesn't own the child models. Instead, pointers to the children
+// are passed in the order focus should cycle through them every time focus
+// changes:
+//
+//	func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+//	    var cmd tea.Cmd
+//	    m.focus, cmd = m.focus.Update(msg, &m.table, &m.input)
+//	    // ...
+//	}
+//
+//	func (m model) View() string {
+//	    return lipgloss.JoinHorizontal(lipgloss.Top,
+//	        m.focus.Render(0, m.table.View()),
+//	        m.focus.Render(1, m.input.View()),
+//	    )
+//	}
+//
+// Because the pointers are taken from the model that's being updated there's
+// no risk of focusing a stale copy of a child.
+package focus
+
+import (
+	"github.com/charmbracelet/bubbles/event"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Target is a child model that can be focused. Besides Blur, a target must
+// have either a Focus() method, like table.Model, or a Focus() tea.Cmd
+// method, like textinput.Model. Pass pointers to your models so the manager
+// can change their focus state.
+type Target interface {
+	Blur()
+}
+
+type focuser interface {
+	Focus()
+}
+
+type cmdFocuser interface {
+	Focus() tea.Cmd
+}
+
+type identifiable interface {
+	ID() int
+}
+
+// Position is the location of a target in a grid layout. It's used for
+// directional focus movement.
+type Position struct {
+	Row int
+	Col int
+}
+
+// KeyMap defines the keybindings that move focus: Next and Prev cycle through
+// the children in order, and Up, Down, Left and Right move focus to the
+// nearest child in that direction. It satisfies the help.KeyMap interface, so
+// the bindings can be listed with the help bubble.
+type KeyMap struct {
+	Next  key.Binding
+	Prev  key.Binding
+	Up    key.Binding
+	Down  key.Binding
+	Left  key.Binding
+	Right key.Binding
+}
+
+// DefaultKeyMap returns a default set of keybindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Next: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "next"),
+		),
+		Prev: key.NewBinding(
+			key.WithKeys("shift+tab"),
+			key.WithHelp("shift+tab", "prev"),
+		),
+		Up: key.NewBinding(
+			key.WithKeys("alt+up"),
+			key.WithHelp("alt+↑", "focus up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("alt+down"),
+			key.WithHelp("alt+↓", "focus down"),
+		),
+		Left: key.NewBinding(
+			key.WithKeys("alt+left"),
+			key.WithHelp("alt+←", "focus left"),
+		),
+		Right: key.NewBinding(
+			key.WithKeys("alt+right"),
+			key.WithHelp("alt+→", "focus right"),
+		),
+	}
+}
+
+// ShortHelp implements the KeyMap interface.
+func (km KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{km.Next, km.Prev}
+}
+
+// FullHelp implements the KeyMap interface.
+func (km KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{km.Next, km.Prev},
+		{km.Up, km.Down, km.Left, km.Right},
+	}
+}
+
+// Styles contains the styles used to render the focus indicator.
+type Styles struct {
+	Focused lipgloss.Style
+	Blurred lipgloss.Style
+}
+
+// DefaultStyles returns a set of default style definitions for the focus
+// indicator.
+func DefaultStyles() Styles {
+	return Styles{
+		Focused: lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("212")),
+		Blurred: lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("240")),
+	}
+}
+
+// Model is the focus manager.
+type Model struct {
+	KeyMap KeyMap
+	Styles Styles
+
+	// Wrap sets whether tab and shift+tab wrap around at the ends.
+	Wrap bool
+
+	// Positions of the targets, by index, for directional movement. Targets
+	// without a position are treated as if they were stacked vertically in
+	// focus order.
+	Positions []Position
+
+	index int
+}
+
+// Option is used to set options in New.
+type Option func(*Model)
+
+// New creates a new focus manager. The first target is focused by default;
+// call Focus with the targets to apply the initial state.
+func New(opts ...Option) Model {
+	m := Model{
+		KeyMap: DefaultKeyMap(),
+		Styles: DefaultStyles(),
+		Wrap:   true,
+	}
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
+}
+
+// WithPositions sets the grid positions of the targets for directional focus
+// movement.
+func WithPositions(p ...Position) Option {
+	return func(m *Model) {
+		m.Positions = p
+	}
+}
+
+// WithGrid lays out n targets in a grid with the given number of columns,
+// filling rows from left to right.
+func WithGrid(n, cols int) Option {
+	return func(m *Model) {
+		if cols < 1 {
+			cols = 1
+		}
+		m.Positions = make([]Position, n)
+		for i := range m.Positions {
+			m.Positions[i] = Position{Row: i / cols, Col: i % cols}
+		}
+	}
+}
+
+// WithStyles sets the focus indicator styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) {
+		m.Styles = s
+	}
+}
+
+// WithKeyMap sets the key map.
+func WithKeyMap(km KeyMap) Option {
+	return func(m *Model) {
+		m.KeyMap = km
+	}
+}
+
+// WithoutWrap stops tab and shift+tab from wrapping around.
+func WithoutWrap() Option {
+	return func(m *Model) {
+		m.Wrap = false
+	}
+}
+
+// Index returns the index of the focused target.
+func (m Model) Index() int {
+	return m.index
+}
+
+// Focused returns whether the target at the given index is focused.
+func (m Model) Focused(i int) bool {
+	return m.index == i
+}
+
+// Update handles focus movement keys. The targets are the focusable children
+// in focus order.
+func (m Model) Update(msg tea.Msg, targets ...Target) (Model, tea.Cmd) {
+	if len(targets) == 0 {
+		return m, nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	next := m.index
+	switch {
+	case key.Matches(keyMsg, m.KeyMap.Next):
+		next = m.step(1, len(targets))
+	case key.Matches(keyMsg, m.KeyMap.Prev):
+		next = m.step(-1, len(targets))
+	case key.Matches(keyMsg, m.KeyMap.Up):
+		next = m.nearest(len(targets), -1, 0)
+	case key.Matches(keyMsg, m.KeyMap.Down):
+		next = m.nearest(len(targets), 1, 0)
+	case key.Matches(keyMsg, m.KeyMap.Left):
+		next = m.nearest(len(targets), 0, -1)
+	case key.Matches(keyMsg, m.KeyMap.Right):
+		next = m.nearest(len(targets), 0, 1)
+	default:
+		return m, nil
+	}
+
+	if next == m.index {
+		return m, nil
+	}
+	cmd := m.Focus(next, targets...)
+	return m, cmd
+}
+
+// Focus focuses the target at index i and blurs all others. It returns the
+// command returned by the target's Focus method, if any.
+//
+// Targets that have an ID() int method, like table.Model, are also reported
+// with event.FocusChangedMsg: one for the target that lost focus and one for
+// the target that gained it.
+func (m *Model) Focus(i int, targets ...Target) tea.Cmd {
+	if i < 0 || i >= len(targets) {
+		return nil
+	}
+	prev := m.index
+	m.index = i
+
+	var cmds []tea.Cmd
+	for j, t := range targets {
+		if j != i {
+			t.Blur()
+			if j == prev {
+				cmds = append(cmds, focusChanged(t, false))
+			}
+			continue
+		}
+		switch t := t.(type) {
+		case cmdFocuser:
+			cmds = append(cmds, t.Focus())
+		case focuser:
+			t.Focus()
+		}
+		cmds = append(cmds, focusChanged(t, true))
+	}
+	return tea.Batch(cmds...)
+}
+
+func focusChanged(t Target, focused bool) tea.Cmd {
+	if t, ok := t.(identifiable); ok {
+		return event.Cmd(event.FocusChangedMsg{ID: t.ID(), Focused: focused})
+	}
+	return nil
+}
+
+// Render wraps the view of the target at index i in the focused or blurred
+// indicator style.
+func (m Model) Render(i int, view string) string {
+	if m.Focused(i) {
+		return m.Styles.Focused.Render(view)
+	}
+	return m.Styles.Blurred.Render(view)
+}
+
+func (m Model) step(delta, n int) int {
+	next := m.index + delta
+	if m.Wrap {
+		return (next + n) % n
+	}
+	return clamp(next, 0, n-1)
+}
+
+func (m Model) position(i int) Position {
+	if i < len(m.Positions) {
+		return m.Positions[i]
+	}
+	return Position{Row: i}
+}
+
+// nearest returns the closest target in the given direction, or the current
+// index if there's none.
+func (m Model) nearest(n, dRow, dCol int) int {
+	cur := m.position(m.index)
+	best, bestDist := m.index, -1
+
+	for i := 0; i < n; i++ {
+		if i == m.index {
+			continue
+		}
+		p := m.position(i)
+		rows, cols := p.Row-cur.Row, p.Col-cur.Col
+
+		// Only consider targets in the requested direction.
+		if (dRow != 0 && rows*dRow <= 0) || (dCol != 0 && cols*dCol <= 0) {
+			continue
+		}
+
+		// Prefer targets along the axis of movement over ones that are
+		// diagonally offset.
+		var dist int
+		if dRow != 0 {
+			dist = abs(rows)*100 + abs(cols)
+		} else {
+			dist = abs(cols)*100 + abs(rows)
+		}
+		if bestDist < 0 || dist < bestDist {
+			best, bestDist = i, dist
+		}
+	}
+
+	return best
+}
+
+func abs(a int) int {
+	if a < 0 {
+		return -a
+	}
+	return a
+}
+
+func clamp(v, low, high int) int {
+	if v < low {
+		return low
+	}
+	if v > high {
+		return high
+	}
+	return v
+}
@@ -0,0 +1,50 @@
+package focus
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type target struct{ focused bool }
+
+func (t *target) Focus() { t.focused = true }
+func (t *target) Blur()  { t.focused = false }
+
+func TestTabCycles(t *testing.T) {
+	a, b, c := &target{}, &target{}, &target{}
+	m := New()
+	m.Focus(0, a, b, c)
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyTab}, a, b, c)
+	if m.Index() != 1 || a.focused || !b.focused {
+		t.Fatalf("expected second target to be focused, got index %d", m.Index())
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}, a, b, c)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}, a, b, c)
+	if m.Index() != 2 || !c.focused || b.focused {
+		t.Fatalf("expected shift+tab to wrap to the last target, got index %d", m.Index())
+	}
+}
+
+func TestDirectionalMovement(t *testing.T) {
+	targets := []Target{&target{}, &target{}, &target{}, &target{}}
+	m := New(WithGrid(len(targets), 2))
+	m.Focus(0, targets...)
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight, Alt: true}, targets...)
+	if m.Index() != 1 {
+		t.Fatalf("expected right to focus index 1, got %d", m.Index())
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown, Alt: true}, targets...)
+	if m.Index() != 3 {
+		t.Fatalf("expected down to focus index 3, got %d", m.Index())
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown, Alt: true}, targets...)
+	if m.Index() != 3 {
+		t.Fatalf("expected focus to stay at the bottom edge, got %d", m.Index())
+	}
+}
@@ -12,5 +12,6 @@ require (
 	github.com/mattn/go-runewidth v0.0.14
 	github.com/muesli/reflow v0.3.0
 	github.com/muesli/termenv v0.11.1-0.20220212125758-44cd13922739
+	github.com/rivo/uniseg v0.2.0
 	github.com/sahilm/fuzzy v0.1.0
 )
@@ -0,0 +1,363 @@
+// Package grid provides a layout engine for dashboards: it places the views of
+// child bubbles into cells of a grid of rows and columns, with cells spanning
+// several of them, gaps between them and minimum sizes for them, and resizes
+// the cells when the window is resized.
+//
+// Like the focus manager, the grid doesn't own the child models. Add a cell
+// for every child, size the children to their cells when the window is
+// resized and render their views into the cells, in the order the cells were
+// added. Children that implement layout.Sizable, such as tables, can be added
+// with AddSizable, which makes their cells at least as large as they need,
+// and sized to their cells with Size:
+//
+//	m.grid = grid.New(2, 2, grid.WithGap(1, 0))
+//	m.grid.Add(grid.Cell{Row: 0, Col: 0, ColSpan: 2, MinHeight: 10})
+//	m.grid.AddSizable(grid.Cell{Row: 1, Col: 0}, &m.table)
+//	m.grid.Add(grid.Cell{Row: 1, Col: 1, MinWidth: 30})
+//
+//	func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+//	    m.grid, _ = m.grid.Update(msg)
+//	    if _, ok := msg.(tea.WindowSizeMsg); ok {
+//	        m.chart, _ = m.chart.Update(m.grid.SizeMsg(0))
+//	        m.grid.Size(1, &m.table)
+//	    }
+//	}
+//
+//	func (m model) View() string {
+//	    return m.grid.Render(m.chart.View(), m.table.View(), m.logs.View())
+//	}
+package grid
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/layout"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Cell is a cell of the grid.
+type Cell struct {
+	// Row and Col are the row and the column of the top left corner of the
+	// cell, counting from 0.
+	Row int
+	Col int
+
+	// RowSpan and ColSpan are the number of rows and columns the cell
+	// spans. A span of 0 spans one.
+	RowSpan int
+	ColSpan int
+
+	// MinWidth and MinHeight are the size the cell needs at least, including
+	// its style's frame. The rows and columns it spans grow to fit it,
+	// taking space from the others.
+	MinWidth  int
+	MinHeight int
+
+	// Style frames the content of the cell, such as with a border. Its
+	// frame is taken from the cell's size.
+	Style lipgloss.Style
+}
+
+func (c Cell) rowSpan() int {
+	return max(1, c.RowSpan)
+}
+
+func (c Cell) colSpan() int {
+	return max(1, c.ColSpan)
+}
+
+// Model is the grid.
+type Model struct {
+	// Rows and Cols are the number of rows and columns.
+	Rows int
+	Cols int
+
+	// GapX is the number of columns between the columns of the grid, and
+	// GapY the number of lines between its rows.
+	GapX int
+	GapY int
+
+	width  int
+	height int
+	cells  []Cell
+
+	// The size of every column and row, computed when the size changes.
+	colWidths  []int
+	rowHeights []int
+}
+
+// Option is used to set options in New.
+type Option func(*Model)
+
+// New creates a new grid with the given number of rows and columns.
+func New(rows, cols int, opts ...Option) Model {
+	m := Model{
+		Rows: max(1, rows),
+		Cols: max(1, cols),
+	}
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	m.reflow()
+	return m
+}
+
+// WithGap sets the gaps between the columns and the rows.
+func WithGap(x, y int) Option {
+	return func(m *Model) {
+		m.GapX = x
+		m.GapY = y
+	}
+}
+
+// WithSize sets the size of the grid.
+func WithSize(width, height int) Option {
+	return func(m *Model) {
+		m.width = width
+		m.height = height
+	}
+}
+
+// Add adds a cell. Cells are numbered in the order they're added.
+func (m *Model) Add(c Cell) {
+	m.cells = append(m.cells, c)
+	m.reflow()
+}
+
+// AddSizable adds a cell for a child that negotiates its size, growing the
+// cell's minimum size to fit the child's.
+func (m *Model) AddSizable(c Cell, child layout.Sizable) {
+	w, h := child.MinSize()
+	c.MinWidth = max(c.MinWidth, w+c.Style.GetHorizontalFrameSize())
+	c.MinHeight = max(c.MinHeight, h+c.Style.GetVerticalFrameSize())
+	m.Add(c)
+}
+
+// Cells returns the cells.
+func (m Model) Cells() []Cell {
+	return m.cells
+}
+
+// SetSize sets the size of the grid and resizes the cells to fit it.
+func (m *Model) SetSize(width, height int) {
+	m.width, m.height = width, height
+	m.reflow()
+}
+
+// Width returns the width of the grid.
+func (m Model) Width() int {
+	return m.width
+}
+
+// Height returns the height of the grid.
+func (m Model) Height() int {
+	return m.height
+}
+
+// Update resizes the grid to the window when the window is resized.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if msg, ok := msg.(tea.WindowSizeMsg); ok {
+		m.SetSize(msg.Width, msg.Height)
+	}
+	return m, nil
+}
+
+// CellSize returns the size of the content of a cell, without its style's
+// frame, to size the child rendered into it to.
+func (m Model) CellSize(i int) (width, height int) {
+	if i < 0 || i >= len(m.cells) {
+		return 0, 0
+	}
+	c := m.cells[i]
+	_, _, w, h := m.bounds(c)
+	return max(0, w-c.Style.GetHorizontalFrameSize()), max(0, h-c.Style.GetVerticalFrameSize())
+}
+
+// Size sizes a child to the content of a cell.
+func (m Model) Size(i int, child layout.Sizable) {
+	child.SetSize(m.CellSize(i))
+}
+
+// SizeMsg returns a window size message with the size of the content of a
+// cell, to pass on to a child that sizes itself to the window.
+func (m Model) SizeMsg(i int) tea.WindowSizeMsg {
+	w, h := m.CellSize(i)
+	return tea.WindowSizeMsg{Width: w, Height: h}
+}
+
+// bounds returns the position and the size of a cell, including its frame.
+func (m Model) bounds(c Cell) (x, y, width, height int) {
+	x, width = span(m.colWidths, m.GapX, c.Col, c.colSpan())
+	y, height = span(m.rowHeights, m.GapY, c.Row, c.rowSpan())
+	return x, y, width, height
+}
+
+// span returns the position and the size of the tracks from start to
+// start+n, including the gaps between them.
+func span(sizes []int, gap, start, n int) (pos, size int) {
+	start = clamp(start, 0, len(sizes))
+	end := clamp(start+n, start, len(sizes))
+	for _, s := range sizes[:start] {
+		pos += s + gap
+	}
+	for _, s := range sizes[start:end] {
+		size += s
+	}
+	if end > start {
+		size += (end - start - 1) * gap
+	}
+	return pos, size
+}
+
+// reflow computes the sizes of the columns and the rows.
+func (m *Model) reflow() {
+	var cols, rows []need
+	for _, c := range m.cells {
+		cols = append(cols, need{c.Col, c.colSpan(), c.MinWidth})
+		rows = append(rows, need{c.Row, c.rowSpan(), c.MinHeight})
+	}
+	m.colWidths = distribute(m.width, m.Cols, m.GapX, cols)
+	m.rowHeights = distribute(m.height, m.Rows, m.GapY, rows)
+}
+
+// need is the size a range of tracks needs at least.
+type need struct {
+	start, n, min int
+}
+
+// distribute divides a size between n tracks with gaps between them: equally,
+// except that tracks grow to fit the sizes the ranges of tracks need, taking
+// space from the tracks that have more than they need.
+func distribute(total, n, gap int, needs []need) []int {
+	available := max(0, total-(n-1)*gap)
+	sizes := make([]int, n)
+	for i := range sizes {
+		sizes[i] = available / n
+		if i < available%n {
+			sizes[i]++
+		}
+	}
+
+	// The size every track needs at least, from the ranges it's in, with
+	// the largest ranges handled last so smaller ones can fit in them.
+	sort.SliceStable(needs, func(i, j int) bool {
+		return needs[i].n < needs[j].n
+	})
+	mins := make([]int, n)
+	for _, nd := range needs {
+		start := clamp(nd.start, 0, n)
+		end := clamp(nd.start+nd.n, start, n)
+		if end == start {
+			continue
+		}
+		have := (end - start - 1) * gap
+		for i := start; i < end; i++ {
+			have += mins[i]
+		}
+		for i := start; have < nd.min; i = start + (i-start+1)%(end-start) {
+			mins[i]++
+			have++
+		}
+	}
+
+	// Grow the tracks that have less than they need, and shrink the others
+	// that have more, from the last one.
+	var deficit int
+	for i := range sizes {
+		if sizes[i] < mins[i] {
+			deficit += mins[i] - sizes[i]
+			sizes[i] = mins[i]
+		}
+	}
+	for deficit > 0 {
+		shrunk := false
+		for i := n - 1; i >= 0 && deficit > 0; i-- {
+			if sizes[i] > mins[i] {
+				sizes[i]--
+				deficit--
+				shrunk = true
+			}
+		}
+		if !shrunk {
+			break
+		}
+	}
+	return sizes
+}
+
+// Render renders the views of the children into the cells, in the order the
+// cells were added. Views are cut to the size of their cell.
+func (m Model) Render(views ...string) string {
+	type segment struct {
+		x    int
+		line string
+	}
+	lines := make([][]segment, m.height)
+
+	for i, c := range m.cells {
+		if i >= len(views) {
+			break
+		}
+		x, y, w, h := m.bounds(c)
+		if w == 0 || h == 0 {
+			continue
+		}
+
+		innerWidth := max(0, w-c.Style.GetHorizontalFrameSize())
+		innerHeight := max(0, h-c.Style.GetVerticalFrameSize())
+		content := lipgloss.NewStyle().
+			Width(innerWidth).MaxWidth(innerWidth).
+			Height(innerHeight).MaxHeight(innerHeight).
+			Render(views[i])
+		box := c.Style.Copy().UnsetWidth().UnsetHeight().Render(content)
+
+		for j, line := range strings.Split(box, "\n") {
+			if y+j >= len(lines) {
+				break
+			}
+			lines[y+j] = append(lines[y+j], segment{x, line})
+		}
+	}
+
+	var b strings.Builder
+	for y, segments := range lines {
+		if y > 0 {
+			b.WriteByte('\n')
+		}
+		sort.SliceStable(segments, func(i, j int) bool {
+			return segments[i].x < segments[j].x
+		})
+		var col int
+		for _, s := range segments {
+			if s.x < col {
+				continue // overlapping cells
+			}
+			b.WriteString(strings.Repeat(" ", s.x-col))
+			b.WriteString(s.line)
+			col = s.x + lipgloss.Width(s.line)
+		}
+		b.WriteString(strings.Repeat(" ", max(0, m.width-col)))
+	}
+	return b.String()
+}
+
+func clamp(v, low, high int) int {
+	if v < low {
+		return low
+	}
+	if v > high {
+		return high
+	}
+	return v
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
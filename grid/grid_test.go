@@ -0,0 +1,73 @@
+package grid
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestDistribute(t *testing.T) {
+	tests := []struct {
+		name  string
+		total int
+		n     int
+		gap   int
+		needs []need
+		exp   []int
+	}{
+		{"equal", 10, 3, 0, nil, []int{4, 3, 3}},
+		{"gaps", 10, 3, 1, nil, []int{3, 3, 2}},
+		{"min", 12, 3, 0, []need{{1, 1, 6}}, []int{3, 6, 3}},
+		{"spanned min", 12, 3, 0, []need{{0, 2, 10}}, []int{5, 5, 2}},
+		{"too small", 4, 2, 0, []need{{0, 1, 3}, {1, 1, 3}}, []int{3, 3}},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := distribute(tc.total, tc.n, tc.gap, tc.needs)
+			for i := range tc.exp {
+				if got[i] != tc.exp[i] {
+					t.Fatalf("expected sizes %v, got %v", tc.exp, got)
+				}
+			}
+		})
+	}
+}
+
+func TestRender(t *testing.T) {
+	m := New(2, 2, WithGap(1, 0))
+	m.Add(Cell{Row: 0, Col: 0, ColSpan: 2})
+	m.Add(Cell{Row: 1, Col: 0})
+	m.Add(Cell{Row: 1, Col: 1})
+	m, _ = m.Update(tea.WindowSizeMsg{Width: 7, Height: 4})
+
+	if w, h := m.CellSize(0); w != 7 || h != 2 {
+		t.Errorf("expected the spanning cell to be 7×2, got %d×%d", w, h)
+	}
+	if msg := m.SizeMsg(2); msg.Width != 3 || msg.Height != 2 {
+		t.Errorf("expected the last cell to be 3×2, got %d×%d", msg.Width, msg.Height)
+	}
+
+	exp := "top    \n       \nab  cd \n       "
+	if got := m.Render("top", "ab", "cd"); got != exp {
+		t.Errorf("expected view %q, got %q", exp, got)
+	}
+}
+
+func TestSizable(t *testing.T) {
+	vp := viewport.New(0, 0)
+	vp.Style = lipgloss.NewStyle().Border(lipgloss.NormalBorder())
+
+	m := New(1, 2, WithSize(20, 10))
+	m.AddSizable(Cell{Row: 0, Col: 0}, &vp)
+	m.Add(Cell{Row: 0, Col: 1})
+	if c := m.Cells()[0]; c.MinWidth != 3 || c.MinHeight != 3 {
+		t.Fatalf("expected the cell to fit the viewport, got %dx%d", c.MinWidth, c.MinHeight)
+	}
+
+	m.Size(0, &vp)
+	if vp.Width != 10 || vp.Height != 10 {
+		t.Fatalf("expected the viewport to be sized to its cell, got %dx%d", vp.Width, vp.Height)
+	}
+}
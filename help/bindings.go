@@ -0,0 +1,80 @@
+package help
+
+import (
+	"sort"
+
+	"github.com/charmbracelet/bubbles/key"
+)
+
+// BindingKeyMap is a KeyMap generated from a list of keybindings, arranged by
+// their groups and priorities (see key.WithGroup and key.WithPriority) instead
+// of by hand.
+type BindingKeyMap []key.Binding
+
+// Bindings returns a KeyMap generated from the given keybindings:
+//
+//	func (k KeyMap) ShortHelp() []key.Binding {
+//	    return help.Bindings(k.Up, k.Down, k.Quit).ShortHelp()
+//	}
+func Bindings(bindings ...key.Binding) BindingKeyMap {
+	return bindings
+}
+
+// ShortHelp returns the keybindings in the order they were given. The ones
+// with the lowest priority are left out first when the help is truncated.
+func (k BindingKeyMap) ShortHelp() []key.Binding {
+	return k
+}
+
+// FullHelp returns a column per group of keybindings, in the order the groups
+// first appear, with the keybindings of every column sorted from the highest
+// priority to the lowest.
+func (k BindingKeyMap) FullHelp() [][]key.Binding {
+	groups := k.groups()
+	columns := make([][]key.Binding, len(groups))
+	for i, g := range groups {
+		columns[i] = g.bindings
+	}
+	return columns
+}
+
+// Sections returns a section per group of keybindings, titled by the group,
+// in the order the groups first appear.
+func (k BindingKeyMap) Sections() SectionKeyMap {
+	groups := k.groups()
+	sections := make(SectionKeyMap, len(groups))
+	for i, g := range groups {
+		sections[i] = Section{Title: g.name, KeyMap: BindingKeyMap(g.bindings)}
+	}
+	return sections
+}
+
+type bindingGroup struct {
+	name     string
+	bindings []key.Binding
+}
+
+// groups returns the keybindings by group, in the order the groups first
+// appear, sorted by priority.
+func (k BindingKeyMap) groups() []bindingGroup {
+	var (
+		groups []bindingGroup
+		index  = make(map[string]int)
+	)
+	for _, b := range k {
+		i, ok := index[b.Group()]
+		if !ok {
+			i = len(groups)
+			index[b.Group()] = i
+			groups = append(groups, bindingGroup{name: b.Group()})
+		}
+		groups[i].bindings = append(groups[i].bindings, b)
+	}
+	for _, g := range groups {
+		bindings := g.bindings
+		sort.SliceStable(bindings, func(i, j int) bool {
+			return bindings[i].Priority() > bindings[j].Priority()
+		})
+	}
+	return groups
+}
@@ -40,6 +40,13 @@ type Styles struct {
 	FullKey       lipgloss.Style
 	FullDesc      lipgloss.Style
 	FullSeparator lipgloss.Style
+
+	// Styling for the titles of sections, see Sections
+	SectionTitle lipgloss.Style
+
+	// Styling for disabled keybindings shown with the reason they're
+	// disabled, see key.WithDisabledReason
+	Disabled lipgloss.Style
 }
 
 // Model contains the state of the help view.
@@ -50,6 +57,12 @@ type Model struct {
 	ShortSeparator string
 	FullSeparator  string
 
+	// The separator between a key and its description.
+	KeySeparator string
+
+	// Compact renders only the keys, without their descriptions.
+	Compact bool
+
 	// The symbol we use in the short help when help items have been truncated
 	// due to width. Periods of ellipsis by default.
 	Ellipsis string
@@ -77,6 +90,7 @@ func New() Model {
 	return Model{
 		ShortSeparator: " • ",
 		FullSeparator:  "    ",
+		KeySeparator:   " ",
 		Ellipsis:       "…",
 		Styles: Styles{
 			ShortKey:       keyStyle,
@@ -86,6 +100,8 @@ func New() Model {
 			FullKey:        keyStyle.Copy(),
 			FullDesc:       descStyle.Copy(),
 			FullSeparator:  sepStyle.Copy(),
+			SectionTitle:   keyStyle.Copy().Bold(true),
+			Disabled:       sepStyle.Copy(),
 		},
 	}
 }
@@ -103,6 +119,9 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 // View renders the help view's current state.
 func (m Model) View(k KeyMap) string {
 	if m.ShowAll {
+		if sections, ok := k.(SectionKeyMap); ok {
+			return m.SectionsView(sections)
+		}
 		return m.FullHelpView(k.FullHelp())
 	}
 	return m.ShortHelpView(k.ShortHelp())
@@ -110,53 +129,103 @@ func (m Model) View(k KeyMap) string {
 
 // ShortHelpView renders a single line help view from a slice of keybindings.
 // If the line is longer than the maximum width it will be gracefully
-// truncated, showing only as many help items as possible.
+// truncated, leaving out the help items with the lowest priority first, and
+// the last of those with the same priority.
 func (m Model) ShortHelpView(bindings []key.Binding) string {
 	if len(bindings) == 0 {
 		return ""
 	}
 
-	var b strings.Builder
-	var totalWidth int
-	var separator = m.Styles.ShortSeparator.Inline(true).Render(m.ShortSeparator)
-
-	for i, kb := range bindings {
-		if !kb.Enabled() {
+	var (
+		items      []string
+		priorities []int
+		separator  = m.Styles.ShortSeparator.Inline(true).Render(m.ShortSeparator)
+	)
+	for _, kb := range bindings {
+		if !shouldRender(kb) {
 			continue
 		}
+		items = append(items, m.shortHelpItem(kb))
+		priorities = append(priorities, kb.Priority())
+	}
 
-		var sep string
-		if totalWidth > 0 && i < len(bindings) {
-			sep = separator
+	kept := make([]bool, len(items))
+	for i := range kept {
+		kept[i] = true
+	}
+	keptWidth := func() (w int) {
+		for i, item := range items {
+			if !kept[i] {
+				continue
+			}
+			if w > 0 {
+				w += lipgloss.Width(separator)
+			}
+			w += lipgloss.Width(item)
 		}
+		return w
+	}
 
-		str := sep +
-			m.Styles.ShortKey.Inline(true).Render(kb.Help().Key) + " " +
-			m.Styles.ShortDesc.Inline(true).Render(kb.Help().Desc)
-
-		w := lipgloss.Width(str)
-
-		// If adding this help item would go over the available width, stop
-		// drawing.
-		if m.Width > 0 && totalWidth+w > m.Width {
-			// Although if there's room for an ellipsis, print that.
-			tail := " " + m.Styles.Ellipsis.Inline(true).Render(m.Ellipsis)
-			tailWidth := lipgloss.Width(tail)
-
-			if totalWidth+tailWidth < m.Width {
-				b.WriteString(tail)
+	// Leave out help items until the rest fits the available width.
+	var truncated bool
+	totalWidth := keptWidth()
+	for m.Width > 0 && totalWidth > m.Width {
+		drop := -1
+		for i := range items {
+			if kept[i] && (drop < 0 || priorities[i] <= priorities[drop]) {
+				drop = i
 			}
-
+		}
+		if drop < 0 {
 			break
 		}
+		kept[drop] = false
+		truncated = true
+		totalWidth = keptWidth()
+	}
+
+	var b strings.Builder
+	for i, item := range items {
+		if !kept[i] {
+			continue
+		}
+		if b.Len() > 0 {
+			b.WriteString(separator)
+		}
+		b.WriteString(item)
+	}
 
-		totalWidth += w
-		b.WriteString(str)
+	// If help items were left out and there's room for an ellipsis, print
+	// that.
+	if truncated {
+		tail := " " + m.Styles.Ellipsis.Inline(true).Render(m.Ellipsis)
+		if totalWidth+lipgloss.Width(tail) < m.Width {
+			b.WriteString(tail)
+		}
 	}
 
 	return b.String()
 }
 
+// shortHelpItem renders a keybinding for the short help.
+func (m Model) shortHelpItem(kb key.Binding) string {
+	if !kb.Enabled() {
+		if m.Compact {
+			return m.Styles.Disabled.Inline(true).Render(kb.Help().Key)
+		}
+		return m.Styles.Disabled.Inline(true).Render(
+			kb.Help().Key + m.KeySeparator + disabledDesc(kb),
+		)
+	}
+
+	str := m.Styles.ShortKey.Inline(true).Render(kb.Help().Key)
+	if m.Compact {
+		return str
+	}
+	return str + m.KeySeparator +
+		m.Styles.ShortDesc.Inline(true).Render(kb.Help().Desc)
+}
+
 // FullHelpView renders help columns from a slice of key binding slices. Each
 // top level slice entry renders into a column.
 func (m Model) FullHelpView(groups [][]key.Binding) string {
@@ -188,18 +257,24 @@ func (m Model) FullHelpView(groups [][]key.Binding) string {
 
 		// Separate keys and descriptions into different slices
 		for _, kb := range group {
-			if !kb.Enabled() {
-				continue
+			switch {
+			case kb.Enabled():
+				keys = append(keys, kb.Help().Key)
+				descriptions = append(descriptions, kb.Help().Desc)
+			case shouldRender(kb):
+				keys = append(keys, m.Styles.Disabled.Inline(true).Render(kb.Help().Key))
+				descriptions = append(descriptions, m.Styles.Disabled.Inline(true).Render(disabledDesc(kb)))
 			}
-			keys = append(keys, kb.Help().Key)
-			descriptions = append(descriptions, kb.Help().Desc)
 		}
 
-		col := lipgloss.JoinHorizontal(lipgloss.Top,
-			m.Styles.FullKey.Render(strings.Join(keys, "\n")),
-			m.Styles.FullKey.Render(" "),
-			m.Styles.FullDesc.Render(strings.Join(descriptions, "\n")),
-		)
+		col := m.Styles.FullKey.Render(strings.Join(keys, "\n"))
+		if !m.Compact {
+			col = lipgloss.JoinHorizontal(lipgloss.Top,
+				col,
+				m.Styles.FullKey.Render(m.KeySeparator),
+				m.Styles.FullDesc.Render(strings.Join(descriptions, "\n")),
+			)
+		}
 
 		// Column
 		totalWidth += lipgloss.Width(col)
@@ -225,9 +300,21 @@ func (m Model) FullHelpView(groups [][]key.Binding) string {
 
 func shouldRenderColumn(b []key.Binding) (ok bool) {
 	for _, v := range b {
-		if v.Enabled() {
+		if shouldRender(v) {
 			return true
 		}
 	}
 	return false
 }
+
+// shouldRender returns whether a keybinding is shown in help: if it's
+// enabled, or disabled with a reason.
+func shouldRender(b key.Binding) bool {
+	return b.Enabled() || b.DisabledReason() != ""
+}
+
+// disabledDesc returns the description of a disabled keybinding, followed
+// by the reason it's disabled.
+func disabledDesc(b key.Binding) string {
+	return b.Help().Desc + " (" + b.DisabledReason() + ")"
+}
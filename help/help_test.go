@@ -0,0 +1,120 @@
+package help
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+)
+
+func plainHelp(width int) Model {
+	m := New()
+	m.Width = width
+	m.Styles = Styles{}
+	return m
+}
+
+func TestShortHelpPriorities(t *testing.T) {
+	bindings := []key.Binding{
+		key.NewBinding(key.WithKeys("q"), key.WithHelp("q", "quit"), key.WithPriority(2)),
+		key.NewBinding(key.WithKeys("up"), key.WithHelp("↑", "up")),
+		key.NewBinding(key.WithKeys("down"), key.WithHelp("↓", "down")),
+		key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "help"), key.WithPriority(1)),
+	}
+
+	for _, tc := range []struct {
+		width    int
+		expected string
+	}{
+		{0, "q quit • ↑ up • ↓ down • ? help"},
+		{30, "q quit • ↑ up • ? help …"},
+		{20, "q quit • ? help …"},
+		{9, "q quit …"},
+		{8, "q quit"},
+	} {
+		if got := plainHelp(tc.width).ShortHelpView(bindings); got != tc.expected {
+			t.Errorf("width %d: expected %q, got %q", tc.width, tc.expected, got)
+		}
+	}
+}
+
+func TestCompactHelp(t *testing.T) {
+	bindings := []key.Binding{
+		key.NewBinding(key.WithKeys("q"), key.WithHelp("q", "quit")),
+		key.NewBinding(key.WithKeys("?"), key.WithHelp("?", "help")),
+	}
+
+	m := plainHelp(0)
+	m.Compact = true
+	m.ShortSeparator = " "
+	if got := m.ShortHelpView(bindings); got != "q ?" {
+		t.Errorf("expected only keys, got %q", got)
+	}
+
+	m.Compact = false
+	m.KeySeparator = ": "
+	if got := m.ShortHelpView(bindings); got != "q: quit ?: help" {
+		t.Errorf("expected the key separator, got %q", got)
+	}
+
+	m.Width = 80
+	m.Compact = true
+	if got := m.FullHelpView([][]key.Binding{bindings}); got != "q    \n?    " {
+		t.Errorf("expected a column of keys, got %q", got)
+	}
+}
+
+type testKeyMap []key.Binding
+
+func (k testKeyMap) ShortHelp() []key.Binding  { return k }
+func (k testKeyMap) FullHelp() [][]key.Binding { return [][]key.Binding{k} }
+
+func TestSections(t *testing.T) {
+	sections := Sections(map[string]KeyMap{
+		"Viewer": testKeyMap{key.NewBinding(key.WithKeys("j"), key.WithHelp("j", "down"))},
+		"App":    testKeyMap{key.NewBinding(key.WithKeys("q"), key.WithHelp("q", "quit"))},
+		"Empty":  testKeyMap{key.NewBinding(key.WithHelp("x", "nothing"))},
+	})
+
+	m := plainHelp(80)
+	if got := m.View(sections); got != "q quit • j down" {
+		t.Errorf("expected the short help of all sections, got %q", got)
+	}
+
+	m.ShowAll = true
+	expected := "App\nq quit    \n\nViewer\nj down    "
+	if got := m.View(sections); got != expected {
+		t.Errorf("expected:\n%q\ngot:\n%q", expected, got)
+	}
+}
+
+func TestDisabledHelp(t *testing.T) {
+	bindings := []key.Binding{
+		key.NewBinding(key.WithKeys("q"), key.WithHelp("q", "quit")),
+		key.NewBinding(key.WithKeys("d"), key.WithHelp("d", "delete"), key.WithDisabledReason("read-only")),
+		key.NewBinding(key.WithKeys("x"), key.WithHelp("x", "cut"), key.WithDisabled()),
+	}
+	if got := plainHelp(0).ShortHelpView(bindings); got != "q quit • d delete (read-only)" {
+		t.Errorf("expected the disabled binding with its reason, got %q", got)
+	}
+}
+
+func TestBindings(t *testing.T) {
+	k := Bindings(
+		key.NewBinding(key.WithKeys("up"), key.WithHelp("↑", "up"), key.WithGroup("Move")),
+		key.NewBinding(key.WithKeys("q"), key.WithHelp("q", "quit"), key.WithGroup("App")),
+		key.NewBinding(key.WithKeys("g"), key.WithHelp("g", "top"), key.WithGroup("Move")),
+		key.NewBinding(key.WithKeys("down"), key.WithHelp("↓", "down"), key.WithGroup("Move"), key.WithPriority(1)),
+	)
+
+	m := plainHelp(80)
+	m.ShowAll = true
+	expected := "↓ down    q quit    \n↑ up                \ng top               "
+	if got := m.View(k); got != expected {
+		t.Errorf("expected:\n%q\ngot:\n%q", expected, got)
+	}
+
+	expected = "Move\n↓ down    \n↑ up      \ng top     \n\nApp\nq quit    "
+	if got := m.View(k.Sections()); got != expected {
+		t.Errorf("expected:\n%q\ngot:\n%q", expected, got)
+	}
+}
@@ -0,0 +1,84 @@
+package help
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+)
+
+// Section is a KeyMap with a title, such as the keybindings of one of the
+// components of a program.
+type Section struct {
+	Title  string
+	KeyMap KeyMap
+}
+
+// SectionKeyMap is a KeyMap that merges the keybindings of several sections.
+// Its short help lists the short help of all sections, and the full help view
+// renders the full help of every section under its title.
+type SectionKeyMap []Section
+
+// Sections merges keymaps into a KeyMap with a section per keymap, titled by
+// its key in the map and sorted by title:
+//
+//	m.help.View(help.Sections(map[string]help.KeyMap{
+//	    "Table":  m.table.KeyMap,
+//	    "Viewer": m.viewport.KeyMap,
+//	    "App":    m.keys,
+//	}))
+func Sections(keymaps map[string]KeyMap) SectionKeyMap {
+	sections := make(SectionKeyMap, 0, len(keymaps))
+	for title, k := range keymaps {
+		sections = append(sections, Section{Title: title, KeyMap: k})
+	}
+	sort.Slice(sections, func(i, j int) bool {
+		return sections[i].Title < sections[j].Title
+	})
+	return sections
+}
+
+// ShortHelp returns the short help of every section.
+func (s SectionKeyMap) ShortHelp() []key.Binding {
+	var bindings []key.Binding
+	for _, section := range s {
+		bindings = append(bindings, section.KeyMap.ShortHelp()...)
+	}
+	return bindings
+}
+
+// FullHelp returns the columns of the full help of every section.
+func (s SectionKeyMap) FullHelp() [][]key.Binding {
+	var groups [][]key.Binding
+	for _, section := range s {
+		groups = append(groups, section.KeyMap.FullHelp()...)
+	}
+	return groups
+}
+
+// SectionsView renders the full help of every section under its title, if it
+// has one, leaving out the sections without enabled keybindings.
+func (m Model) SectionsView(sections SectionKeyMap) string {
+	var out []string
+	for _, section := range sections {
+		groups := section.KeyMap.FullHelp()
+		if !shouldRenderSection(groups) {
+			continue
+		}
+		view := m.FullHelpView(groups)
+		if section.Title != "" {
+			view = m.Styles.SectionTitle.Inline(true).Render(section.Title) + "\n" + view
+		}
+		out = append(out, view)
+	}
+	return strings.Join(out, "\n\n")
+}
+
+func shouldRenderSection(groups [][]key.Binding) bool {
+	for _, group := range groups {
+		if shouldRenderColumn(group) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,410 @@
+// Package historypicker provides a searchable history picker in the style of
+// the reverse incremental search of shells (ctrl+r). It searches a list of
+// strings, such as shell history, recent files or past commands, as the user
+// types, most recent first, and shows a preview of the highlighted entry.
+//
+// The picker is inactive until Open is called. While it's active it handles
+// key presses and, once the user accepts an entry or cancels, sends an
+// AcceptedMsg or a CanceledMsg and becomes inactive again:
+//
+//	case tea.KeyMsg:
+//	    if key.Matches(msg, m.keys.Search) {
+//	        return m, m.history.Open()
+//	    }
+//	case historypicker.AcceptedMsg:
+//	    m.input.SetValue(msg.Value)
+package historypicker
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/i18n"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/route"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
+)
+
+// AcceptedMsg is sent when the user accepts an entry.
+type AcceptedMsg struct {
+	// ID is the ID of the picker that sent the message.
+	ID int
+
+	// Index is the index of the entry in the entries, and Value the entry.
+	Index int
+	Value string
+}
+
+// CanceledMsg is sent when the user closes the picker without accepting an
+// entry.
+type CanceledMsg struct {
+	// ID is the ID of the picker that sent the message.
+	ID int
+}
+
+// KeyMap defines the keybindings of the picker while it's open. It satisfies
+// the help.KeyMap interface, so the bindings can be shown below the search
+// input with the help bubble.
+type KeyMap struct {
+	// Older and Newer move the highlight to the next and the previous match.
+	Older  key.Binding
+	Newer  key.Binding
+	Accept key.Binding
+	Cancel key.Binding
+}
+
+// DefaultKeyMap returns a default set of keybindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Older: key.NewBinding(
+			key.WithKeys("ctrl+r", "up", "ctrl+p"),
+			key.WithHelp("ctrl+r/↑", "older"),
+		),
+		Newer: key.NewBinding(
+			key.WithKeys("ctrl+s", "down", "ctrl+n"),
+			key.WithHelp("ctrl+s/↓", "newer"),
+		),
+		Accept: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "accept"),
+		),
+		Cancel: key.NewBinding(
+			key.WithKeys("esc", "ctrl+g", "ctrl+c"),
+			key.WithHelp("esc", "cancel"),
+		),
+	}
+}
+
+// ShortHelp implements the KeyMap interface.
+func (km KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{km.Older, km.Newer, km.Accept, km.Cancel}
+}
+
+// FullHelp implements the KeyMap interface.
+func (km KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{km.ShortHelp()}
+}
+
+// Styles contains the styles used to render the picker.
+type Styles struct {
+	Entry         lipgloss.Style
+	SelectedEntry lipgloss.Style
+
+	// Matched styles the characters of an entry that match the query.
+	Matched lipgloss.Style
+
+	Preview lipgloss.Style
+	NoMatch lipgloss.Style
+}
+
+// DefaultStyles returns a set of default style definitions for the picker.
+func DefaultStyles() Styles {
+	return Styles{
+		Entry: lipgloss.NewStyle().PaddingLeft(2),
+		SelectedEntry: lipgloss.NewStyle().
+			Border(lipgloss.NormalBorder(), false, false, false, true).
+			BorderForeground(lipgloss.Color("212")).
+			Foreground(lipgloss.Color("212")).
+			PaddingLeft(1),
+		Matched: lipgloss.NewStyle().Underline(true),
+		Preview: lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("240")).
+			Padding(0, 1),
+		NoMatch: lipgloss.NewStyle().Foreground(lipgloss.Color("240")).PaddingLeft(2),
+	}
+}
+
+// PreviewFunc renders the preview of an entry.
+type PreviewFunc func(entry string) string
+
+// Model is the history picker.
+type Model struct {
+	KeyMap KeyMap
+	Styles Styles
+
+	// Input is the query input. Its prompt is "(reverse-i-search) " by
+	// default.
+	Input textinput.Model
+
+	// Height is the number of matches shown at once.
+	Height int
+
+	// Width is the width of the preview. The preview is as wide as the
+	// entry if it's 0.
+	Width int
+
+	// Preview renders the preview of the highlighted entry. If it's nil the
+	// entry itself is shown; set ShowPreview to false to hide the preview.
+	Preview     PreviewFunc
+	ShowPreview bool
+
+	id      int
+	active  bool
+	entries []string
+	matches []fuzzy.Match
+	cursor  int
+	offset  int
+}
+
+// Option is used to set options in New.
+type Option func(*Model)
+
+// New creates a new history picker over the given entries, oldest first, as
+// history is usually stored.
+func New(entries []string, opts ...Option) Model {
+	input := textinput.New()
+	input.Prompt = i18n.Text("(reverse-i-search) ")
+
+	m := Model{
+		KeyMap:      DefaultKeyMap(),
+		Styles:      DefaultStyles(),
+		Input:       input,
+		Height:      10, //nolint:gomnd
+		ShowPreview: true,
+		id:          route.NextID(),
+		entries:     entries,
+	}
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
+}
+
+// WithHeight sets the number of matches shown at once.
+func WithHeight(h int) Option {
+	return func(m *Model) {
+		m.Height = h
+	}
+}
+
+// WithPreview sets the function that renders the preview of an entry.
+func WithPreview(f PreviewFunc) Option {
+	return func(m *Model) {
+		m.Preview = f
+	}
+}
+
+// WithoutPreview hides the preview.
+func WithoutPreview() Option {
+	return func(m *Model) {
+		m.ShowPreview = false
+	}
+}
+
+// WithStyles sets the styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) {
+		m.Styles = s
+	}
+}
+
+// WithKeyMap sets the key map.
+func WithKeyMap(km KeyMap) Option {
+	return func(m *Model) {
+		m.KeyMap = km
+	}
+}
+
+// ID returns the picker's unique ID.
+func (m Model) ID() int {
+	return m.id
+}
+
+// SetEntries sets the entries to search, oldest first.
+func (m *Model) SetEntries(entries []string) {
+	m.entries = entries
+	m.search()
+}
+
+// Entries returns the entries, oldest first.
+func (m Model) Entries() []string {
+	return m.entries
+}
+
+// Open opens the picker with an empty query. It returns the command that
+// makes the query's cursor blink.
+func (m *Model) Open() tea.Cmd {
+	m.active = true
+	m.Input.SetValue("")
+	m.search()
+	return m.Input.Focus()
+}
+
+// Close closes the picker without sending a message.
+func (m *Model) Close() {
+	m.active = false
+	m.Input.Blur()
+}
+
+// Active returns whether the picker is open.
+func (m Model) Active() bool {
+	return m.active
+}
+
+// Highlighted returns the highlighted entry, if any entry matches.
+func (m Model) Highlighted() (string, bool) {
+	if m.cursor >= len(m.matches) {
+		return "", false
+	}
+	return m.matches[m.cursor].Str, true
+}
+
+// Update handles key presses while the picker is open.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.active {
+		return m, nil
+	}
+
+	msg, ok := route.Accept(m.id, msg)
+	if !ok {
+		return m, nil
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.KeyMap.Older):
+			m.moveCursor(1)
+			return m, nil
+		case key.Matches(msg, m.KeyMap.Newer):
+			m.moveCursor(-1)
+			return m, nil
+		case key.Matches(msg, m.KeyMap.Accept):
+			return m.accept()
+		case key.Matches(msg, m.KeyMap.Cancel):
+			m.Close()
+			id := m.id
+			return m, func() tea.Msg {
+				return CanceledMsg{ID: id}
+			}
+		}
+	}
+
+	query := m.Input.Value()
+	var cmd tea.Cmd
+	m.Input, cmd = m.Input.Update(msg)
+	if m.Input.Value() != query {
+		m.search()
+	}
+	return m, cmd
+}
+
+func (m Model) accept() (Model, tea.Cmd) {
+	if m.cursor >= len(m.matches) {
+		return m, nil
+	}
+	m.Close()
+	id, match := m.id, m.matches[m.cursor]
+	index := len(m.entries) - 1 - match.Index
+	return m, func() tea.Msg {
+		return AcceptedMsg{ID: id, Index: index, Value: match.Str}
+	}
+}
+
+// search finds the entries matching the query, best matches first and the
+// most recent of equally good matches first, and highlights the first one.
+func (m *Model) search() {
+	m.cursor, m.offset = 0, 0
+
+	// Search the most recent entries first.
+	recent := make([]string, len(m.entries))
+	for i, e := range m.entries {
+		recent[len(m.entries)-1-i] = e
+	}
+
+	query := m.Input.Value()
+	if query == "" {
+		m.matches = make([]fuzzy.Match, len(recent))
+		for i, e := range recent {
+			m.matches[i] = fuzzy.Match{Str: e, Index: i}
+		}
+		return
+	}
+	matches := fuzzy.Find(query, recent)
+	sort.Stable(matches)
+	m.matches = matches
+}
+
+// moveCursor moves the highlight by n matches, scrolling the matches shown.
+func (m *Model) moveCursor(n int) {
+	m.cursor = clamp(m.cursor+n, 0, len(m.matches)-1)
+	if m.cursor < m.offset {
+		m.offset = m.cursor
+	}
+	if m.Height > 0 && m.cursor >= m.offset+m.Height {
+		m.offset = m.cursor - m.Height + 1
+	}
+}
+
+// View renders the picker: the query, the matches, the most recent at the
+// top, and the preview of the highlighted one. It renders nothing if the
+// picker isn't open.
+func (m Model) View() string {
+	if !m.active {
+		return ""
+	}
+
+	lines := []string{m.Input.View()}
+	if len(m.matches) == 0 {
+		lines = append(lines, m.Styles.NoMatch.Render(i18n.Text("no matches")))
+	}
+
+	end := len(m.matches)
+	if m.Height > 0 {
+		end = min(end, m.offset+m.Height)
+	}
+	for i := m.offset; i < end; i++ {
+		match := m.matches[i]
+		style := m.Styles.Entry
+		if i == m.cursor {
+			style = m.Styles.SelectedEntry
+		}
+		// Only the first line of multi-line entries is listed.
+		entry := strings.SplitN(match.Str, "\n", 2)[0] //nolint:gomnd
+		if len(match.MatchedIndexes) > 0 {
+			unmatched := style.Copy().Inline(true)
+			matched := unmatched.Copy().Inherit(m.Styles.Matched)
+			entry = lipgloss.StyleRunes(entry, match.MatchedIndexes, matched, unmatched)
+		}
+		lines = append(lines, style.Render(entry))
+	}
+
+	if entry, ok := m.Highlighted(); ok && m.ShowPreview {
+		preview := entry
+		if m.Preview != nil {
+			preview = m.Preview(entry)
+		}
+		style := m.Styles.Preview.Copy()
+		if m.Width > 0 {
+			style = style.Width(m.Width - style.GetHorizontalBorderSize())
+		}
+		lines = append(lines, style.Render(preview))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func clamp(v, low, high int) int {
+	if high < low {
+		return low
+	}
+	if v < low {
+		return low
+	}
+	if v > high {
+		return high
+	}
+	return v
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,50 @@
+package historypicker
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/bubbletest"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSearch(t *testing.T) {
+	m := New([]string{"git status", "go test ./...", "git commit", "ls"})
+	m.Open()
+
+	if entry, _ := m.Highlighted(); entry != "ls" {
+		t.Fatalf("expected the most recent entry without a query, got %q", entry)
+	}
+
+	m = bubbletest.TypeString(m, "git").(Model)
+	if entry, _ := m.Highlighted(); entry != "git commit" {
+		t.Fatalf("expected the most recent match, got %q", entry)
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	if entry, _ := m.Highlighted(); entry != "git status" {
+		t.Fatalf("expected the next older match, got %q", entry)
+	}
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	msg, ok := cmd().(AcceptedMsg)
+	if !ok || msg.Value != "git status" || msg.Index != 0 || msg.ID != m.ID() {
+		t.Fatalf("expected git status to be accepted, got %#v", msg)
+	}
+	if m.Active() {
+		t.Fatal("expected the picker to close")
+	}
+}
+
+func TestCancel(t *testing.T) {
+	m := New([]string{"ls"})
+	m.Open()
+	m = bubbletest.TypeString(m, "nothing").(Model)
+	if _, ok := m.Highlighted(); ok {
+		t.Fatal("expected no match")
+	}
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if _, ok := cmd().(CanceledMsg); !ok || m.Active() {
+		t.Fatal("expected the picker to be canceled")
+	}
+}
@@ -0,0 +1,129 @@
+// Package i18n translates the strings bubbles show, such as help
+// descriptions, status bar text and prompts, so that applications in other
+// languages don't show English chrome.
+//
+// Strings are looked up by their English text in the catalog of the current
+// language. Applications register catalogs with SetMessages and pick the
+// language with SetLanguage, which defaults to the one of the environment
+// (LC_ALL, LC_MESSAGES or LANG):
+//
+//	i18n.SetMessages("de", i18n.Messages{
+//	    "filter":          "filtern",
+//	    "Nothing matched": "Keine Treffer",
+//	    "%d filtered":     "%d ausgefiltert",
+//	})
+//	i18n.SetLanguage("de")
+//
+// Strings without a translation are shown in English. Help descriptions are
+// translated when help is rendered, but strings that bubbles set when they're
+// created, such as the filter prompt of a list, are translated then: set the
+// language and messages before creating bubbles.
+package i18n
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+)
+
+// English is the language of the strings of the bubbles.
+const English = "en"
+
+// Messages maps the English strings of the bubbles to their translations.
+// Format strings, such as "%d filtered", take the same arguments in the same
+// order; use explicit argument indexes, such as "%[2]s", to reorder them.
+type Messages map[string]string
+
+var (
+	mtx      sync.RWMutex
+	language = envLanguage()
+	catalogs = make(map[string]Messages)
+)
+
+// SetLanguage sets the language strings are translated to, such as "de" or
+// "pt-BR". Regional languages fall back to the messages of their base
+// language, and English is used for strings neither has.
+func SetLanguage(lang string) {
+	mtx.Lock()
+	defer mtx.Unlock()
+	language = normalize(lang)
+}
+
+// Language returns the language strings are translated to.
+func Language() string {
+	mtx.RLock()
+	defer mtx.RUnlock()
+	return language
+}
+
+// SetMessages adds translations to the catalog of a language, replacing the
+// translations it already has for the same strings.
+func SetMessages(lang string, msgs Messages) {
+	mtx.Lock()
+	defer mtx.Unlock()
+
+	lang = normalize(lang)
+	catalog, ok := catalogs[lang]
+	if !ok {
+		catalog = make(Messages, len(msgs))
+		catalogs[lang] = catalog
+	}
+	for s, t := range msgs {
+		catalog[s] = t
+	}
+}
+
+// Text returns the translation of an English string in the current language,
+// or the string itself if it has no translation.
+func Text(s string) string {
+	mtx.RLock()
+	defer mtx.RUnlock()
+
+	lang := language
+	for lang != "" && lang != English {
+		if t, ok := catalogs[lang][s]; ok {
+			return t
+		}
+		lang = base(lang)
+	}
+	return s
+}
+
+// Format translates an English format string and formats it with the given
+// arguments, as fmt.Sprintf does.
+func Format(format string, args ...interface{}) string {
+	return fmt.Sprintf(Text(format), args...)
+}
+
+// normalize returns a language tag in the form "pt-br", given a tag or a
+// locale such as "pt_BR.UTF-8".
+func normalize(lang string) string {
+	if i := strings.IndexAny(lang, ".@"); i >= 0 {
+		lang = lang[:i]
+	}
+	lang = strings.ToLower(strings.ReplaceAll(lang, "_", "-"))
+	if lang == "" || lang == "c" || lang == "posix" {
+		return English
+	}
+	return lang
+}
+
+// base returns the base language of a regional language, such as "pt" for
+// "pt-br", or an empty string for a base language.
+func base(lang string) string {
+	if i := strings.LastIndex(lang, "-"); i >= 0 {
+		return lang[:i]
+	}
+	return ""
+}
+
+// envLanguage returns the language of the environment.
+func envLanguage() string {
+	for _, v := range []string{"LC_ALL", "LC_MESSAGES", "LANG"} {
+		if lang := os.Getenv(v); lang != "" {
+			return normalize(lang)
+		}
+	}
+	return English
+}
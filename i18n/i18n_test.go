@@ -0,0 +1,43 @@
+package i18n
+
+import "testing"
+
+func TestText(t *testing.T) {
+	defer SetLanguage(Language())
+
+	SetMessages("pt", Messages{"filter": "filtrar", "%d filtered": "%d filtrados"})
+	SetMessages("pt-BR", Messages{"filter": "filtrar itens"})
+
+	tests := []struct {
+		lang, s, expected string
+	}{
+		{"pt", "filter", "filtrar"},
+		{"pt_BR.UTF-8", "filter", "filtrar itens"},
+		{"pt-BR", "%d filtered", "%d filtrados"},
+		{"pt", "sort", "sort"},
+		{"en", "filter", "filter"},
+		{"C", "filter", "filter"},
+	}
+	for _, tt := range tests {
+		SetLanguage(tt.lang)
+		if got := Text(tt.s); got != tt.expected {
+			t.Errorf("%s: expected %q for %q, got %q", tt.lang, tt.expected, tt.s, got)
+		}
+	}
+
+	SetLanguage("pt")
+	if got := Format("%d filtered", 3); got != "3 filtrados" {
+		t.Errorf("expected the translation to be formatted, got %q", got)
+	}
+}
+
+func TestSetMessagesMerges(t *testing.T) {
+	defer SetLanguage(Language())
+
+	SetMessages("fr", Messages{"up": "haut", "down": "bas"})
+	SetMessages("fr", Messages{"down": "en bas"})
+	SetLanguage("fr")
+	if Text("up") != "haut" || Text("down") != "en bas" {
+		t.Fatalf("expected messages to be merged, got %q and %q", Text("up"), Text("down"))
+	}
+}
@@ -2,8 +2,10 @@ package cursor
 
 import (
 	"context"
+	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/accessibility"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -49,11 +51,36 @@ func (c Mode) String() string {
 	}[c]
 }
 
+// Shape describes what the cursor looks like.
+type Shape int
+
+// Available cursor shapes.
+const (
+	// ShapeBlock renders the character under the cursor in reverse video.
+	ShapeBlock Shape = iota
+	// ShapeUnderline underlines the character under the cursor.
+	ShapeUnderline
+	// ShapeBar renders a thin bar in place of the character under the
+	// cursor, which shows when the cursor blinks off.
+	ShapeBar
+)
+
+// String returns the cursor shape in a human-readable format.
+func (s Shape) String() string {
+	return [...]string{
+		"block",
+		"underline",
+		"bar",
+	}[s]
+}
+
 // Model is the Bubble Tea model for this cursor element.
 type Model struct {
 	BlinkSpeed time.Duration
 	// Style for styling the cursor block.
 	Style lipgloss.Style
+	// Shape of the cursor.
+	Shape Shape
 	// TextStyle is the style used for the cursor when it is hidden (when blinking).
 	// I.e. displaying normal text.
 	TextStyle lipgloss.Style
@@ -152,6 +179,12 @@ func (m *Model) BlinkCmd() tea.Cmd {
 		return nil
 	}
 
+	// With reduced motion the cursor is shown without blinking.
+	if accessibility.ReducedMotion() {
+		m.Blink = false
+		return nil
+	}
+
 	if m.blinkCtx != nil && m.blinkCtx.cancel != nil {
 		m.blinkCtx.cancel()
 	}
@@ -203,5 +236,16 @@ func (m Model) View() string {
 	if m.Blink {
 		return m.TextStyle.Inline(true).Render(m.char)
 	}
+	switch m.Shape {
+	case ShapeUnderline:
+		return m.Style.Inline(true).Underline(true).Render(m.char)
+	case ShapeBar:
+		// Keep the width of wide characters so the text after it stays put.
+		bar := "▏"
+		if w := lipgloss.Width(m.char); w > 1 {
+			bar += strings.Repeat(" ", w-1)
+		}
+		return m.Style.Inline(true).Render(bar)
+	}
 	return m.Style.Inline(true).Reverse(true).Render(m.char)
 }
@@ -0,0 +1,73 @@
+package key
+
+import "sync"
+
+// Capability is a capability of the terminal that some keys need to be
+// delivered to the program.
+type Capability uint
+
+// Terminal capabilities.
+const (
+	// ExtendedKeys is set if the terminal reports keys that legacy terminals
+	// can't tell apart from others, such as shift+enter, through a protocol
+	// like the kitty keyboard protocol.
+	ExtendedKeys Capability = 1 << iota
+)
+
+var (
+	capabilities   Capability
+	capabilitiesMu sync.RWMutex
+)
+
+// SetCapabilities sets the capabilities of the terminal the program runs in.
+// Keybindings with alternates use them when the terminal lacks a capability
+// their keys need. No capabilities are set by default.
+func SetCapabilities(c Capability) {
+	capabilitiesMu.Lock()
+	defer capabilitiesMu.Unlock()
+	capabilities = c
+}
+
+// Capabilities returns the capabilities of the terminal set with
+// SetCapabilities.
+func Capabilities() Capability {
+	capabilitiesMu.RLock()
+	defer capabilitiesMu.RUnlock()
+	return capabilities
+}
+
+// WithAlternates initializes a keybinding with alternate keys, used instead
+// of its keys when the terminal lacks the capabilities they need. For example:
+//
+//	key.NewBinding(
+//	    key.WithKeys("shift+enter"),
+//	    key.WithHelp("shift+enter", "new line"),
+//	    key.WithAlternates(key.ExtendedKeys, "ctrl+j"),
+//	    key.WithAlternateHelp("ctrl+j"),
+//	)
+func WithAlternates(needs Capability, keys ...string) BindingOpt {
+	return func(b *Binding) {
+		b.SetAlternates(needs, keys...)
+	}
+}
+
+// WithAlternateHelp initializes the help text of the key of a keybinding,
+// shown while its alternates are used.
+func WithAlternateHelp(key string) BindingOpt {
+	return func(b *Binding) {
+		b.alternateHelp = key
+	}
+}
+
+// SetAlternates sets the alternate keys of the keybinding, used instead of its
+// keys when the terminal lacks the capabilities they need.
+func (b *Binding) SetAlternates(needs Capability, keys ...string) {
+	b.needs = needs
+	b.alternates = keys
+}
+
+// usingAlternates returns whether the keybinding uses its alternates instead
+// of its keys.
+func (b Binding) usingAlternates() bool {
+	return b.alternates != nil && Capabilities()&b.needs != b.needs
+}
@@ -37,6 +37,7 @@
 package key
 
 import (
+	"github.com/charmbracelet/bubbles/i18n"
 	tea "github.com/charmbracelet/bubbletea"
 )
 
@@ -46,6 +47,21 @@ type Binding struct {
 	keys     []string
 	help     Help
 	disabled bool
+	priority int
+	group    string
+
+	// Keys used instead of keys when the terminal lacks the capabilities
+	// they need, and their help text.
+	needs         Capability
+	alternates    []string
+	alternateHelp string
+
+	// Why the keybinding is disabled, shown in help.
+	disabledReason string
+
+	// Enables the keybinding only in some context, see WithEnabledWhen.
+	enabledWhen       func() bool
+	enabledWhenReason string
 }
 
 // BindingOpt is an initialization option for a keybinding. It's used as an
@@ -75,6 +91,24 @@ func WithHelp(key, desc string) BindingOpt {
 	}
 }
 
+// WithPriority initializes a keybinding with the given priority in help. When
+// there isn't room for all keybindings, the ones with the lowest priority are
+// left out first. Keybindings have a priority of 0 by default.
+func WithPriority(priority int) BindingOpt {
+	return func(b *Binding) {
+		b.priority = priority
+	}
+}
+
+// WithGroup initializes a keybinding in the given group, such as
+// "Navigation". Help generated from keybindings puts the keybindings of a group
+// together.
+func WithGroup(group string) BindingOpt {
+	return func(b *Binding) {
+		b.group = group
+	}
+}
+
 // WithDisabled initializes a disabled keybinding.
 func WithDisabled() BindingOpt {
 	return func(b *Binding) {
@@ -82,13 +116,36 @@ func WithDisabled() BindingOpt {
 	}
 }
 
+// WithDisabledReason initializes a disabled keybinding with the reason it's
+// disabled. Unlike other disabled keybindings, it's shown in help, grayed out
+// and with the reason.
+func WithDisabledReason(reason string) BindingOpt {
+	return func(b *Binding) {
+		b.DisableWithReason(reason)
+	}
+}
+
+// WithEnabledWhen initializes a keybinding that's only enabled while the given
+// function returns true, such as while an item is selected. If a reason is
+// given, it's shown in help while the keybinding is disabled, as it is for
+// WithDisabledReason.
+func WithEnabledWhen(enabled func() bool, reason string) BindingOpt {
+	return func(b *Binding) {
+		b.SetEnabledWhen(enabled, reason)
+	}
+}
+
 // SetKeys sets the keys for the keybinding.
 func (b *Binding) SetKeys(keys ...string) {
 	b.keys = keys
 }
 
-// Keys returns the keys for the keybinding.
+// Keys returns the keys for the keybinding: its alternates, if the terminal
+// lacks the capabilities its keys need (see WithAlternates).
 func (b Binding) Keys() []string {
+	if b.usingAlternates() {
+		return b.alternates
+	}
 	return b.keys
 }
 
@@ -97,21 +154,82 @@ func (b *Binding) SetHelp(key, desc string) {
 	b.help = Help{Key: key, Desc: desc}
 }
 
-// Help returns the Help information for the keybinding.
+// Help returns the Help information for the keybinding. The description is
+// translated to the current language, see the i18n package.
 func (b Binding) Help() Help {
-	return b.help
+	h := b.help
+	if b.alternateHelp != "" && b.usingAlternates() {
+		h.Key = b.alternateHelp
+	}
+	if h.Desc != "" {
+		h.Desc = i18n.Text(h.Desc)
+	}
+	return h
+}
+
+// SetPriority sets the priority of the keybinding in help.
+func (b *Binding) SetPriority(priority int) {
+	b.priority = priority
+}
+
+// Priority returns the priority of the keybinding in help.
+func (b Binding) Priority() int {
+	return b.priority
+}
+
+// SetGroup sets the group of the keybinding.
+func (b *Binding) SetGroup(group string) {
+	b.group = group
+}
+
+// Group returns the group of the keybinding.
+func (b Binding) Group() string {
+	return b.group
 }
 
 // Enabled returns whether or not the keybinding is enabled. Disabled
-// keybindings won't be activated and won't show up in help. Keybindings are
-// enabled by default.
+// keybindings won't be activated and won't show up in help, unless they were
+// disabled with a reason. Keybindings are enabled by default.
 func (b Binding) Enabled() bool {
+	if b.enabledWhen != nil && !b.enabledWhen() {
+		return false
+	}
 	return !b.disabled && b.keys != nil
 }
 
 // SetEnabled enables or disables the keybinding.
 func (b *Binding) SetEnabled(v bool) {
 	b.disabled = !v
+	b.disabledReason = ""
+}
+
+// DisableWithReason disables the keybinding with the reason it's disabled,
+// which is shown in help.
+func (b *Binding) DisableWithReason(reason string) {
+	b.disabled = true
+	b.disabledReason = reason
+}
+
+// SetEnabledWhen enables the keybinding only while the given function returns
+// true, showing the reason in help while it's disabled. Pass nil to remove
+// the condition.
+func (b *Binding) SetEnabledWhen(enabled func() bool, reason string) {
+	b.enabledWhen = enabled
+	b.enabledWhenReason = reason
+}
+
+// DisabledReason returns why the keybinding is disabled, if it is and a
+// reason was given.
+func (b Binding) DisabledReason() string {
+	switch {
+	case b.keys == nil:
+		return ""
+	case b.disabled:
+		return b.disabledReason
+	case b.enabledWhen != nil && !b.enabledWhen():
+		return b.enabledWhenReason
+	}
+	return ""
 }
 
 // Unbind removes the keys and help from this binding, effectively nullifying
@@ -120,6 +238,8 @@ func (b *Binding) SetEnabled(v bool) {
 func (b *Binding) Unbind() {
 	b.keys = nil
 	b.help = Help{}
+	b.alternates = nil
+	b.alternateHelp = ""
 }
 
 // Help is help information for a given keybinding.
@@ -132,7 +252,7 @@ type Help struct {
 func Matches(k tea.KeyMsg, b ...Binding) bool {
 	keys := k.String()
 	for _, binding := range b {
-		for _, v := range binding.keys {
+		for _, v := range binding.Keys() {
 			if keys == v && binding.Enabled() {
 				return true
 			}
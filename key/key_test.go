@@ -2,6 +2,9 @@ package key
 
 import (
 	"testing"
+
+	"github.com/charmbracelet/bubbles/i18n"
+	tea "github.com/charmbracelet/bubbletea"
 )
 
 func TestBinding_Enabled(t *testing.T) {
@@ -24,3 +27,65 @@ func TestBinding_Enabled(t *testing.T) {
 		t.Errorf("expected key not to be Enabled")
 	}
 }
+
+func TestBinding_DisabledReason(t *testing.T) {
+	binding := NewBinding(
+		WithKeys("d"),
+		WithHelp("d", "delete"),
+		WithDisabledReason("read-only"),
+	)
+	if binding.Enabled() || binding.DisabledReason() != "read-only" {
+		t.Errorf("expected key to be disabled because it's read-only")
+	}
+	binding.SetEnabled(true)
+	if !binding.Enabled() || binding.DisabledReason() != "" {
+		t.Errorf("expected key to be Enabled without a reason")
+	}
+
+	selected := false
+	binding.SetEnabledWhen(func() bool { return selected }, "nothing selected")
+	if Matches(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")}, binding) {
+		t.Errorf("expected key not to match while nothing is selected")
+	}
+	if binding.DisabledReason() != "nothing selected" {
+		t.Errorf("expected key to be disabled because nothing is selected")
+	}
+	selected = true
+	if !Matches(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("d")}, binding) {
+		t.Errorf("expected key to match once something is selected")
+	}
+}
+
+func TestBinding_Alternates(t *testing.T) {
+	defer SetCapabilities(Capabilities())
+
+	binding := NewBinding(
+		WithKeys("shift+enter"),
+		WithHelp("shift+enter", "new line"),
+		WithAlternates(ExtendedKeys, "ctrl+j"),
+		WithAlternateHelp("ctrl+j"),
+	)
+	ctrlJ := tea.KeyMsg{Type: tea.KeyCtrlJ}
+
+	SetCapabilities(0)
+	if !Matches(ctrlJ, binding) || binding.Help().Key != "ctrl+j" {
+		t.Errorf("expected the alternate to be used without extended keys")
+	}
+
+	SetCapabilities(ExtendedKeys)
+	if Matches(ctrlJ, binding) || binding.Help().Key != "shift+enter" {
+		t.Errorf("expected the primary key to be used with extended keys")
+	}
+}
+
+func TestHelpTranslated(t *testing.T) {
+	defer i18n.SetLanguage(i18n.Language())
+
+	i18n.SetMessages("de", i18n.Messages{"delete": "löschen"})
+	i18n.SetLanguage("de")
+
+	binding := NewBinding(WithKeys("x"), WithHelp("x", "delete"))
+	if h := binding.Help(); h.Key != "x" || h.Desc != "löschen" {
+		t.Fatalf("expected the description to be translated, got %+v", h)
+	}
+}
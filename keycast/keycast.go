@@ -0,0 +1,270 @@
+// Package keycast provides an overlay that shows the keybindings as they're
+// pressed, like the key displays of screencasts. It's useful for demos, and
+// for teaching new users the keybindings of an application.
+//
+// Give it the application's keybindings and pass it every key press. Pressing
+// a key shows the help of the binding it matches, which fades after a while:
+//
+//	m.keycast = keycast.New(keycast.WithKeyMap(m.keys))
+//
+//	func (m model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+//	    var cmd tea.Cmd
+//	    m.keycast, cmd = m.keycast.Update(msg)
+//	    // ...
+//	}
+//
+// Render its View where it should overlay the application, such as in the
+// bottom right corner with lipgloss.Place.
+package keycast
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/route"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const (
+	defaultDuration = 2 * time.Second
+	defaultMax      = 4
+)
+
+// ExpireMsg indicates that keys that were shown long enough should be
+// removed.
+type ExpireMsg struct {
+	id   int
+	Time time.Time
+}
+
+// Styles contains the styles used to render the overlay.
+type Styles struct {
+	Key       lipgloss.Style
+	Desc      lipgloss.Style
+	Unmatched lipgloss.Style
+	Count     lipgloss.Style
+
+	// Latest is applied to the key that was pressed last, on top of the
+	// other styles.
+	Latest lipgloss.Style
+
+	// Separator is rendered between the keys.
+	Separator lipgloss.Style
+}
+
+// DefaultStyles returns a set of default style definitions for the overlay.
+func DefaultStyles() Styles {
+	return Styles{
+		Key: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("230")).
+			Background(lipgloss.Color("237")).
+			Padding(0, 1),
+		Desc:      lipgloss.NewStyle().Foreground(lipgloss.Color("245")).MarginLeft(1),
+		Unmatched: lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Padding(0, 1),
+		Count:     lipgloss.NewStyle().Foreground(lipgloss.Color("240")).MarginLeft(1),
+		Latest:    lipgloss.NewStyle().Bold(true),
+		Separator: lipgloss.NewStyle().SetString("  "),
+	}
+}
+
+// press is a key press being shown.
+type press struct {
+	matched bool
+	help    key.Help
+	key     string
+	count   int
+	at      time.Time
+}
+
+// Model is the overlay.
+type Model struct {
+	Styles Styles
+
+	// Enabled is whether key presses are shown.
+	Enabled bool
+
+	// Bindings are the keybindings to show. Disabled ones aren't shown.
+	Bindings []key.Binding
+
+	// Duration is how long a key press is shown.
+	Duration time.Duration
+
+	// Max is the most key presses shown at once. Older ones are dropped
+	// first.
+	Max int
+
+	// ShowUnmatched shows keys that don't match any of the bindings, too.
+	ShowUnmatched bool
+
+	id      int
+	presses []press
+	now     func() time.Time
+}
+
+// Option is used to set options in New.
+type Option func(*Model)
+
+// New creates a new overlay. It's enabled.
+func New(opts ...Option) Model {
+	m := Model{
+		Styles:   DefaultStyles(),
+		Enabled:  true,
+		Duration: defaultDuration,
+		Max:      defaultMax,
+		id:       route.NextID(),
+		now:      time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
+}
+
+// WithBindings adds keybindings to show.
+func WithBindings(bindings ...key.Binding) Option {
+	return func(m *Model) {
+		m.Bindings = append(m.Bindings, bindings...)
+	}
+}
+
+// WithKeyMap adds the keybindings of a key map's full help to show.
+func WithKeyMap(km help.KeyMap) Option {
+	return func(m *Model) {
+		for _, column := range km.FullHelp() {
+			m.Bindings = append(m.Bindings, column...)
+		}
+	}
+}
+
+// WithDuration sets how long a key press is shown.
+func WithDuration(d time.Duration) Option {
+	return func(m *Model) {
+		m.Duration = d
+	}
+}
+
+// WithUnmatched shows keys that don't match any of the bindings, too.
+func WithUnmatched() Option {
+	return func(m *Model) {
+		m.ShowUnmatched = true
+	}
+}
+
+// WithStyles sets the styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) {
+		m.Styles = s
+	}
+}
+
+// ID returns the overlay's unique ID.
+func (m Model) ID() int {
+	return m.id
+}
+
+// Toggle enables the overlay if it's disabled and disables it if it's
+// enabled.
+func (m *Model) Toggle() {
+	m.Enabled = !m.Enabled
+	m.presses = nil
+}
+
+// Update shows key presses and removes the ones that were shown long enough.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case ExpireMsg:
+		if msg.id != m.id {
+			return m, nil
+		}
+		for len(m.presses) > 0 && !msg.Time.Before(m.presses[0].at.Add(m.Duration)) {
+			m.presses = m.presses[1:]
+		}
+		return m, nil
+
+	case tea.KeyMsg:
+		if !m.Enabled {
+			return m, nil
+		}
+		return m, m.press(msg)
+	}
+
+	return m, nil
+}
+
+// press shows a key press, and returns the command that removes it once it
+// was shown long enough.
+func (m *Model) press(msg tea.KeyMsg) tea.Cmd {
+	p := press{key: msg.String(), count: 1, at: m.now()}
+	for _, b := range m.Bindings {
+		if b.Enabled() && key.Matches(msg, b) {
+			p.matched, p.help = true, b.Help()
+			break
+		}
+	}
+	if !p.matched && !m.ShowUnmatched {
+		return nil
+	}
+
+	// Pressing the same key again counts up instead.
+	if n := len(m.presses); n > 0 && m.presses[n-1].key == p.key {
+		p.count += m.presses[n-1].count
+		m.presses = m.presses[:n-1]
+	}
+	m.presses = append(m.presses, p)
+	if m.Max > 0 && len(m.presses) > m.Max {
+		m.presses = m.presses[len(m.presses)-m.Max:]
+	}
+
+	id := m.id
+	return tea.Tick(m.Duration, func(t time.Time) tea.Msg {
+		return ExpireMsg{id: id, Time: t}
+	})
+}
+
+// View renders the key presses being shown, oldest first.
+func (m Model) View() string {
+	if !m.Enabled || len(m.presses) == 0 {
+		return ""
+	}
+
+	views := make([]string, len(m.presses))
+	for i, p := range m.presses {
+		latest := i == len(m.presses)-1
+		views[i] = m.pressView(p, latest)
+	}
+	return strings.Join(views, m.Styles.Separator.String())
+}
+
+func (m Model) pressView(p press, latest bool) string {
+	style := func(s lipgloss.Style) lipgloss.Style {
+		if latest {
+			return s.Copy().Inherit(m.Styles.Latest)
+		}
+		return s
+	}
+
+	var b strings.Builder
+	if !p.matched {
+		b.WriteString(style(m.Styles.Unmatched).Render(p.key))
+	} else {
+		h := p.help
+		k := h.Key
+		if k == "" {
+			k = p.key
+		}
+		b.WriteString(style(m.Styles.Key).Render(k))
+		if h.Desc != "" {
+			b.WriteString(style(m.Styles.Desc).Render(h.Desc))
+		}
+	}
+	if p.count > 1 {
+		b.WriteString(m.Styles.Count.Render("×" + strconv.Itoa(p.count)))
+	}
+	return b.String()
+}
@@ -0,0 +1,60 @@
+package keycast
+
+import (
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func plainStyles() Styles {
+	return Styles{
+		Desc:      lipgloss.NewStyle().MarginLeft(1),
+		Count:     lipgloss.NewStyle().MarginLeft(1),
+		Separator: lipgloss.NewStyle().SetString(" | "),
+	}
+}
+
+func TestKeycast(t *testing.T) {
+	up := key.NewBinding(key.WithKeys("up", "k"), key.WithHelp("↑/k", "up"))
+	quit := key.NewBinding(key.WithKeys("q"), key.WithHelp("q", "quit"))
+
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	m := New(WithBindings(up, quit), WithStyles(plainStyles()))
+	m.now = func() time.Time { return now }
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	if cmd == nil {
+		t.Fatal("expected a command to remove the key press")
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'x'}})
+
+	now = now.Add(time.Second)
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'q'}})
+
+	if exp, got := "↑/k up ×2 | q quit", m.View(); exp != got {
+		t.Errorf("expected view %q, got %q", exp, got)
+	}
+
+	// The first key press expires first.
+	m, _ = m.Update(ExpireMsg{id: m.id, Time: now.Add(time.Second)})
+	if exp, got := "q quit", m.View(); exp != got {
+		t.Errorf("expected view %q, got %q", exp, got)
+	}
+
+	m.Toggle()
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyUp}); cmd != nil || m.View() != "" {
+		t.Error("expected the disabled overlay to ignore key presses")
+	}
+}
+
+func TestUnmatched(t *testing.T) {
+	m := New(WithUnmatched(), WithStyles(plainStyles()))
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlX})
+	if exp, got := "ctrl+x", m.View(); exp != got {
+		t.Errorf("expected view %q, got %q", exp, got)
+	}
+}
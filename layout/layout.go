@@ -0,0 +1,56 @@
+// Package layout defines how bubbles negotiate their size with the layout
+// containers they're placed in, such as a grid.
+//
+// A bubble that implements Sizable tells a container how small it can get
+// and how large it would like to be, and the container sizes it to the space
+// it gives it. Containers can then lay out any bubble without knowing its
+// type:
+//
+//	children := []layout.Sizable{&m.table, &m.viewport}
+//	for i, child := range children {
+//	    m.grid.Size(i, child)
+//	}
+package layout
+
+// Sizable is a bubble that layout containers can size. Pass pointers to your
+// models so their size can be set.
+type Sizable interface {
+	// SetSize sets the size of the bubble's view, frame included.
+	SetSize(width, height int)
+
+	// MinSize returns the smallest size the bubble can be rendered at
+	// without breaking its layout.
+	MinSize() (width, height int)
+
+	// PreferredSize returns the size the bubble needs to show all of its
+	// content, or 0 for a dimension it would rather fill.
+	PreferredSize() (width, height int)
+}
+
+// Fit sizes a bubble to its preferred size within the given space, but not
+// smaller than its minimum size, even if that doesn't fit. A preferred size
+// of 0 takes up all of the space. It returns the size it set.
+func Fit(s Sizable, width, height int) (int, int) {
+	minW, minH := s.MinSize()
+	prefW, prefH := s.PreferredSize()
+	width = max(minW, pick(prefW, width))
+	height = max(minH, pick(prefH, height))
+	s.SetSize(width, height)
+	return width, height
+}
+
+// pick returns the preferred size if it's set and fits in the available
+// space, or the available space otherwise.
+func pick(preferred, available int) int {
+	if preferred > 0 && preferred < available {
+		return preferred
+	}
+	return available
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
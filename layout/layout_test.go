@@ -0,0 +1,34 @@
+package layout
+
+import "testing"
+
+type box struct {
+	width, height int
+	minW, minH    int
+	prefW, prefH  int
+}
+
+func (b *box) SetSize(width, height int)          { b.width, b.height = width, height }
+func (b *box) MinSize() (width, height int)       { return b.minW, b.minH }
+func (b *box) PreferredSize() (width, height int) { return b.prefW, b.prefH }
+
+func TestFit(t *testing.T) {
+	tests := []struct {
+		name          string
+		box           box
+		width, height int
+		expW, expH    int
+	}{
+		{"preferred", box{minW: 2, minH: 1, prefW: 10, prefH: 4}, 20, 20, 10, 4},
+		{"shrunk", box{minW: 2, minH: 1, prefW: 10, prefH: 4}, 6, 3, 6, 3},
+		{"minimum", box{minW: 8, minH: 2, prefW: 10, prefH: 4}, 6, 1, 8, 2},
+		{"fill", box{minW: 2, minH: 1}, 20, 5, 20, 5},
+	}
+	for _, tt := range tests {
+		b := tt.box
+		w, h := Fit(&b, tt.width, tt.height)
+		if w != tt.expW || h != tt.expH || b.width != w || b.height != h {
+			t.Errorf("%s: expected %dx%d, got %dx%d (set to %dx%d)", tt.name, tt.expW, tt.expH, w, h, b.width, b.height)
+		}
+	}
+}
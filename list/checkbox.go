@@ -0,0 +1,162 @@
+package list
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// SetChecked checks or unchecks an item. Checked items are remembered by
+// their ID, see IdentifiableItem, so they stay checked when the list is
+// filtered or the items are replaced.
+func (m *Model) SetChecked(item Item, v bool) {
+	id := itemID(item)
+	if !v {
+		delete(m.checked, id)
+		return
+	}
+	if m.checked == nil {
+		m.checked = make(map[string]bool)
+	}
+	m.checked[id] = true
+}
+
+// IsChecked returns whether an item is checked.
+func (m Model) IsChecked(item Item) bool {
+	return m.checked[itemID(item)]
+}
+
+// CheckedItems returns the checked items, in the order of the list's items.
+func (m Model) CheckedItems() []Item {
+	var items []Item
+	for _, item := range m.items {
+		if m.checked[itemID(item)] {
+			items = append(items, item)
+		}
+	}
+	return items
+}
+
+// ClearChecked unchecks all items.
+func (m *Model) ClearChecked() {
+	m.checked = nil
+}
+
+// extendChecked moves the cursor up (dir < 0) or down (dir > 0) and checks
+// the items from where the range selection started to the cursor.
+func (m *Model) extendChecked(dir int) {
+	if m.checkAnchor < 0 {
+		m.checkAnchor = m.VisibleIndex()
+	}
+	if dir < 0 {
+		m.CursorUp()
+	} else {
+		m.CursorDown()
+	}
+
+	from, to := m.checkAnchor, m.VisibleIndex()
+	if from > to {
+		from, to = to, from
+	}
+	items := m.VisibleItems()
+	for i := from; i <= to && i < len(items); i++ {
+		m.SetChecked(items[i], true)
+	}
+}
+
+// CheckboxKeyMap defines the keybindings of the CheckboxDelegate.
+type CheckboxKeyMap struct {
+	Toggle    key.Binding
+	ToggleAll key.Binding
+
+	// SelectUp and SelectDown move the cursor and check every item passed,
+	// starting with the selected one.
+	SelectUp   key.Binding
+	SelectDown key.Binding
+}
+
+// DefaultCheckboxKeyMap returns a default set of keybindings for the
+// CheckboxDelegate.
+func DefaultCheckboxKeyMap() CheckboxKeyMap {
+	return CheckboxKeyMap{
+		Toggle: key.NewBinding(
+			key.WithKeys(" ", "x"),
+			key.WithHelp("space", "check"),
+		),
+		ToggleAll: key.NewBinding(
+			key.WithKeys("ctrl+a"),
+			key.WithHelp("ctrl+a", "check all"),
+		),
+		SelectUp: key.NewBinding(
+			key.WithKeys("shift+up", "K"),
+			key.WithHelp("shift+↑", "check up"),
+		),
+		SelectDown: key.NewBinding(
+			key.WithKeys("shift+down", "J"),
+			key.WithHelp("shift+↓", "check down"),
+		),
+	}
+}
+
+// CheckboxDelegate is a DefaultDelegate that renders a checkbox in front of
+// every item and lets the user check items, for flows where the user picks a
+// few items and then acts on them with CheckedItems.
+type CheckboxDelegate struct {
+	DefaultDelegate
+	KeyMap CheckboxKeyMap
+}
+
+// NewCheckboxDelegate creates a new checkbox delegate with default styles and
+// keybindings.
+func NewCheckboxDelegate() CheckboxDelegate {
+	d := NewDefaultDelegate()
+	d.checkbox = true
+	return CheckboxDelegate{
+		DefaultDelegate: d,
+		KeyMap:          DefaultCheckboxKeyMap(),
+	}
+}
+
+// Update checks items, then calls the DefaultDelegate's Update.
+func (d CheckboxDelegate) Update(msg tea.Msg, m *Model) tea.Cmd {
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, d.KeyMap.SelectUp):
+			m.extendChecked(-1)
+		case key.Matches(msg, d.KeyMap.SelectDown):
+			m.extendChecked(1)
+		case key.Matches(msg, d.KeyMap.Toggle):
+			m.checkAnchor = -1
+			if item := m.SelectedItem(); item != nil {
+				m.SetChecked(item, !m.IsChecked(item))
+			}
+		case key.Matches(msg, d.KeyMap.ToggleAll):
+			m.checkAnchor = -1
+			items := m.VisibleItems()
+			all := true
+			for _, item := range items {
+				all = all && m.IsChecked(item)
+			}
+			for _, item := range items {
+				m.SetChecked(item, !all)
+			}
+		default:
+			m.checkAnchor = -1
+		}
+	}
+	return d.DefaultDelegate.Update(msg, m)
+}
+
+// ShortHelp returns the delegate's short help.
+func (d CheckboxDelegate) ShortHelp() []key.Binding {
+	return append([]key.Binding{d.KeyMap.Toggle}, d.DefaultDelegate.ShortHelp()...)
+}
+
+// FullHelp returns the delegate's full help.
+func (d CheckboxDelegate) FullHelp() [][]key.Binding {
+	return append([][]key.Binding{{
+		d.KeyMap.Toggle,
+		d.KeyMap.ToggleAll,
+		d.KeyMap.SelectUp,
+		d.KeyMap.SelectDown,
+	}}, d.DefaultDelegate.FullHelp()...)
+}
@@ -0,0 +1,50 @@
+package list
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func checkedNames(m Model) string {
+	var names []string
+	for _, item := range m.CheckedItems() {
+		names = append(names, item.FilterValue())
+	}
+	return strings.Join(names, ",")
+}
+
+func TestCheckboxDelegate(t *testing.T) {
+	d := NewCheckboxDelegate()
+	d.ShowDescription = false
+	d.Styles.Checked = lipgloss.NewStyle().SetString("[x]")
+	list := New([]Item{
+		menuItem{title: "a"}, menuItem{title: "b"}, menuItem{title: "c"}, menuItem{title: "d"},
+	}, d, 20, 20)
+
+	list, _ = list.Update(tea.KeyMsg{Type: tea.KeySpace, Runes: []rune(" ")})
+	if got := checkedNames(list); got != "a" {
+		t.Fatalf("expected a to be checked, got %q", got)
+	}
+	if !strings.Contains(list.View(), "[x] a") {
+		t.Fatalf("expected a checked checkbox, got:\n%s", list.View())
+	}
+
+	list, _ = list.Update(tea.KeyMsg{Type: tea.KeyDown})
+	list, _ = list.Update(tea.KeyMsg{Type: tea.KeyDown})
+	list, _ = list.Update(tea.KeyMsg{Type: tea.KeyShiftDown})
+	if got := checkedNames(list); got != "a,c,d" {
+		t.Fatalf("expected the range from c to be checked, got %q", got)
+	}
+
+	list, _ = list.Update(tea.KeyMsg{Type: tea.KeyCtrlA})
+	if got := checkedNames(list); got != "a,b,c,d" {
+		t.Fatalf("expected all items to be checked, got %q", got)
+	}
+	list, _ = list.Update(tea.KeyMsg{Type: tea.KeyCtrlA})
+	if got := checkedNames(list); got != "" {
+		t.Fatalf("expected all items to be unchecked, got %q", got)
+	}
+}
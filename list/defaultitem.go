@@ -28,6 +28,16 @@ type DefaultItemStyles struct {
 
 	// Charcters matching the current filter, if any.
 	FilterMatch lipgloss.Style
+
+	// The item's quick-select shortcut, if shortcuts are enabled.
+	Shortcut lipgloss.Style
+
+	// The marker shown in front of pinned items.
+	PinnedMarker lipgloss.Style
+
+	// The checkboxes rendered by the CheckboxDelegate.
+	Checked   lipgloss.Style
+	Unchecked lipgloss.Style
 }
 
 // NewDefaultItemStyles returns style definitions for a default item. See
@@ -58,6 +68,20 @@ func NewDefaultItemStyles() (s DefaultItemStyles) {
 
 	s.FilterMatch = lipgloss.NewStyle().Underline(true)
 
+	s.Shortcut = lipgloss.NewStyle().
+		Foreground(lipgloss.AdaptiveColor{Light: "#847A85", Dark: "#979797"}).
+		Bold(true)
+
+	s.PinnedMarker = lipgloss.NewStyle().
+		Foreground(lipgloss.AdaptiveColor{Light: "#F793FF", Dark: "#AD58B4"}).
+		SetString("★")
+
+	s.Checked = lipgloss.NewStyle().
+		Foreground(lipgloss.AdaptiveColor{Light: "#04B575", Dark: "#04B575"}).
+		SetString("[x]")
+
+	s.Unchecked = lipgloss.NewStyle().SetString("[ ]")
+
 	return s
 }
 
@@ -89,6 +113,10 @@ type DefaultDelegate struct {
 	FullHelpFunc    func() [][]key.Binding
 	height          int
 	spacing         int
+
+	// Whether a checkbox is rendered in front of the title. See
+	// CheckboxDelegate.
+	checkbox bool
 }
 
 // NewDefaultDelegate creates a new delegate with default styles.
@@ -154,9 +182,33 @@ func (d DefaultDelegate) Render(w io.Writer, m Model, index int, item Item) {
 		return
 	}
 
+	// Checkbox, shortcut badge and pinned marker, shown in front of the title
+	var badge string
+	if d.checkbox {
+		if m.IsChecked(item) {
+			badge = s.Checked.String() + " "
+		} else {
+			badge = s.Unchecked.String() + " "
+		}
+	}
+	if shortcut := m.ShortcutForItem(index); shortcut != "" {
+		badge += s.Shortcut.Render(shortcut) + " "
+	}
+	if m.IsPinned(item) {
+		badge += s.PinnedMarker.String() + " "
+	}
+
+	// Status decoration, shown on the right of the title
+	decoration := m.DecorationForItem(index)
+	decorationWidth := lipgloss.Width(decoration)
+	if decorationWidth > 0 {
+		decorationWidth++
+	}
+
 	// Prevent text from exceeding list width
 	textwidth := uint(m.width - s.NormalTitle.GetPaddingLeft() - s.NormalTitle.GetPaddingRight())
-	title = truncate.StringWithTail(title, textwidth, ellipsis)
+	titlewidth := uint(max(0, int(textwidth)-lipgloss.Width(badge)-decorationWidth))
+	title = truncate.StringWithTail(title, titlewidth, ellipsis)
 	if d.ShowDescription {
 		var lines []string
 		for i, line := range strings.Split(desc, "\n") {
@@ -170,18 +222,18 @@ func (d DefaultDelegate) Render(w io.Writer, m Model, index int, item Item) {
 
 	// Conditions
 	var (
-		isSelected  = index == m.Index()
+		isSelected  = index == m.VisibleIndex()
 		emptyFilter = m.FilterState() == Filtering && m.FilterValue() == ""
 		isFiltered  = m.FilterState() == Filtering || m.FilterState() == FilterApplied
 	)
 
-	if isFiltered && index < len(m.filteredItems) {
+	if isFiltered {
 		// Get indices of matched characters
 		matchedRunes = m.MatchesForItem(index)
 	}
 
 	if emptyFilter {
-		title = s.DimmedTitle.Render(title)
+		title = s.DimmedTitle.Render(badge + title)
 		desc = s.DimmedDesc.Render(desc)
 	} else if isSelected && m.FilterState() != Filtering {
 		if isFiltered {
@@ -190,7 +242,7 @@ func (d DefaultDelegate) Render(w io.Writer, m Model, index int, item Item) {
 			matched := unmatched.Copy().Inherit(s.FilterMatch)
 			title = lipgloss.StyleRunes(title, matchedRunes, matched, unmatched)
 		}
-		title = s.SelectedTitle.Render(title)
+		title = s.SelectedTitle.Render(badge + title)
 		desc = s.SelectedDesc.Render(desc)
 	} else {
 		if isFiltered {
@@ -199,10 +251,15 @@ func (d DefaultDelegate) Render(w io.Writer, m Model, index int, item Item) {
 			matched := unmatched.Copy().Inherit(s.FilterMatch)
 			title = lipgloss.StyleRunes(title, matchedRunes, matched, unmatched)
 		}
-		title = s.NormalTitle.Render(title)
+		title = s.NormalTitle.Render(badge + title)
 		desc = s.NormalDesc.Render(desc)
 	}
 
+	if decoration != "" {
+		gap := max(1, m.width-lipgloss.Width(title)-lipgloss.Width(decoration))
+		title += strings.Repeat(" ", gap) + decoration
+	}
+
 	if d.ShowDescription {
 		fmt.Fprintf(w, "%s\n%s", title, desc)
 		return
@@ -0,0 +1,172 @@
+package list
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// SavedFilter is a named filter query the user can pick from the saved
+// filters overlay.
+type SavedFilter struct {
+	Name  string
+	Query string
+}
+
+// SetSavedFilters sets the named filters shown in the saved filters overlay,
+// which the user opens with the ShowSavedFilters keybinding.
+func (m *Model) SetSavedFilters(filters []SavedFilter) {
+	m.savedFilters = filters
+	m.savedFilterCursor = 0
+	if len(filters) == 0 {
+		m.savedFiltersOpen = false
+	}
+	m.updateKeybindings()
+}
+
+// SavedFilters returns the named filters shown in the saved filters overlay.
+func (m Model) SavedFilters() []SavedFilter {
+	return m.savedFilters
+}
+
+// SavedFiltersOpen returns whether the saved filters overlay is open.
+func (m Model) SavedFiltersOpen() bool {
+	return m.savedFiltersOpen
+}
+
+// SetFilterHistory sets the history of filter queries, oldest first. Use it
+// together with FilterHistory to keep the history across sessions.
+func (m *Model) SetFilterHistory(queries []string) {
+	m.filterHistory = append([]string(nil), queries...)
+	m.trimFilterHistory()
+	m.historyIndex = -1
+	m.updateKeybindings()
+}
+
+// FilterHistory returns the filter queries the user has applied, oldest
+// first. While setting a filter, the PrevFilter and NextFilter keybindings
+// step through them.
+func (m Model) FilterHistory() []string {
+	return m.filterHistory
+}
+
+// ApplyFilter filters the list with the given query, as if the user had typed
+// it and accepted it. An empty query resets the filter.
+func (m *Model) ApplyFilter(query string) {
+	if query == "" {
+		m.resetFiltering()
+		return
+	}
+	m.FilterInput.SetValue(query)
+	m.FilterInput.CursorEnd()
+	m.FilterInput.Blur()
+	m.filterState = FilterApplied
+	if msg, ok := filterItems(*m)().(FilterMatchesMsg); ok {
+		m.filteredItems = m.sortFiltered(filteredItems(msg))
+	}
+	m.addFilterHistory(query)
+	m.Paginator.Page = 0
+	m.cursor = 0
+	m.updatePagination()
+	m.updateKeybindings()
+}
+
+// addFilterHistory adds a query to the end of the history, moving it there if
+// it's in the history already.
+func (m *Model) addFilterHistory(query string) {
+	query = strings.TrimSpace(query)
+	if query == "" {
+		return
+	}
+	for i, q := range m.filterHistory {
+		if q == query {
+			m.filterHistory = append(m.filterHistory[:i:i], m.filterHistory[i+1:]...)
+			break
+		}
+	}
+	m.filterHistory = append(m.filterHistory, query)
+	m.trimFilterHistory()
+}
+
+// trimFilterHistory drops the oldest queries beyond FilterHistorySize.
+func (m *Model) trimFilterHistory() {
+	if m.FilterHistorySize > 0 && len(m.filterHistory) > m.FilterHistorySize {
+		m.filterHistory = m.filterHistory[len(m.filterHistory)-m.FilterHistorySize:]
+	}
+}
+
+// browseFilterHistory replaces the filter being set with an older (dir < 0)
+// or newer (dir > 0) query from the history. Going past the newest query
+// brings back what the user had typed.
+func (m *Model) browseFilterHistory(dir int) tea.Cmd {
+	n := len(m.filterHistory)
+	if n == 0 {
+		return nil
+	}
+
+	i := m.historyIndex
+	if i < 0 {
+		if dir > 0 {
+			return nil
+		}
+		m.historyDraft = m.FilterInput.Value()
+		i = n
+	}
+	i += dir
+
+	var value string
+	switch {
+	case i < 0:
+		return nil
+	case i >= n:
+		m.historyIndex = -1
+		value = m.historyDraft
+	default:
+		m.historyIndex = i
+		value = m.filterHistory[i]
+	}
+
+	m.FilterInput.SetValue(value)
+	m.FilterInput.CursorEnd()
+	m.KeyMap.AcceptWhileFiltering.SetEnabled(value != "")
+	return filterItems(*m)
+}
+
+// Updates for when the saved filters overlay is open.
+func (m *Model) handleSavedFilters(msg tea.Msg) tea.Cmd {
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return nil
+	}
+
+	switch {
+	case key.Matches(keyMsg, m.KeyMap.CloseSavedFilters):
+		m.savedFiltersOpen = false
+	case key.Matches(keyMsg, m.KeyMap.ApplySavedFilter):
+		m.savedFiltersOpen = false
+		m.hideStatusMessage()
+		m.ApplyFilter(m.savedFilters[m.savedFilterCursor].Query)
+	case key.Matches(keyMsg, m.KeyMap.CursorUp):
+		m.savedFilterCursor = max(0, m.savedFilterCursor-1)
+	case key.Matches(keyMsg, m.KeyMap.CursorDown):
+		m.savedFilterCursor = min(len(m.savedFilters)-1, m.savedFilterCursor+1)
+	}
+	m.updateKeybindings()
+	return nil
+}
+
+// savedFiltersView renders the saved filters overlay.
+func (m Model) savedFiltersView() string {
+	lines := make([]string, len(m.savedFilters))
+	for i, f := range m.savedFilters {
+		name := f.Name + " " + m.Styles.SavedFilterQuery.Render(f.Query)
+		if i == m.savedFilterCursor {
+			lines[i] = m.Styles.SelectedSavedFilter.Render("> " + name)
+		} else {
+			lines[i] = "  " + name
+		}
+	}
+	return m.Styles.SavedFilters.Render(lipgloss.JoinVertical(lipgloss.Left, lines...))
+}
@@ -0,0 +1,80 @@
+package list
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type fruit string
+
+func (f fruit) FilterValue() string { return string(f) }
+
+func fruitList() Model {
+	return New([]Item{fruit("apple"), fruit("banana"), fruit("cherry")}, itemDelegate{}, 40, 20)
+}
+
+func typeFilter(m Model, s string) Model {
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	for _, r := range s {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	return m
+}
+
+func TestFilterHistory(t *testing.T) {
+	list := fruitList()
+	list = typeFilter(list, "app")
+	list.ResetFilter()
+	list = typeFilter(list, "ban")
+	list.ResetFilter()
+	list = typeFilter(list, "app")
+
+	if got := strings.Join(list.FilterHistory(), ","); got != "ban,app" {
+		t.Fatalf("expected history ban,app, got %s", got)
+	}
+
+	list.ResetFilter()
+	list, _ = list.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	list, _ = list.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	for _, want := range []string{"app", "ban", "ban"} {
+		list, _ = list.Update(tea.KeyMsg{Type: tea.KeyUp})
+		if got := list.FilterValue(); got != want {
+			t.Fatalf("expected %q going back, got %q", want, got)
+		}
+	}
+	for _, want := range []string{"app", "c"} {
+		list, _ = list.Update(tea.KeyMsg{Type: tea.KeyDown})
+		if got := list.FilterValue(); got != want {
+			t.Fatalf("expected %q going forward, got %q", want, got)
+		}
+	}
+	if !list.SettingFilter() {
+		t.Fatal("expected up and down not to accept the filter")
+	}
+}
+
+func TestSavedFilters(t *testing.T) {
+	list := fruitList()
+	list.SetSavedFilters([]SavedFilter{{Name: "A", Query: "apple"}, {Name: "C", Query: "cherry"}})
+
+	list, _ = list.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("F")})
+	if !list.SavedFiltersOpen() {
+		t.Fatal("expected the saved filters overlay to open")
+	}
+	if !strings.Contains(list.View(), "cherry") {
+		t.Fatalf("expected the overlay in the view, got:\n%s", list.View())
+	}
+
+	list, _ = list.Update(tea.KeyMsg{Type: tea.KeyDown})
+	list, _ = list.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if list.SavedFiltersOpen() || !list.IsFiltered() {
+		t.Fatal("expected the saved filter to be applied")
+	}
+	items := list.VisibleItems()
+	if len(items) != 1 || items[0] != fruit("cherry") {
+		t.Fatalf("expected only cherry, got %v", items)
+	}
+}
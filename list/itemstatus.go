@@ -0,0 +1,114 @@
+package list
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ItemState is the state of an item in a bulk operation.
+type ItemState int
+
+// Item states.
+const (
+	ItemIdle ItemState = iota
+	ItemProcessing
+	ItemDone
+	ItemFailed
+)
+
+// ItemStatus is the status of an item in a bulk operation. It's shown as a
+// decoration on the right of the item: a spinner or a percentage while the
+// item is processed, and a checkmark or a cross once it's done or has failed.
+type ItemStatus struct {
+	State ItemState
+
+	// Progress is how far processing the item has come, from 0 to 1. While
+	// it's above 0 a percentage is shown instead of the spinner.
+	Progress float64
+}
+
+// ItemStatusMsg sets the status of the item with the given ID. Send it from
+// the commands that process the items and pass it on to the list's Update
+// function. See IdentifiableItem for how items are identified.
+type ItemStatusMsg struct {
+	ID     string
+	Status ItemStatus
+}
+
+// SetItemStatus sets the status of the item with the given ID. Setting an
+// item to ItemIdle removes its decoration. The returned command keeps the
+// spinner of processing items spinning.
+func (m *Model) SetItemStatus(id string, status ItemStatus) tea.Cmd {
+	if status.State == ItemIdle {
+		delete(m.itemStatuses, id)
+	} else {
+		if m.itemStatuses == nil {
+			m.itemStatuses = make(map[string]ItemStatus)
+		}
+		m.itemStatuses[id] = status
+	}
+
+	if status.State != ItemProcessing || m.statusSpinning {
+		return nil
+	}
+	m.statusSpinning = true
+	return m.statusSpinner.Tick
+}
+
+// ItemStatus returns the status of an item.
+func (m Model) ItemStatus(item Item) ItemStatus {
+	return m.itemStatuses[itemID(item)]
+}
+
+// ClearItemStatuses removes the status of all items.
+func (m *Model) ClearItemStatuses() {
+	m.itemStatuses = nil
+}
+
+// DecorationForItem returns the rendered status of the item at the given
+// index in the visible items, or an empty string if it has none. Delegates
+// use it to render the status on the right of the item.
+//
+// See DefaultDelegate.Render for a usage example.
+func (m Model) DecorationForItem(index int) string {
+	items := m.VisibleItems()
+	if index < 0 || index >= len(items) {
+		return ""
+	}
+
+	status, ok := m.itemStatuses[itemID(items[index])]
+	if !ok {
+		return ""
+	}
+	switch status.State {
+	case ItemProcessing:
+		if status.Progress > 0 {
+			return m.Styles.ItemProgress.Render(fmt.Sprintf("%3.0f%%", status.Progress*100)) //nolint:gomnd
+		}
+		return m.statusSpinner.View()
+	case ItemDone:
+		return m.Styles.ItemDone.String()
+	case ItemFailed:
+		return m.Styles.ItemFailed.String()
+	}
+	return ""
+}
+
+// updateStatusSpinner advances the spinner of processing items. It stops
+// spinning once no item is processed anymore.
+func (m *Model) updateStatusSpinner(msg spinner.TickMsg) tea.Cmd {
+	var cmd tea.Cmd
+	m.statusSpinner, cmd = m.statusSpinner.Update(msg)
+	if msg.ID != m.statusSpinner.ID() {
+		return nil
+	}
+	for _, s := range m.itemStatuses {
+		if s.State == ItemProcessing {
+			return cmd
+		}
+	}
+	m.statusSpinning = false
+	return nil
+}
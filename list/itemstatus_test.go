@@ -0,0 +1,48 @@
+package list
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/spinner"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestItemStatus(t *testing.T) {
+	d := NewDefaultDelegate()
+	d.ShowDescription = false
+	list := New([]Item{menuItem{title: "one"}, menuItem{title: "two"}}, d, 20, 10)
+	list.Styles.ItemDone = lipgloss.NewStyle().SetString("ok")
+	list.Styles.ItemProgress = lipgloss.NewStyle()
+
+	list, cmd := list.Update(ItemStatusMsg{ID: "one", Status: ItemStatus{State: ItemProcessing}})
+	if cmd == nil {
+		t.Fatal("expected the spinner to start")
+	}
+	if list.DecorationForItem(0) != list.statusSpinner.View() {
+		t.Fatalf("expected a spinner, got %q", list.DecorationForItem(0))
+	}
+
+	list, _ = list.Update(ItemStatusMsg{ID: "two", Status: ItemStatus{State: ItemProcessing, Progress: 0.42}})
+	if got := list.DecorationForItem(1); got != " 42%" {
+		t.Fatalf("expected the progress, got %q", got)
+	}
+
+	list, _ = list.Update(ItemStatusMsg{ID: "one", Status: ItemStatus{State: ItemDone}})
+	list, _ = list.Update(ItemStatusMsg{ID: "two", Status: ItemStatus{State: ItemIdle}})
+	lines := strings.Split(list.View(), "\n")
+	var found bool
+	for _, line := range lines {
+		if strings.Contains(line, "one") && strings.HasSuffix(strings.TrimRight(line, " "), strings.Repeat(" ", 13)+"ok") {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatalf("expected the decoration on the right, got:\n%s", list.View())
+	}
+
+	list, cmd = list.Update(spinner.TickMsg{ID: list.statusSpinner.ID()})
+	if list.statusSpinning {
+		t.Fatalf("expected the spinner to stop without processing items, got %v", cmd)
+	}
+}
@@ -15,9 +15,27 @@ type KeyMap struct {
 	Filter      key.Binding
 	ClearFilter key.Binding
 
-	// Keybindings used when setting a filter.
+	// Opens the saved filters overlay, if there are saved filters.
+	ShowSavedFilters key.Binding
+
+	// Pins the selected item to the top of the list, or unpins it. It's
+	// only enabled if pinning is, see Model.SetPinningEnabled.
+	TogglePin key.Binding
+
+	// Switches to the next sort order, if there are sort orders.
+	CycleSort key.Binding
+
+	// Keybindings used when setting a filter. PrevFilter and NextFilter step
+	// through the filter history.
 	CancelWhileFiltering key.Binding
 	AcceptWhileFiltering key.Binding
+	PrevFilter           key.Binding
+	NextFilter           key.Binding
+
+	// Keybindings used in the saved filters overlay, besides CursorUp and
+	// CursorDown.
+	ApplySavedFilter  key.Binding
+	CloseSavedFilters key.Binding
 
 	// Help toggle keybindings.
 	ShowFullHelp  key.Binding
@@ -66,6 +84,18 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("esc"),
 			key.WithHelp("esc", "clear filter"),
 		),
+		ShowSavedFilters: key.NewBinding(
+			key.WithKeys("F"),
+			key.WithHelp("F", "saved filters"),
+		),
+		TogglePin: key.NewBinding(
+			key.WithKeys("p"),
+			key.WithHelp("p", "pin/unpin"),
+		),
+		CycleSort: key.NewBinding(
+			key.WithKeys("s"),
+			key.WithHelp("s", "sort"),
+		),
 
 		// Filtering.
 		CancelWhileFiltering: key.NewBinding(
@@ -76,6 +106,24 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("enter", "tab", "shift+tab", "ctrl+k", "up", "ctrl+j", "down"),
 			key.WithHelp("enter", "apply filter"),
 		),
+		PrevFilter: key.NewBinding(
+			key.WithKeys("up", "ctrl+p"),
+			key.WithHelp("↑", "previous filter"),
+		),
+		NextFilter: key.NewBinding(
+			key.WithKeys("down", "ctrl+n"),
+			key.WithHelp("↓", "next filter"),
+		),
+
+		// Saved filters.
+		ApplySavedFilter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "apply"),
+		),
+		CloseSavedFilters: key.NewBinding(
+			key.WithKeys("esc", "F"),
+			key.WithHelp("esc", "close"),
+		),
 
 		// Toggle help.
 		ShowFullHelp: key.NewBinding(
@@ -11,10 +11,12 @@ import (
 	"time"
 
 	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/i18n"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/paginator"
 	"github.com/charmbracelet/bubbles/spinner"
 	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/virtual"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/muesli/reflow/ansi"
@@ -53,6 +55,7 @@ type ItemDelegate interface {
 }
 
 type filteredItem struct {
+	index   int   // index of the item in items
 	item    Item  // item matched
 	matches []int // rune indices of matched items
 }
@@ -151,6 +154,14 @@ type Model struct {
 	AdditionalShortHelpKeys func() []key.Binding
 	AdditionalFullHelpKeys  func() []key.Binding
 
+	// Whether or not to select items by clicking them and move the cursor
+	// with the mouse wheel while browsing. The mouse must be enabled in
+	// Bubble Tea for this to work. XPosition and YPosition are the position
+	// of the list in the terminal window.
+	MouseEnabled bool
+	XPosition    int
+	YPosition    int
+
 	spinner     spinner.Model
 	showSpinner bool
 	width       int
@@ -168,6 +179,55 @@ type Model struct {
 	statusMessage      string
 	statusMessageTimer *time.Timer
 
+	// How many filter queries the history keeps. By default this is 50.
+	FilterHistorySize int
+
+	// Applied filter queries, oldest first. While stepping through them,
+	// historyIndex is the index of the one shown and historyDraft what the
+	// user had typed. historyIndex is -1 otherwise.
+	filterHistory []string
+	historyIndex  int
+	historyDraft  string
+
+	// Named filters and the state of the overlay they're picked from.
+	savedFilters      []SavedFilter
+	savedFiltersOpen  bool
+	savedFilterCursor int
+
+	// Whether items can be selected with quick-select shortcuts.
+	shortcuts bool
+
+	// Statuses of items in bulk operations, by item ID, and the spinner
+	// shown while they're processed.
+	itemStatuses   map[string]ItemStatus
+	statusSpinner  spinner.Model
+	statusSpinning bool
+
+	// Checked items, by item ID, and the index where the current range
+	// selection started, or -1. See CheckboxDelegate.
+	checked     map[string]bool
+	checkAnchor int
+
+	// Sort orders by name, their names in the order they're cycled through,
+	// the name of the active one and the items sorted by it, or nil if the
+	// list isn't sorted.
+	sortFuncs   map[string]LessFunc
+	sortNames   []string
+	sortOrder   string
+	sortedItems []Item
+
+	// The indices in items of the sorted items, or nil if the list isn't
+	// sorted.
+	sortedIndexes []int
+
+	// IDs of the pinned items, in the order they were pinned. Pinned items
+	// are shown at the top of the list. Set it to restore pinned items from
+	// a previous session. See IdentifiableItem.
+	Pinned []string
+
+	// Whether the user can pin items with the TogglePin keybinding.
+	pinningEnabled bool
+
 	// The master set of items we're working with.
 	items []Item
 
@@ -187,8 +247,12 @@ func New(items []Item, delegate ItemDelegate, width, height int) Model {
 	sp.Spinner = spinner.Line
 	sp.Style = styles.Spinner
 
+	statusSpinner := spinner.New()
+	statusSpinner.Spinner = spinner.MiniDot
+	statusSpinner.Style = styles.Spinner
+
 	filterInput := textinput.New()
-	filterInput.Prompt = "Filter: "
+	filterInput.Prompt = i18n.Text("Filter: ")
 	filterInput.PromptStyle = styles.FilterPrompt
 	filterInput.CursorStyle = styles.FilterCursor
 	filterInput.CharLimit = 64
@@ -205,15 +269,17 @@ func New(items []Item, delegate ItemDelegate, width, height int) Model {
 		showStatusBar:         true,
 		showPagination:        true,
 		showHelp:              true,
-		itemNameSingular:      "item",
-		itemNamePlural:        "items",
+		itemNameSingular:      i18n.Text("item"),
+		itemNamePlural:        i18n.Text("items"),
 		filteringEnabled:      true,
 		KeyMap:                DefaultKeyMap(),
 		Filter:                DefaultFilter,
 		Styles:                styles,
-		Title:                 "List",
+		Title:                 i18n.Text("List"),
 		FilterInput:           filterInput,
 		StatusMessageLifetime: time.Second,
+		FilterHistorySize:     50,
+		historyIndex:          -1,
 
 		width:     width,
 		height:    height,
@@ -222,6 +288,9 @@ func New(items []Item, delegate ItemDelegate, width, height int) Model {
 		Paginator: p,
 		spinner:   sp,
 		Help:      help.New(),
+
+		statusSpinner: statusSpinner,
+		checkAnchor:   -1,
 	}
 
 	m.updatePagination()
@@ -335,6 +404,7 @@ func (m Model) Items() []Item {
 func (m *Model) SetItems(i []Item) tea.Cmd {
 	var cmd tea.Cmd
 	m.items = i
+	m.resort()
 
 	if m.filterState != Unfiltered {
 		m.filteredItems = nil
@@ -366,6 +436,7 @@ func (m *Model) ResetFilter() {
 func (m *Model) SetItem(index int, item Item) tea.Cmd {
 	var cmd tea.Cmd
 	m.items[index] = item
+	m.resort()
 
 	if m.filterState != Unfiltered {
 		cmd = filterItems(*m)
@@ -380,6 +451,7 @@ func (m *Model) SetItem(index int, item Item) tea.Cmd {
 func (m *Model) InsertItem(index int, item Item) tea.Cmd {
 	var cmd tea.Cmd
 	m.items = insertItemIntoSlice(m.items, item, index)
+	m.resort()
 
 	if m.filterState != Unfiltered {
 		cmd = filterItems(*m)
@@ -395,8 +467,9 @@ func (m *Model) InsertItem(index int, item Item) tea.Cmd {
 // case of a TUI.
 func (m *Model) RemoveItem(index int) {
 	m.items = removeItemFromSlice(m.items, index)
+	m.resort()
 	if m.filterState != Unfiltered {
-		m.filteredItems = removeFilterMatchFromSlice(m.filteredItems, index)
+		m.filteredItems = removeFilterMatch(m.filteredItems, index)
 		if len(m.filteredItems) == 0 {
 			m.resetFiltering()
 		}
@@ -412,15 +485,28 @@ func (m *Model) SetDelegate(d ItemDelegate) {
 
 // VisibleItems returns the total items available to be shown.
 func (m Model) VisibleItems() []Item {
+	if len(m.Pinned) > 0 {
+		return m.visibleFilteredItems().items()
+	}
 	if m.filterState != Unfiltered {
 		return m.filteredItems.items()
 	}
-	return m.items
+	return m.sortedOrItems()
+}
+
+// visibleFilteredItems returns the items to be shown, with their filter
+// matches, pinned items first.
+func (m Model) visibleFilteredItems() filteredItems {
+	items := m.filteredItems
+	if m.filterState == Unfiltered {
+		items = m.itemsAsFilterItems()
+	}
+	return m.pinFirst(items)
 }
 
 // SelectedItems returns the current selected item in the list.
 func (m Model) SelectedItem() Item {
-	i := m.Index()
+	i := m.VisibleIndex()
 
 	items := m.VisibleItems()
 	if i < 0 || len(items) == 0 || len(items) <= i {
@@ -435,6 +521,13 @@ func (m Model) SelectedItem() Item {
 //
 // See DefaultItemView for a usage example.
 func (m Model) MatchesForItem(index int) []int {
+	if len(m.Pinned) > 0 {
+		items := m.visibleFilteredItems()
+		if index < 0 || index >= len(items) {
+			return nil
+		}
+		return items[index].matches
+	}
 	if m.filteredItems == nil || index >= len(m.filteredItems) {
 		return nil
 	}
@@ -442,11 +535,39 @@ func (m Model) MatchesForItem(index int) []int {
 }
 
 // Index returns the index of the currently selected item as it appears in the
-// entire slice of items.
+// entire slice of items, whatever the sort order, pinned items and filter.
 func (m Model) Index() int {
+	i := m.VisibleIndex()
+	if len(m.Pinned) == 0 && m.filterState == Unfiltered {
+		if i >= 0 && i < len(m.items) {
+			return m.sortedIndex(i)
+		}
+		return i
+	}
+	items := m.visibleFilteredItems()
+	if i < 0 || i >= len(items) {
+		return i
+	}
+	return items[i].index
+}
+
+// VisibleIndex returns the index of the currently selected item among the
+// visible items, that is, in the order they're shown. It's the index the
+// item is rendered with by the delegate.
+func (m Model) VisibleIndex() int {
 	return m.Paginator.Page*m.Paginator.PerPage + m.cursor
 }
 
+// window returns the current page as a window over the given number of
+// visible items.
+func (m Model) window(total int) virtual.Window {
+	return virtual.Window{
+		Total:  total,
+		Size:   m.Paginator.PerPage,
+		Offset: m.Paginator.Page * m.Paginator.PerPage,
+	}
+}
+
 // Cursor returns the index of the cursor on the current page.
 func (m Model) Cursor() int {
 	return m.cursor
@@ -640,10 +761,12 @@ func (m *Model) resetFiltering() {
 }
 
 func (m Model) itemsAsFilterItems() filteredItems {
-	fi := make([]filteredItem, len(m.items))
-	for i, item := range m.items {
+	items := m.sortedOrItems()
+	fi := make([]filteredItem, len(items))
+	for i, item := range items {
 		fi[i] = filteredItem{
-			item: item,
+			index: m.sortedIndex(i),
+			item:  item,
 		}
 	}
 	return filteredItems(fi)
@@ -663,6 +786,13 @@ func (m *Model) updateKeybindings() {
 		m.KeyMap.ClearFilter.SetEnabled(false)
 		m.KeyMap.CancelWhileFiltering.SetEnabled(true)
 		m.KeyMap.AcceptWhileFiltering.SetEnabled(m.FilterInput.Value() != "")
+		m.KeyMap.PrevFilter.SetEnabled(len(m.filterHistory) > 0)
+		m.KeyMap.NextFilter.SetEnabled(len(m.filterHistory) > 0)
+		m.KeyMap.ShowSavedFilters.SetEnabled(false)
+		m.KeyMap.TogglePin.SetEnabled(false)
+		m.KeyMap.CycleSort.SetEnabled(false)
+		m.KeyMap.ApplySavedFilter.SetEnabled(false)
+		m.KeyMap.CloseSavedFilters.SetEnabled(false)
 		m.KeyMap.Quit.SetEnabled(false)
 		m.KeyMap.ShowFullHelp.SetEnabled(false)
 		m.KeyMap.CloseFullHelp.SetEnabled(false)
@@ -678,12 +808,20 @@ func (m *Model) updateKeybindings() {
 
 		m.KeyMap.GoToStart.SetEnabled(hasItems)
 		m.KeyMap.GoToEnd.SetEnabled(hasItems)
+		m.KeyMap.TogglePin.SetEnabled(hasItems && m.pinningEnabled && !m.savedFiltersOpen)
+		m.KeyMap.CycleSort.SetEnabled(hasItems && len(m.sortNames) > 0 && !m.savedFiltersOpen)
 
 		m.KeyMap.Filter.SetEnabled(m.filteringEnabled && hasItems)
 		m.KeyMap.ClearFilter.SetEnabled(m.filterState == FilterApplied)
 		m.KeyMap.CancelWhileFiltering.SetEnabled(false)
 		m.KeyMap.AcceptWhileFiltering.SetEnabled(false)
-		m.KeyMap.Quit.SetEnabled(!m.disableQuitKeybindings)
+		m.KeyMap.PrevFilter.SetEnabled(false)
+		m.KeyMap.NextFilter.SetEnabled(false)
+		m.KeyMap.Quit.SetEnabled(!m.disableQuitKeybindings && !m.savedFiltersOpen)
+
+		m.KeyMap.ShowSavedFilters.SetEnabled(m.filteringEnabled && hasItems && len(m.savedFilters) > 0 && !m.savedFiltersOpen)
+		m.KeyMap.ApplySavedFilter.SetEnabled(m.savedFiltersOpen)
+		m.KeyMap.CloseSavedFilters.SetEnabled(m.savedFiltersOpen)
 
 		if m.Help.ShowAll {
 			m.KeyMap.ShowFullHelp.SetEnabled(true)
@@ -698,7 +836,7 @@ func (m *Model) updateKeybindings() {
 
 // Update pagination according to the amount of items for the current state.
 func (m *Model) updatePagination() {
-	index := m.Index()
+	index := m.VisibleIndex()
 	availHeight := m.height
 
 	if m.showTitle || (m.showFilter && m.filteringEnabled) {
@@ -750,7 +888,7 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		}
 
 	case FilterMatchesMsg:
-		m.filteredItems = filteredItems(msg)
+		m.filteredItems = m.sortFiltered(filteredItems(msg))
 		return m, nil
 
 	case spinner.TickMsg:
@@ -759,12 +897,18 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		if m.showSpinner {
 			cmds = append(cmds, cmd)
 		}
+		cmds = append(cmds, m.updateStatusSpinner(msg))
+
+	case ItemStatusMsg:
+		return m, m.SetItemStatus(msg.ID, msg.Status)
 
 	case statusMessageTimeoutMsg:
 		m.hideStatusMessage()
 	}
 
-	if m.filterState == Filtering {
+	if m.savedFiltersOpen {
+		cmds = append(cmds, m.handleSavedFilters(msg))
+	} else if m.filterState == Filtering {
 		cmds = append(cmds, m.handleFiltering(msg))
 	} else {
 		cmds = append(cmds, m.handleBrowsing(msg))
@@ -780,6 +924,10 @@ func (m *Model) handleBrowsing(msg tea.Msg) tea.Cmd {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if ok, cmd := m.handleShortcut(msg); ok {
+			return cmd
+		}
+
 		switch {
 		// Note: we match clear filter before quit because, by default, they're
 		// both mapped to escape.
@@ -818,17 +966,35 @@ func (m *Model) handleBrowsing(msg tea.Msg) tea.Cmd {
 			m.Paginator.Page = 0
 			m.cursor = 0
 			m.filterState = Filtering
+			m.historyIndex = -1
 			m.FilterInput.CursorEnd()
 			m.FilterInput.Focus()
 			m.updateKeybindings()
 			return textinput.Blink
 
+		case key.Matches(msg, m.KeyMap.TogglePin):
+			m.togglePin()
+
+		case key.Matches(msg, m.KeyMap.CycleSort):
+			m.CycleSortOrder()
+
+		case key.Matches(msg, m.KeyMap.ShowSavedFilters):
+			m.hideStatusMessage()
+			m.savedFiltersOpen = true
+			m.updateKeybindings()
+			return nil
+
 		case key.Matches(msg, m.KeyMap.ShowFullHelp):
 			fallthrough
 		case key.Matches(msg, m.KeyMap.CloseFullHelp):
 			m.Help.ShowAll = !m.Help.ShowAll
 			m.updatePagination()
 		}
+
+	case tea.MouseMsg:
+		if m.MouseEnabled {
+			m.handleMouse(msg)
+		}
 	}
 
 	cmd := m.delegate.Update(msg, m)
@@ -850,6 +1016,18 @@ func (m *Model) handleFiltering(msg tea.Msg) tea.Cmd {
 	// Handle keys
 	if msg, ok := msg.(tea.KeyMsg); ok {
 		switch {
+		// Note: we match the history keybindings before accepting the
+		// filter because, by default, up and down also accept it.
+		case key.Matches(msg, m.KeyMap.PrevFilter):
+			cmd := m.browseFilterHistory(-1)
+			m.updatePagination()
+			return cmd
+
+		case key.Matches(msg, m.KeyMap.NextFilter):
+			cmd := m.browseFilterHistory(1)
+			m.updatePagination()
+			return cmd
+
 		case key.Matches(msg, m.KeyMap.CancelWhileFiltering):
 			m.resetFiltering()
 			m.KeyMap.Filter.SetEnabled(true)
@@ -872,6 +1050,7 @@ func (m *Model) handleFiltering(msg tea.Msg) tea.Cmd {
 
 			m.FilterInput.Blur()
 			m.filterState = FilterApplied
+			m.addFilterHistory(m.FilterInput.Value())
 			m.updateKeybindings()
 
 			if m.FilterInput.Value() == "" {
@@ -901,6 +1080,15 @@ func (m *Model) handleFiltering(msg tea.Msg) tea.Cmd {
 // ShortHelp returns bindings to show in the abbreviated help view. It's part
 // of the help.KeyMap interface.
 func (m Model) ShortHelp() []key.Binding {
+	if m.savedFiltersOpen {
+		return []key.Binding{
+			m.KeyMap.CursorUp,
+			m.KeyMap.CursorDown,
+			m.KeyMap.ApplySavedFilter,
+			m.KeyMap.CloseSavedFilters,
+		}
+	}
+
 	kb := []key.Binding{
 		m.KeyMap.CursorUp,
 		m.KeyMap.CursorDown,
@@ -921,6 +1109,8 @@ func (m Model) ShortHelp() []key.Binding {
 		m.KeyMap.ClearFilter,
 		m.KeyMap.AcceptWhileFiltering,
 		m.KeyMap.CancelWhileFiltering,
+		m.KeyMap.PrevFilter,
+		m.KeyMap.NextFilter,
 	)
 
 	if !filtering && m.AdditionalShortHelpKeys != nil {
@@ -936,6 +1126,10 @@ func (m Model) ShortHelp() []key.Binding {
 // FullHelp returns bindings to show the full help view. It's part of the
 // help.KeyMap interface.
 func (m Model) FullHelp() [][]key.Binding {
+	if m.savedFiltersOpen {
+		return [][]key.Binding{m.ShortHelp()}
+	}
+
 	kb := [][]key.Binding{{
 		m.KeyMap.CursorUp,
 		m.KeyMap.CursorDown,
@@ -956,10 +1150,15 @@ func (m Model) FullHelp() [][]key.Binding {
 	}
 
 	listLevelBindings := []key.Binding{
+		m.KeyMap.CycleSort,
+		m.KeyMap.TogglePin,
 		m.KeyMap.Filter,
 		m.KeyMap.ClearFilter,
+		m.KeyMap.ShowSavedFilters,
 		m.KeyMap.AcceptWhileFiltering,
 		m.KeyMap.CancelWhileFiltering,
+		m.KeyMap.PrevFilter,
+		m.KeyMap.NextFilter,
 	}
 
 	if !filtering && m.AdditionalFullHelpKeys != nil {
@@ -1005,7 +1204,12 @@ func (m Model) View() string {
 		availHeight -= lipgloss.Height(help)
 	}
 
-	content := lipgloss.NewStyle().Height(availHeight).Render(m.populatedView())
+	var content string
+	if m.savedFiltersOpen {
+		content = lipgloss.Place(m.width, availHeight, lipgloss.Center, lipgloss.Center, m.savedFiltersView())
+	} else {
+		content = lipgloss.NewStyle().Height(availHeight).Render(m.populatedView())
+	}
 	sections = append(sections, content)
 
 	if m.showPagination {
@@ -1080,18 +1284,18 @@ func (m Model) statusView() string {
 		itemName = m.itemNameSingular
 	}
 
-	itemsDisplay := fmt.Sprintf("%d %s", visibleItems, itemName)
+	itemsDisplay := i18n.Format("%d %s", visibleItems, itemName)
 
 	if m.filterState == Filtering {
 		// Filter results
 		if visibleItems == 0 {
-			status = m.Styles.StatusEmpty.Render("Nothing matched")
+			status = m.Styles.StatusEmpty.Render(i18n.Text("Nothing matched"))
 		} else {
 			status = itemsDisplay
 		}
 	} else if len(m.items) == 0 {
 		// Not filtering: no items.
-		status = m.Styles.StatusEmpty.Render("No " + m.itemNamePlural)
+		status = m.Styles.StatusEmpty.Render(i18n.Format("No %s", m.itemNamePlural))
 	} else {
 		// Normal
 		filtered := m.FilterState() == FilterApplied
@@ -1108,7 +1312,12 @@ func (m Model) statusView() string {
 	numFiltered := totalItems - visibleItems
 	if numFiltered > 0 {
 		status += m.Styles.DividerDot.String()
-		status += m.Styles.StatusBarFilterCount.Render(fmt.Sprintf("%d filtered", numFiltered))
+		status += m.Styles.StatusBarFilterCount.Render(i18n.Format("%d filtered", numFiltered))
+	}
+
+	if m.sortOrder != "" && visibleItems > 0 {
+		status += m.Styles.DividerDot.String()
+		status += m.Styles.StatusBarSortOrder.Render(i18n.Format("sorted by %s", m.sortOrder))
 	}
 
 	return m.Styles.StatusBar.Render(status)
@@ -1146,11 +1355,11 @@ func (m Model) populatedView() string {
 		if m.filterState == Filtering {
 			return ""
 		}
-		return m.Styles.NoItems.Render("No " + m.itemNamePlural + " found.")
+		return m.Styles.NoItems.Render(i18n.Format("No %s found.", m.itemNamePlural))
 	}
 
 	if len(items) > 0 {
-		start, end := m.Paginator.GetSliceBounds(len(items))
+		start, end := m.window(len(items)).Visible()
 		docs := items[start:end]
 
 		for i, item := range docs {
@@ -1200,6 +1409,7 @@ func filterItems(m Model) tea.Cmd {
 		filterMatches := []filteredItem{}
 		for _, r := range m.Filter(m.FilterInput.Value(), targets) {
 			filterMatches = append(filterMatches, filteredItem{
+				index:   r.Index,
 				item:    items[r.Index],
 				matches: r.MatchedIndexes,
 			})
@@ -1235,13 +1445,20 @@ func removeItemFromSlice(i []Item, index int) []Item {
 	return i[:len(i)-1]
 }
 
-func removeFilterMatchFromSlice(i []filteredItem, index int) []filteredItem {
-	if index >= len(i) {
-		return i // noop
+// removeFilterMatch removes the match of the item at the given index in items,
+// if there's one, and shifts the indices of the items after it.
+func removeFilterMatch(items []filteredItem, index int) []filteredItem {
+	result := items[:0]
+	for _, fi := range items {
+		if fi.index == index {
+			continue
+		}
+		if fi.index > index {
+			fi.index--
+		}
+		result = append(result, fi)
 	}
-	copy(i[index:], i[index+1:])
-	i[len(i)-1] = filteredItem{}
-	return i[:len(i)-1]
+	return result
 }
 
 func countEnabledBindings(groups [][]key.Binding) (agg int) {
@@ -1261,3 +1478,17 @@ func max(a, b int) int {
 	}
 	return b
 }
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func clamp(v, low, high int) int {
+	if high < low {
+		return low
+	}
+	return min(high, max(low, v))
+}
@@ -72,3 +72,18 @@ func TestCustomStatusBarItemName(t *testing.T) {
 		t.Fatalf("Error: expected view to contain %s", expected)
 	}
 }
+
+func TestPopulatedViewRendersPage(t *testing.T) {
+	items := make([]Item, 25)
+	for i := range items {
+		items[i] = item(fmt.Sprint(i))
+	}
+	list := New(items, itemDelegate{}, 20, 10)
+	list.Select(list.Paginator.PerPage + 1)
+
+	view := list.populatedView()
+	first := fmt.Sprintf("%d. ", list.Paginator.PerPage+1)
+	if !strings.Contains(view, first) || strings.Contains(view, "\n1. ") || strings.HasPrefix(view, "1. ") {
+		t.Fatalf("expected the second page to be rendered, got:\n%s", view)
+	}
+}
@@ -0,0 +1,51 @@
+package list
+
+import (
+	"github.com/charmbracelet/bubbles/mouse"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// mouseWheelDelta is the number of items the mouse wheel moves the cursor.
+const mouseWheelDelta = 3
+
+// regions returns the regions of the items on the current page, with their
+// indices in the visible items as IDs.
+func (m Model) regions() mouse.Regions {
+	var (
+		regions mouse.Regions
+		y       int
+	)
+	if m.showTitle || (m.showFilter && m.filteringEnabled) {
+		y += lipgloss.Height(m.titleView())
+	}
+	if m.showStatusBar {
+		y += lipgloss.Height(m.statusView())
+	}
+
+	start, end := m.window(len(m.VisibleItems())).Visible()
+	step := m.delegate.Height() + m.delegate.Spacing()
+	for i := start; i < end; i++ {
+		regions.Add(i, 0, y+(i-start)*step, m.width, m.delegate.Height())
+	}
+	return regions
+}
+
+// handleMouse selects the item under a click and moves the cursor with the
+// mouse wheel.
+func (m *Model) handleMouse(msg tea.MouseMsg) {
+	if msg.Type == tea.MouseLeft {
+		if ev, ok := m.regions().Hit(msg, m.XPosition, m.YPosition); ok {
+			m.Select(ev.ID)
+		}
+		return
+	}
+
+	n := mouse.Wheel(msg, mouseWheelDelta)
+	for ; n < 0; n++ {
+		m.CursorUp()
+	}
+	for ; n > 0; n-- {
+		m.CursorDown()
+	}
+}
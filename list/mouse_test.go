@@ -0,0 +1,33 @@
+package list
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestMouse(t *testing.T) {
+	items := []Item{item("a"), item("b"), item("c"), item("d"), item("e")}
+	list := New(items, itemDelegate{}, 20, 20)
+	list.SetShowStatusBar(false)
+	list.MouseEnabled = true
+	list.XPosition, list.YPosition = 2, 3
+
+	top := 3 + lipgloss.Height(list.titleView())
+	list, _ = list.Update(tea.MouseMsg{X: 4, Y: top + 3, Type: tea.MouseLeft})
+	if list.Index() != 3 {
+		t.Fatalf("expected the clicked item to be selected, got %d", list.Index())
+	}
+
+	list, _ = list.Update(tea.MouseMsg{Type: tea.MouseWheelUp})
+	if list.Index() != 0 {
+		t.Fatalf("expected the wheel to move the cursor up, got %d", list.Index())
+	}
+
+	// Clicks above the items are ignored.
+	list, _ = list.Update(tea.MouseMsg{X: 4, Y: 3, Type: tea.MouseLeft})
+	if list.Index() != 0 {
+		t.Fatalf("expected the cursor to stay, got %d", list.Index())
+	}
+}
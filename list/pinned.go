@@ -0,0 +1,127 @@
+package list
+
+// IdentifiableItem is an item with a stable ID, which is used to remember
+// pinned items. Items that don't implement it are identified by their
+// FilterValue.
+type IdentifiableItem interface {
+	Item
+	ID() string
+}
+
+// SetPinningEnabled enables or disables pinning items with the TogglePin
+// keybinding. It's disabled by default. Items can be pinned with Pin either
+// way.
+func (m *Model) SetPinningEnabled(v bool) {
+	m.pinningEnabled = v
+	m.updateKeybindings()
+}
+
+// PinningEnabled returns whether items can be pinned with the TogglePin
+// keybinding.
+func (m Model) PinningEnabled() bool {
+	return m.pinningEnabled
+}
+
+// Pin pins an item, keeping it at the top of the list regardless of sorting
+// and filtering. Pinned items are kept in the exported Pinned slice.
+func (m *Model) Pin(item Item) {
+	if m.IsPinned(item) {
+		return
+	}
+	m.Pinned = append(m.Pinned, itemID(item))
+	m.repin(item)
+}
+
+// Unpin unpins an item.
+func (m *Model) Unpin(item Item) {
+	id := itemID(item)
+	for i, p := range m.Pinned {
+		if p == id {
+			m.Pinned = append(m.Pinned[:i:i], m.Pinned[i+1:]...)
+			break
+		}
+	}
+	m.repin(item)
+}
+
+// IsPinned returns whether an item is pinned.
+func (m Model) IsPinned(item Item) bool {
+	id := itemID(item)
+	for _, p := range m.Pinned {
+		if p == id {
+			return true
+		}
+	}
+	return false
+}
+
+// togglePin pins the selected item, or unpins it if it's pinned.
+func (m *Model) togglePin() {
+	item := m.SelectedItem()
+	if item == nil {
+		return
+	}
+	if m.IsPinned(item) {
+		m.Unpin(item)
+	} else {
+		m.Pin(item)
+	}
+}
+
+// repin keeps the given item selected after the pinned items changed.
+func (m *Model) repin(item Item) {
+	m.updatePagination()
+	id := itemID(item)
+	for i, v := range m.VisibleItems() {
+		if itemID(v) == id {
+			m.Select(i)
+			return
+		}
+	}
+}
+
+// pinFirst moves the pinned items to the front of the given items, in the
+// order they were pinned. Pinned items that are filtered out are added.
+func (m Model) pinFirst(items filteredItems) filteredItems {
+	if len(m.Pinned) == 0 {
+		return items
+	}
+
+	pinned := make(map[string]bool, len(m.Pinned))
+	for _, id := range m.Pinned {
+		pinned[id] = true
+	}
+
+	// Find the pinned items among all items, preferring the filter matches.
+	byID := make(map[string]filteredItem, len(m.Pinned))
+	for i, item := range m.items {
+		if id := itemID(item); pinned[id] {
+			byID[id] = filteredItem{index: i, item: item}
+		}
+	}
+	rest := make(filteredItems, 0, len(items))
+	for _, fi := range items {
+		if id := itemID(fi.item); pinned[id] {
+			byID[id] = fi
+			continue
+		}
+		rest = append(rest, fi)
+	}
+
+	result := make(filteredItems, 0, len(byID)+len(rest))
+	for _, id := range m.Pinned {
+		if fi, ok := byID[id]; ok {
+			result = append(result, fi)
+			delete(byID, id)
+		}
+	}
+	return append(result, rest...)
+}
+
+// itemID returns the ID of an item.
+func itemID(item Item) string {
+	if i, ok := item.(IdentifiableItem); ok {
+		return i.ID()
+	}
+	return item.FilterValue()
+}
@@ -0,0 +1,48 @@
+package list
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func visibleNames(m Model) string {
+	var names []string
+	for _, item := range m.VisibleItems() {
+		names = append(names, item.FilterValue())
+	}
+	return strings.Join(names, ",")
+}
+
+func TestPinned(t *testing.T) {
+	list := fruitList()
+	list.Select(2)
+	list, _ = list.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+	if len(list.Pinned) != 0 {
+		t.Fatalf("expected pinning to be disabled by default, got %v", list.Pinned)
+	}
+
+	list.SetPinningEnabled(true)
+	list, _ = list.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("p")})
+
+	if got := visibleNames(list); got != "cherry,apple,banana" {
+		t.Fatalf("expected cherry pinned to the top, got %s", got)
+	}
+	if list.VisibleIndex() != 0 || list.Index() != 2 || len(list.Pinned) != 1 || list.Pinned[0] != "cherry" {
+		t.Fatalf("expected cherry to stay selected and be pinned, got %d (%d), %v", list.VisibleIndex(), list.Index(), list.Pinned)
+	}
+
+	list.ApplyFilter("ban")
+	if got := visibleNames(list); got != "cherry,banana" {
+		t.Fatalf("expected pinned items to be shown when filtered, got %s", got)
+	}
+	if matches := list.MatchesForItem(1); len(matches) != 3 {
+		t.Fatalf("expected the matches of banana, got %v", matches)
+	}
+
+	list.Unpin(fruit("cherry"))
+	if got := visibleNames(list); got != "banana" {
+		t.Fatalf("expected cherry to be filtered out when unpinned, got %s", got)
+	}
+}
@@ -0,0 +1,122 @@
+package list
+
+import (
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ShortcutItem is an item with its own quick-select shortcut, a single key
+// like "a" or "5". Pressing it selects the item.
+type ShortcutItem interface {
+	Item
+	Shortcut() string
+}
+
+// ShortcutSelectedMsg is sent when the user has selected an item by pressing
+// its shortcut. Menus built on the list usually act on the item right away.
+type ShortcutSelectedMsg struct {
+	// Index is the index of the item in the visible items.
+	Index int
+	Item  Item
+}
+
+// SetShortcutsEnabled enables or disables quick-select shortcuts. Items that
+// implement ShortcutItem get their own shortcut, and the other items on the
+// current page are numbered 1 to 9, skipping the numbers taken by the items'
+// own shortcuts. Shortcuts take precedence over the list's keybindings, so
+// avoid giving items the keys used for browsing.
+func (m *Model) SetShortcutsEnabled(v bool) {
+	m.shortcuts = v
+}
+
+// ShortcutsEnabled returns whether quick-select shortcuts are enabled.
+func (m Model) ShortcutsEnabled() bool {
+	return m.shortcuts
+}
+
+// ShortcutForItem returns the shortcut of the item at the given index in the
+// visible items, or an empty string if it has none. Delegates use it to
+// render the shortcut next to the item.
+//
+// See DefaultDelegate.Render for a usage example.
+func (m Model) ShortcutForItem(index int) string {
+	if !m.shortcuts {
+		return ""
+	}
+	return m.pageShortcuts()[index]
+}
+
+// pageShortcuts returns the shortcuts of the items on the current page, by
+// their index in the visible items.
+func (m Model) pageShortcuts() map[int]string {
+	items := m.VisibleItems()
+	start, end := m.window(len(items)).Visible()
+
+	shortcuts := make(map[int]string, end-start)
+	taken := make(map[string]bool)
+	for i := start; i < end; i++ {
+		if s := itemShortcut(items[i]); s != "" {
+			shortcuts[i] = s
+			taken[s] = true
+		}
+	}
+
+	n := 1
+	for i := start; i < end && n <= 9; i++ {
+		if _, ok := shortcuts[i]; ok {
+			continue
+		}
+		for n <= 9 && taken[strconv.Itoa(n)] {
+			n++
+		}
+		if n <= 9 {
+			shortcuts[i] = strconv.Itoa(n)
+			n++
+		}
+	}
+	return shortcuts
+}
+
+// handleShortcut selects the item whose shortcut was pressed. It returns
+// whether there was one. The items' own shortcuts select items on any page.
+func (m *Model) handleShortcut(msg tea.KeyMsg) (bool, tea.Cmd) {
+	if !m.shortcuts {
+		return false, nil
+	}
+	k := msg.String()
+
+	items := m.VisibleItems()
+	index := -1
+	for i, item := range items {
+		if itemShortcut(item) == k {
+			index = i
+			break
+		}
+	}
+	if index < 0 {
+		for i, s := range m.pageShortcuts() {
+			if s == k {
+				index = i
+				break
+			}
+		}
+	}
+	if index < 0 {
+		return false, nil
+	}
+
+	m.Select(index)
+	item := items[index]
+	return true, func() tea.Msg {
+		return ShortcutSelectedMsg{Index: index, Item: item}
+	}
+}
+
+// itemShortcut returns the item's own shortcut, if it has one.
+func itemShortcut(item Item) string {
+	if i, ok := item.(ShortcutItem); ok {
+		return i.Shortcut()
+	}
+	return ""
+}
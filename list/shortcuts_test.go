@@ -0,0 +1,52 @@
+package list
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+type menuItem struct {
+	title, shortcut string
+}
+
+func (i menuItem) FilterValue() string { return i.title }
+func (i menuItem) Title() string       { return i.title }
+func (i menuItem) Description() string { return "" }
+func (i menuItem) Shortcut() string    { return i.shortcut }
+
+func TestShortcuts(t *testing.T) {
+	d := NewDefaultDelegate()
+	d.ShowDescription = false
+	d.Styles.Shortcut = lipgloss.NewStyle()
+	list := New([]Item{
+		menuItem{title: "Open"},
+		menuItem{title: "Quit", shortcut: "1"},
+		menuItem{title: "Save", shortcut: "s"},
+		menuItem{title: "Close"},
+	}, d, 40, 20)
+	list.SetShortcutsEnabled(true)
+
+	want := []string{"2", "1", "s", "3"}
+	for i, w := range want {
+		if got := list.ShortcutForItem(i); got != w {
+			t.Fatalf("item %d: expected shortcut %q, got %q", i, w, got)
+		}
+	}
+	if !strings.Contains(list.View(), "s Save") {
+		t.Fatalf("expected the shortcut next to the item, got:\n%s", list.View())
+	}
+
+	list, _ = list.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")})
+	if list.Index() != 2 {
+		t.Fatalf("expected item 2 to be selected, got %d", list.Index())
+	}
+
+	_, cmd := list.handleShortcut(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("3")})
+	msg, ok := cmd().(ShortcutSelectedMsg)
+	if !ok || msg.Index != 3 || msg.Item.FilterValue() != "Close" {
+		t.Fatalf("unexpected message %#v", msg)
+	}
+}
@@ -0,0 +1,116 @@
+package list
+
+import "sort"
+
+// LessFunc reports whether item a sorts before item b.
+type LessFunc func(a, b Item) bool
+
+// SetSortFuncs sets the sort orders the user can cycle through with the
+// CycleSort keybinding, by name. The orders are cycled through in the order of
+// their names, after the unsorted order. Setting the sort orders resets the
+// list to the unsorted order.
+func (m *Model) SetSortFuncs(funcs map[string]LessFunc) {
+	m.sortFuncs = funcs
+	m.sortNames = make([]string, 0, len(funcs))
+	for name := range funcs {
+		m.sortNames = append(m.sortNames, name)
+	}
+	sort.Strings(m.sortNames)
+	m.SetSortOrder("")
+}
+
+// SortOrder returns the name of the active sort order, or an empty string if
+// the list isn't sorted.
+func (m Model) SortOrder() string {
+	return m.sortOrder
+}
+
+// SetSortOrder sorts the list by the sort order with the given name. An empty
+// name, or one that isn't a sort order, restores the unsorted order. Sorting
+// applies to filter results too; they're ranked by how well they match
+// otherwise.
+func (m *Model) SetSortOrder(name string) {
+	if _, ok := m.sortFuncs[name]; !ok {
+		name = ""
+	}
+	m.sortOrder = name
+	m.resort()
+
+	if m.filterState != Unfiltered && m.FilterInput.Value() != "" {
+		if msg, ok := filterItems(*m)().(FilterMatchesMsg); ok {
+			m.filteredItems = m.sortFiltered(filteredItems(msg))
+		}
+	} else if m.filterState == Filtering {
+		m.filteredItems = m.itemsAsFilterItems()
+	}
+	m.updatePagination()
+	m.updateKeybindings()
+}
+
+// CycleSortOrder switches to the next sort order.
+func (m *Model) CycleSortOrder() {
+	if len(m.sortNames) == 0 {
+		return
+	}
+	next := m.sortNames[0]
+	for i, name := range m.sortNames {
+		if name == m.sortOrder {
+			next = ""
+			if i+1 < len(m.sortNames) {
+				next = m.sortNames[i+1]
+			}
+			break
+		}
+	}
+	m.SetSortOrder(next)
+}
+
+// resort sorts the items by the active sort order. It must be called
+// whenever the items change.
+func (m *Model) resort() {
+	less := m.sortFuncs[m.sortOrder]
+	if less == nil {
+		m.sortedItems, m.sortedIndexes = nil, nil
+		return
+	}
+	m.sortedIndexes = make([]int, len(m.items))
+	for i := range m.sortedIndexes {
+		m.sortedIndexes[i] = i
+	}
+	sort.SliceStable(m.sortedIndexes, func(i, j int) bool {
+		return less(m.items[m.sortedIndexes[i]], m.items[m.sortedIndexes[j]])
+	})
+	m.sortedItems = make([]Item, len(m.items))
+	for i, j := range m.sortedIndexes {
+		m.sortedItems[i] = m.items[j]
+	}
+}
+
+// sortFiltered sorts filter results by the active sort order.
+func (m Model) sortFiltered(items filteredItems) filteredItems {
+	less := m.sortFuncs[m.sortOrder]
+	if less == nil {
+		return items
+	}
+	sort.SliceStable(items, func(i, j int) bool {
+		return less(items[i].item, items[j].item)
+	})
+	return items
+}
+
+// sortedIndex returns the index in items of the item at the given index in
+// the active sort order.
+func (m Model) sortedIndex(i int) int {
+	if m.sortedIndexes != nil {
+		return m.sortedIndexes[i]
+	}
+	return i
+}
+
+// sortedOrItems returns the items in the active sort order.
+func (m Model) sortedOrItems() []Item {
+	if m.sortedItems != nil {
+		return m.sortedItems
+	}
+	return m.items
+}
@@ -0,0 +1,69 @@
+package list
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestSortOrders(t *testing.T) {
+	list := New([]Item{fruit("banana"), fruit("cherry"), fruit("apple")}, itemDelegate{}, 40, 20)
+	list.SetSortFuncs(map[string]LessFunc{
+		"name":   func(a, b Item) bool { return a.FilterValue() < b.FilterValue() },
+		"length": func(a, b Item) bool { return len(a.FilterValue()) < len(b.FilterValue()) },
+	})
+
+	sortKey := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("s")}
+	list, _ = list.Update(sortKey)
+	if got := visibleNames(list); got != "apple,banana,cherry" || list.SortOrder() != "length" {
+		t.Fatalf("expected the items sorted by length, got %s by %q", got, list.SortOrder())
+	}
+	list, _ = list.Update(sortKey)
+	if got := visibleNames(list); got != "apple,banana,cherry" || list.SortOrder() != "name" {
+		t.Fatalf("expected the items sorted by name, got %s by %q", got, list.SortOrder())
+	}
+	if !strings.Contains(list.statusView(), "sorted by name") {
+		t.Fatalf("expected the sort order in the status bar, got %q", list.statusView())
+	}
+
+	list.ApplyFilter("an")
+	if got := visibleNames(list); got != "banana" {
+		t.Fatalf("expected the filter to apply, got %s", got)
+	}
+	list.ResetFilter()
+
+	list, _ = list.Update(sortKey)
+	if got := visibleNames(list); got != "banana,cherry,apple" || list.SortOrder() != "" {
+		t.Fatalf("expected the original order, got %s by %q", got, list.SortOrder())
+	}
+
+	list.SetSortOrder("length")
+	list.InsertItem(0, fruit("fig"))
+	if got := visibleNames(list); got != "fig,apple,banana,cherry" {
+		t.Fatalf("expected new items to be sorted, got %s", got)
+	}
+}
+
+func TestIndexMapsToItems(t *testing.T) {
+	list := New([]Item{fruit("a"), fruit("b"), fruit("c")}, itemDelegate{}, 40, 20)
+	list.SetSortFuncs(map[string]LessFunc{
+		"desc": func(a, b Item) bool { return a.FilterValue() > b.FilterValue() },
+	})
+	list.SetSortOrder("desc")
+
+	list.Select(0)
+	if list.Index() != 2 || list.VisibleIndex() != 0 {
+		t.Fatalf("expected c at index 2 of the items, got %d", list.Index())
+	}
+	list.RemoveItem(list.Index())
+	if got := visibleNames(list); got != "b,a" {
+		t.Fatalf("expected the selected item to be removed, got %s", got)
+	}
+
+	list.ApplyFilter("a")
+	list.Select(0)
+	if list.Index() != 0 {
+		t.Fatalf("expected the filter match to map to index 0 of the items, got %d", list.Index())
+	}
+}
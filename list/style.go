@@ -26,9 +26,20 @@ type Styles struct {
 	StatusEmpty           lipgloss.Style
 	StatusBarActiveFilter lipgloss.Style
 	StatusBarFilterCount  lipgloss.Style
+	StatusBarSortOrder    lipgloss.Style
 
 	NoItems lipgloss.Style
 
+	// Item statuses in bulk operations. See ItemStatus.
+	ItemProgress lipgloss.Style
+	ItemDone     lipgloss.Style
+	ItemFailed   lipgloss.Style
+
+	// The saved filters overlay.
+	SavedFilters        lipgloss.Style
+	SelectedSavedFilter lipgloss.Style
+	SavedFilterQuery    lipgloss.Style
+
 	PaginationStyle lipgloss.Style
 	HelpStyle       lipgloss.Style
 
@@ -74,9 +85,31 @@ func DefaultStyles() (s Styles) {
 
 	s.StatusBarFilterCount = lipgloss.NewStyle().Foreground(verySubduedColor)
 
+	s.StatusBarSortOrder = lipgloss.NewStyle().Foreground(subduedColor)
+
 	s.NoItems = lipgloss.NewStyle().
 		Foreground(lipgloss.AdaptiveColor{Light: "#909090", Dark: "#626262"})
 
+	s.ItemProgress = lipgloss.NewStyle().Foreground(subduedColor)
+
+	s.ItemDone = lipgloss.NewStyle().
+		Foreground(lipgloss.AdaptiveColor{Light: "#04B575", Dark: "#04B575"}).
+		SetString("✓")
+
+	s.ItemFailed = lipgloss.NewStyle().
+		Foreground(lipgloss.AdaptiveColor{Light: "#FF4672", Dark: "#ED567A"}).
+		SetString("✗")
+
+	s.SavedFilters = lipgloss.NewStyle().
+		Border(lipgloss.RoundedBorder()).
+		BorderForeground(subduedColor).
+		Padding(0, 1)
+
+	s.SelectedSavedFilter = lipgloss.NewStyle().
+		Foreground(lipgloss.AdaptiveColor{Light: "#EE6FF8", Dark: "#EE6FF8"})
+
+	s.SavedFilterQuery = lipgloss.NewStyle().Foreground(subduedColor)
+
 	s.ArabicPagination = lipgloss.NewStyle().Foreground(subduedColor)
 
 	s.PaginationStyle = lipgloss.NewStyle().PaddingLeft(2) //nolint:gomnd
@@ -0,0 +1,61 @@
+package list
+
+import "encoding/json"
+
+// ViewState is a snapshot of how the user has arranged the list: the applied
+// filter, the sort order, the pinned items and the selected item. It only has
+// exported fields of basic types, so it can be saved with encoding/json or
+// similar and restored in a later session with RestoreViewState.
+type ViewState struct {
+	Filter    string
+	SortOrder string
+	Pinned    []string
+
+	// FilterHistory holds the applied filter queries, oldest first.
+	FilterHistory []string
+
+	// Index is the index of the selected item among the visible items.
+	Index int
+}
+
+// ViewState returns the current view state of the list. A filter that's
+// being typed isn't part of it.
+func (m Model) ViewState() ViewState {
+	s := ViewState{
+		SortOrder:     m.sortOrder,
+		Pinned:        append([]string(nil), m.Pinned...),
+		FilterHistory: append([]string(nil), m.filterHistory...),
+		Index:         m.VisibleIndex(),
+	}
+	if m.filterState == FilterApplied {
+		s.Filter = m.FilterInput.Value()
+	}
+	return s
+}
+
+// RestoreViewState restores a view state returned by ViewState. Since the
+// items may have changed in the meantime, a selection past the last visible
+// item selects the last one.
+func (m *Model) RestoreViewState(s ViewState) {
+	m.Pinned = append([]string(nil), s.Pinned...)
+	m.SetFilterHistory(s.FilterHistory)
+	m.SetSortOrder(s.SortOrder)
+	m.ApplyFilter(s.Filter)
+	m.Select(clamp(s.Index, 0, len(m.VisibleItems())-1))
+}
+
+// MarshalState returns the view state of the list as JSON. It satisfies the
+// state.Stateful interface along with UnmarshalState.
+func (m Model) MarshalState() ([]byte, error) {
+	return json.Marshal(m.ViewState())
+}
+
+// UnmarshalState restores a view state returned by MarshalState.
+func (m *Model) UnmarshalState(data []byte) error {
+	var s ViewState
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	m.RestoreViewState(s)
+	return nil
+}
@@ -0,0 +1,40 @@
+package list
+
+import "testing"
+
+type namedItem string
+
+func (i namedItem) FilterValue() string { return string(i) }
+
+func TestViewStateRoundTrip(t *testing.T) {
+	newList := func() Model {
+		items := []Item{namedItem("apple"), namedItem("banana"), namedItem("cherry"), namedItem("blueberry")}
+		l := New(items, itemDelegate{}, 20, 20)
+		l.SetSortFuncs(map[string]LessFunc{
+			"name": func(a, b Item) bool { return a.FilterValue() < b.FilterValue() },
+		})
+		return l
+	}
+
+	l := newList()
+	l.SetSortOrder("name")
+	l.ApplyFilter("b")
+	l.Select(1)
+
+	data, err := l.MarshalState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored := newList()
+	if err := restored.UnmarshalState(data); err != nil {
+		t.Fatal(err)
+	}
+
+	if restored.SortOrder() != "name" || restored.FilterValue() != "b" {
+		t.Fatalf("expected the sort order and the filter to be restored, got %q and %q",
+			restored.SortOrder(), restored.FilterValue())
+	}
+	if got, exp := restored.SelectedItem(), l.SelectedItem(); got != exp {
+		t.Fatalf("expected %v to be selected, got %v", exp, got)
+	}
+}
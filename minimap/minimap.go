@@ -0,0 +1,270 @@
+// Package minimap provides a minimap: a narrow column with a compressed
+// overview of long content, such as the content of a viewport, with the part
+// in view highlighted. Every character of the minimap is a braille pattern of
+// 2×4 dots, each dot standing for a few characters of the content.
+//
+// Set the same content as the viewport and sync the minimap with the
+// viewport after updating it. With the mouse enabled, clicking the minimap or
+// scrolling over it sends a JumpMsg with the offset to scroll the viewport to:
+//
+//	m.minimap.SetContent(content)
+//	m.minimap.Sync(m.viewport)
+//
+//	case minimap.JumpMsg:
+//	    m.viewport.SetYOffset(msg.YOffset)
+package minimap
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/route"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/ansi"
+)
+
+// JumpMsg is sent when the minimap was clicked or scrolled.
+type JumpMsg struct {
+	// ID is the ID of the minimap that sent the message.
+	ID int
+
+	// YOffset is the offset to scroll the content to.
+	YOffset int
+}
+
+// Styles contains the styles used to render the minimap.
+type Styles struct {
+	// Content are the lines outside of the view, and Visible those in view.
+	Content lipgloss.Style
+	Visible lipgloss.Style
+}
+
+// DefaultStyles returns a set of default style definitions for the minimap.
+func DefaultStyles() Styles {
+	return Styles{
+		Content: lipgloss.NewStyle().Foreground(lipgloss.Color("240")),
+		Visible: lipgloss.NewStyle().Foreground(lipgloss.Color("250")).Background(lipgloss.Color("236")),
+	}
+}
+
+// braille dots by their column and row in a cell.
+var dots = [2][4]rune{
+	{0x01, 0x02, 0x04, 0x40},
+	{0x08, 0x10, 0x20, 0x80},
+}
+
+// Model is the minimap.
+type Model struct {
+	Styles Styles
+
+	// Width and Height are the size of the minimap.
+	Width  int
+	Height int
+
+	// MouseEnabled makes clicking and scrolling the minimap send a JumpMsg,
+	// given its position on the screen.
+	MouseEnabled bool
+	XPosition    int
+	YPosition    int
+
+	// MouseWheelDelta is the number of lines scrolling the minimap scrolls
+	// the content by.
+	MouseWheelDelta int
+
+	id        int
+	lines     []string
+	maxWidth  int
+	yOffset   int
+	viewLines int
+}
+
+// Option is used to set options in New.
+type Option func(*Model)
+
+// New creates a new minimap.
+func New(opts ...Option) Model {
+	m := Model{
+		Styles:          DefaultStyles(),
+		Width:           8,
+		Height:          20,
+		MouseWheelDelta: 3,
+		id:              route.NextID(),
+	}
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
+}
+
+// WithSize sets the size of the minimap.
+func WithSize(width, height int) Option {
+	return func(m *Model) {
+		m.Width = width
+		m.Height = height
+	}
+}
+
+// WithMouse enables clicking and scrolling the minimap at the given position
+// on the screen.
+func WithMouse(x, y int) Option {
+	return func(m *Model) {
+		m.MouseEnabled = true
+		m.XPosition = x
+		m.YPosition = y
+	}
+}
+
+// ID returns the minimap's unique ID.
+func (m Model) ID() int {
+	return m.id
+}
+
+// SetContent sets the content the minimap gives an overview of.
+func (m *Model) SetContent(s string) {
+	m.lines = strings.Split(strings.ReplaceAll(s, "\r\n", "\n"), "\n")
+	m.maxWidth = 0
+	for _, line := range m.lines {
+		m.maxWidth = max(m.maxWidth, ansi.PrintableRuneWidth(line))
+	}
+}
+
+// SetView sets the part of the content that's in view: the first line in
+// view and the number of lines in view.
+func (m *Model) SetView(yOffset, lines int) {
+	m.yOffset, m.viewLines = yOffset, lines
+}
+
+// Sync sets the part of the content that's in view to the part a viewport
+// shows.
+func (m *Model) Sync(vp viewport.Model) {
+	m.SetView(vp.YOffset, vp.Height-vp.Style.GetVerticalFrameSize())
+}
+
+// linesPerRow returns the number of lines of content every row of the
+// minimap stands for, so that all of the content fits.
+func (m Model) linesPerRow() int {
+	if m.Height <= 0 {
+		return 1
+	}
+	dotRows := m.Height * 4
+	return max(4, (len(m.lines)+dotRows-1)/dotRows*4)
+}
+
+// columnsPerDot returns the number of columns of content every dot stands
+// for, so that the widest line fits.
+func (m Model) columnsPerDot() int {
+	dotColumns := max(1, m.Width*2)
+	return max(1, (m.maxWidth+dotColumns-1)/dotColumns)
+}
+
+// Update sends a JumpMsg when the minimap is clicked or scrolled with the
+// mouse, if the mouse is enabled.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	mouse, ok := msg.(tea.MouseMsg)
+	if !ok || !m.MouseEnabled {
+		return m, nil
+	}
+	x, y := mouse.X-m.XPosition, mouse.Y-m.YPosition
+	if x < 0 || x >= m.Width || y < 0 || y >= m.Height {
+		return m, nil
+	}
+
+	var yOffset int
+	switch mouse.Type {
+	case tea.MouseLeft:
+		// Center the clicked line.
+		yOffset = y*m.linesPerRow() + m.linesPerRow()/2 - m.viewLines/2
+	case tea.MouseWheelUp:
+		yOffset = m.yOffset - m.MouseWheelDelta
+	case tea.MouseWheelDown:
+		yOffset = m.yOffset + m.MouseWheelDelta
+	default:
+		return m, nil
+	}
+	yOffset = max(0, min(yOffset, len(m.lines)-m.viewLines))
+	if yOffset == m.yOffset {
+		return m, nil
+	}
+	m.yOffset = yOffset
+
+	id := m.id
+	return m, func() tea.Msg {
+		return JumpMsg{ID: id, YOffset: yOffset}
+	}
+}
+
+// View renders the minimap.
+func (m Model) View() string {
+	perRow := m.linesPerRow()
+	perDot := m.columnsPerDot()
+	rows := make([]string, m.Height)
+
+	for row := range rows {
+		first := row * perRow
+		cells := make([]rune, m.Width)
+		for i := range cells {
+			cells[i] = 0x2800
+		}
+		for dotRow := 0; dotRow < 4; dotRow++ {
+			// Every dot row stands for a quarter of the row's lines. A dot
+			// is set if any of them has a character in its columns.
+			for line := first + dotRow*perRow/4; line < first+(dotRow+1)*perRow/4 && line < len(m.lines); line++ {
+				col := 0
+				for _, r := range stripANSI(m.lines[line]) {
+					if r != ' ' && r != '\t' {
+						dotCol := col / perDot
+						if cell := dotCol / 2; cell < m.Width {
+							cells[cell] |= dots[dotCol%2][dotRow]
+						}
+					}
+					col++
+				}
+			}
+		}
+
+		style := m.Styles.Content
+		if first < m.yOffset+m.viewLines && first+perRow > m.yOffset && first < max(1, len(m.lines)) {
+			style = m.Styles.Visible
+		}
+		rows[row] = style.Render(string(cells))
+	}
+	return strings.Join(rows, "\n")
+}
+
+// stripANSI removes the escape sequences of a line.
+func stripANSI(s string) string {
+	var (
+		b      strings.Builder
+		escape bool
+	)
+	for _, r := range s {
+		if r == ansi.Marker {
+			escape = true
+		}
+		if escape {
+			if ansi.IsTerminator(r) {
+				escape = false
+			}
+			continue
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,44 @@
+package minimap
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestView(t *testing.T) {
+	m := New(WithSize(2, 2))
+	m.Styles = Styles{Content: lipgloss.NewStyle(), Visible: lipgloss.NewStyle()}
+
+	// Eight lines fit in two rows of four dots, and every dot is a column.
+	m.SetContent("abcd\n a\n\n  \x1b[1mb\x1b[0m\nab\n\n\n   d")
+	exp := strings.Join([]string{
+		string([]rune{0x2800 | 0x01 | 0x08 | 0x10, 0x2800 | 0x01 | 0x08 | 0x40}),
+		string([]rune{0x2800 | 0x01 | 0x08, 0x2800 | 0x80}),
+	}, "\n")
+	if got := m.View(); got != exp {
+		t.Errorf("expected view %q, got %q", exp, got)
+	}
+}
+
+func TestJump(t *testing.T) {
+	m := New(WithSize(1, 5), WithMouse(10, 0))
+	m.SetContent(strings.Repeat("x\n", 99) + "x")
+	m.SetView(0, 10)
+
+	// A row stands for 20 lines, so clicking the third row centers line 50.
+	m, cmd := m.Update(tea.MouseMsg{X: 10, Y: 2, Type: tea.MouseLeft})
+	if msg, ok := cmd().(JumpMsg); !ok || msg.YOffset != 45 || msg.ID != m.ID() {
+		t.Fatalf("expected a jump to offset 45, got %#v", msg)
+	}
+
+	if _, cmd := m.Update(tea.MouseMsg{X: 9, Y: 2, Type: tea.MouseLeft}); cmd != nil {
+		t.Error("expected clicks next to the minimap to be ignored")
+	}
+	m, cmd = m.Update(tea.MouseMsg{X: 10, Y: 0, Type: tea.MouseWheelDown})
+	if msg, ok := cmd().(JumpMsg); !ok || msg.YOffset != 48 {
+		t.Fatalf("expected a jump to offset 48, got %#v", msg)
+	}
+}
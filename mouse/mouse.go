@@ -0,0 +1,100 @@
+// Package mouse maps mouse events to the parts of a component's view they
+// happen on, so that components don't each do their own coordinate math.
+//
+// A component lays out the clickable parts of its view as regions, relative
+// to its top-left corner, each with an ID that tells what's rendered there,
+// such as the index of a row. Given where the view is on the screen, Hit
+// finds the region under a tea.MouseMsg and returns the event in that
+// region's coordinates:
+//
+//	var regions mouse.Regions
+//	for i := range m.items {
+//	    regions.Add(i, 0, i, m.width, 1)
+//	}
+//	if ev, ok := regions.Hit(msg, m.XPosition, m.YPosition); ok && ev.Type == tea.MouseLeft {
+//	    m.cursor = ev.ID
+//	}
+package mouse
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// Region is a rectangular part of a component's view, in cells relative to
+// the view's top-left corner.
+type Region struct {
+	// ID identifies what's rendered in the region, such as the index of a
+	// row or a page.
+	ID int
+
+	X, Y          int
+	Width, Height int
+}
+
+// Contains returns whether the region covers the given coordinates.
+func (r Region) Contains(x, y int) bool {
+	return x >= r.X && x < r.X+r.Width && y >= r.Y && y < r.Y+r.Height
+}
+
+// Regions are the regions of a component's view. Regions added later are on
+// top of the ones added before them.
+type Regions []Region
+
+// Add adds a region.
+func (rs *Regions) Add(id, x, y, width, height int) {
+	*rs = append(*rs, Region{ID: id, X: x, Y: y, Width: width, Height: height})
+}
+
+// At returns the topmost region covering the given coordinates, relative to
+// the view's top-left corner.
+func (rs Regions) At(x, y int) (Region, bool) {
+	for i := len(rs) - 1; i >= 0; i-- {
+		if rs[i].Contains(x, y) {
+			return rs[i], true
+		}
+	}
+	return Region{}, false
+}
+
+// Event is a mouse event in a region of a component's view.
+type Event struct {
+	// MouseMsg is the mouse event, with X and Y relative to the region's
+	// top-left corner.
+	tea.MouseMsg
+
+	// ID is the ID of the region.
+	ID int
+}
+
+// Hit returns the event for a mouse message in the region under it, given
+// the position of the view's top-left corner on the screen. It returns false
+// if the message isn't over any region.
+func (rs Regions) Hit(msg tea.MouseMsg, x, y int) (Event, bool) {
+	msg = Local(msg, x, y)
+	r, ok := rs.At(msg.X, msg.Y)
+	if !ok {
+		return Event{}, false
+	}
+	msg.X -= r.X
+	msg.Y -= r.Y
+	return Event{MouseMsg: msg, ID: r.ID}, true
+}
+
+// Local returns a mouse message with its coordinates relative to a view
+// whose top-left corner is at the given position on the screen.
+func Local(msg tea.MouseMsg, x, y int) tea.MouseMsg {
+	msg.X -= x
+	msg.Y -= y
+	return msg
+}
+
+// Wheel returns the number of lines a mouse wheel event scrolls by, given the
+// number of lines per step: negative when scrolling up, positive when
+// scrolling down and 0 for other events.
+func Wheel(msg tea.MouseMsg, delta int) int {
+	switch msg.Type {
+	case tea.MouseWheelUp:
+		return -delta
+	case tea.MouseWheelDown:
+		return delta
+	}
+	return 0
+}
@@ -0,0 +1,51 @@
+package mouse
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestHit(t *testing.T) {
+	var regions Regions
+	regions.Add(0, 0, 0, 10, 1)
+	regions.Add(1, 0, 1, 10, 2)
+	regions.Add(2, 4, 1, 2, 1) // on top of the second region
+
+	tests := []struct {
+		x, y int
+		id   int
+		ok   bool
+		ex   int
+		ey   int
+	}{
+		{5, 10, 0, true, 0, 0},
+		{5, 12, 1, true, 0, 1},
+		{9, 11, 2, true, 0, 0},
+		{12, 11, 1, true, 7, 0},
+		{16, 11, 0, false, 0, 0},
+		{5, 13, 0, false, 0, 0},
+	}
+	for _, tt := range tests {
+		ev, ok := regions.Hit(tea.MouseMsg{X: tt.x, Y: tt.y, Type: tea.MouseLeft}, 5, 10)
+		if ok != tt.ok {
+			t.Errorf("(%d, %d): expected hit %t, got %t", tt.x, tt.y, tt.ok, ok)
+			continue
+		}
+		if ok && (ev.ID != tt.id || ev.X != tt.ex || ev.Y != tt.ey || ev.Type != tea.MouseLeft) {
+			t.Errorf("(%d, %d): expected region %d at (%d, %d), got %+v", tt.x, tt.y, tt.id, tt.ex, tt.ey, ev)
+		}
+	}
+}
+
+func TestWheel(t *testing.T) {
+	if n := Wheel(tea.MouseMsg{Type: tea.MouseWheelUp}, 3); n != -3 {
+		t.Errorf("expected -3, got %d", n)
+	}
+	if n := Wheel(tea.MouseMsg{Type: tea.MouseWheelDown}, 3); n != 3 {
+		t.Errorf("expected 3, got %d", n)
+	}
+	if n := Wheel(tea.MouseMsg{Type: tea.MouseLeft}, 3); n != 0 {
+		t.Errorf("expected 0, got %d", n)
+	}
+}
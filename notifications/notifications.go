@@ -0,0 +1,534 @@
+// Package notifications provides a notification center: a panel that slides
+// in from the right edge of the screen and lists past notifications, grouped
+// by their source, with markers on the ones that haven't been read.
+//
+// Push notifications as they come in, and toggle the panel with a key of your
+// choosing. The panel is as tall as its content, so place it yourself:
+//
+//	case tea.KeyMsg:
+//	    if key.Matches(msg, m.keys.Notifications) {
+//	        return m, m.notifications.Toggle()
+//	    }
+//
+//	func (m model) View() string {
+//	    return lipgloss.JoinHorizontal(lipgloss.Top, m.main.View(), m.notifications.View())
+//	}
+package notifications
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/accessibility"
+	"github.com/charmbracelet/bubbles/event"
+	"github.com/charmbracelet/bubbles/i18n"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/route"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/truncate"
+)
+
+const (
+	defaultWidth = 40
+	slideFrames  = 6
+	fps          = 60
+)
+
+// Notification is a notification in the notification center.
+type Notification struct {
+	// Source is what the notification is about, such as the task that sent
+	// it. Notifications are grouped by their source.
+	Source string
+	Title  string
+	Body   string
+
+	// Time is when the notification was sent. Push sets it to the current
+	// time if it's zero.
+	Time time.Time
+
+	Read bool
+}
+
+// FrameMsg indicates that a step of the slide animation should occur.
+type FrameMsg struct {
+	id  int
+	tag int
+}
+
+// KeyMap defines the keybindings of the notification panel, for moving between
+// notifications, marking them as read, dismissing them and closing the panel.
+// It satisfies the help.KeyMap interface, so the bindings can be listed with
+// the help bubble.
+type KeyMap struct {
+	Up          key.Binding
+	Down        key.Binding
+	MarkRead    key.Binding
+	MarkAllRead key.Binding
+	Dismiss     key.Binding
+	DismissAll  key.Binding
+	Close       key.Binding
+}
+
+// DefaultKeyMap returns a default set of keybindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "down"),
+		),
+		MarkRead: key.NewBinding(
+			key.WithKeys("enter", " "),
+			key.WithHelp("enter", "mark read"),
+		),
+		MarkAllRead: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "mark all read"),
+		),
+		Dismiss: key.NewBinding(
+			key.WithKeys("d", "x"),
+			key.WithHelp("d", "dismiss"),
+		),
+		DismissAll: key.NewBinding(
+			key.WithKeys("D"),
+			key.WithHelp("D", "dismiss all"),
+		),
+		Close: key.NewBinding(
+			key.WithKeys("esc", "q"),
+			key.WithHelp("esc", "close"),
+		),
+	}
+}
+
+// ShortHelp implements the KeyMap interface.
+func (km KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{km.MarkRead, km.Dismiss, km.DismissAll, km.Close}
+}
+
+// FullHelp implements the KeyMap interface.
+func (km KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{km.Up, km.Down},
+		{km.MarkRead, km.MarkAllRead},
+		{km.Dismiss, km.DismissAll, km.Close},
+	}
+}
+
+// Styles contains the styles used to render the notification center.
+type Styles struct {
+	Panel        lipgloss.Style
+	Title        lipgloss.Style
+	Source       lipgloss.Style
+	Item         lipgloss.Style
+	SelectedItem lipgloss.Style
+	Body         lipgloss.Style
+	Time         lipgloss.Style
+	Empty        lipgloss.Style
+
+	// Unread is the marker in front of notifications that haven't been read.
+	Unread lipgloss.Style
+}
+
+// DefaultStyles returns a set of default style definitions for the
+// notification center.
+func DefaultStyles() Styles {
+	return Styles{
+		Panel: lipgloss.NewStyle().
+			Border(lipgloss.NormalBorder(), false, false, false, true).
+			BorderForeground(lipgloss.Color("240")).
+			Padding(0, 1),
+		Title:        lipgloss.NewStyle().Bold(true).MarginBottom(1),
+		Source:       lipgloss.NewStyle().Foreground(lipgloss.Color("240")).Bold(true),
+		Item:         lipgloss.NewStyle(),
+		SelectedItem: lipgloss.NewStyle().Foreground(lipgloss.Color("212")),
+		Body:         lipgloss.NewStyle().Foreground(lipgloss.Color("245")),
+		Time:         lipgloss.NewStyle().Foreground(lipgloss.Color("240")),
+		Empty:        lipgloss.NewStyle().Foreground(lipgloss.Color("240")),
+		Unread:       lipgloss.NewStyle().Foreground(lipgloss.Color("212")).SetString("●"),
+	}
+}
+
+// Model is the notification center.
+type Model struct {
+	KeyMap KeyMap
+	Styles Styles
+
+	// Width is the width of the open panel, and Height the most lines it
+	// takes up. The panel is as tall as its content if Height is 0.
+	Width  int
+	Height int
+
+	// Title is shown at the top of the panel.
+	Title string
+
+	// TimeFormat is the layout the time of notifications is shown in.
+	TimeFormat string
+
+	id            int
+	notifications []Notification
+	cursor        int
+	open          bool
+
+	// The number of columns of the panel currently shown while sliding, and
+	// an identifier to keep us from receiving frame messages too quickly.
+	shown int
+	tag   int
+}
+
+// Option is used to set options in New.
+type Option func(*Model)
+
+// New creates a new notification center.
+func New(opts ...Option) Model {
+	m := Model{
+		KeyMap:     DefaultKeyMap(),
+		Styles:     DefaultStyles(),
+		Width:      defaultWidth,
+		Title:      i18n.Text("Notifications"),
+		TimeFormat: "15:04",
+		id:         route.NextID(),
+	}
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
+}
+
+// WithSize sets the width and the height of the panel.
+func WithSize(width, height int) Option {
+	return func(m *Model) {
+		m.Width = width
+		m.Height = height
+	}
+}
+
+// WithStyles sets the styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) {
+		m.Styles = s
+	}
+}
+
+// WithKeyMap sets the key map.
+func WithKeyMap(km KeyMap) Option {
+	return func(m *Model) {
+		m.KeyMap = km
+	}
+}
+
+// ID returns the notification center's unique ID.
+func (m Model) ID() int {
+	return m.id
+}
+
+// Push adds a notification.
+func (m *Model) Push(n Notification) {
+	if n.Time.IsZero() {
+		n.Time = time.Now()
+	}
+	m.notifications = append(m.notifications, n)
+}
+
+// Notifications returns the notifications, in the order they were pushed.
+func (m Model) Notifications() []Notification {
+	return m.notifications
+}
+
+// Unread returns the number of notifications that haven't been read.
+func (m Model) Unread() int {
+	var n int
+	for _, notification := range m.notifications {
+		if !notification.Read {
+			n++
+		}
+	}
+	return n
+}
+
+// Selected returns the index of the selected notification in Notifications,
+// or -1 if there are none.
+func (m Model) Selected() int {
+	order := m.order()
+	if len(order) == 0 {
+		return -1
+	}
+	return order[m.cursor]
+}
+
+// MarkAllRead marks all notifications read.
+func (m *Model) MarkAllRead() {
+	for i := range m.notifications {
+		m.notifications[i].Read = true
+	}
+}
+
+// DismissAll removes all notifications.
+func (m *Model) DismissAll() {
+	m.notifications = nil
+	m.cursor = 0
+}
+
+// Open slides the panel in. It returns the command that animates it.
+func (m *Model) Open() tea.Cmd {
+	m.open = true
+	return m.slide()
+}
+
+// Close slides the panel out. It returns the command that animates it.
+func (m *Model) Close() tea.Cmd {
+	m.open = false
+	return m.slide()
+}
+
+// Toggle opens the panel if it's closed and closes it if it's open.
+func (m *Model) Toggle() tea.Cmd {
+	if m.open {
+		return m.Close()
+	}
+	return m.Open()
+}
+
+// IsOpen returns whether the panel is open, or opening. Key presses are only
+// handled while it's open.
+func (m Model) IsOpen() bool {
+	return m.open
+}
+
+// Visible returns whether any of the panel is shown, including while it
+// slides out.
+func (m Model) Visible() bool {
+	return m.shown > 0
+}
+
+// Update handles the slide animation, and key presses while the panel is
+// open.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if msg, ok := msg.(FrameMsg); ok {
+		if msg.id != m.id || msg.tag != m.tag {
+			return m, nil
+		}
+		return m, m.step()
+	}
+
+	if !m.open {
+		return m, nil
+	}
+
+	msg, ok := route.Accept(m.id, msg)
+	if !ok {
+		return m, nil
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	order := m.order()
+	switch {
+	case key.Matches(keyMsg, m.KeyMap.Up):
+		m.cursor = max(0, m.cursor-1)
+	case key.Matches(keyMsg, m.KeyMap.Down):
+		m.cursor = max(0, min(len(order)-1, m.cursor+1))
+	case key.Matches(keyMsg, m.KeyMap.MarkRead):
+		if len(order) == 0 {
+			break
+		}
+		index := order[m.cursor]
+		m.notifications[index].Read = true
+		id := m.id
+		return m, func() tea.Msg {
+			return event.ActivatedMsg{ID: id, Index: index}
+		}
+	case key.Matches(keyMsg, m.KeyMap.MarkAllRead):
+		m.MarkAllRead()
+	case key.Matches(keyMsg, m.KeyMap.Dismiss):
+		if len(order) == 0 {
+			break
+		}
+		index := order[m.cursor]
+		m.notifications = append(m.notifications[:index], m.notifications[index+1:]...)
+		m.cursor = max(0, min(len(order)-2, m.cursor))
+	case key.Matches(keyMsg, m.KeyMap.DismissAll):
+		m.DismissAll()
+	case key.Matches(keyMsg, m.KeyMap.Close):
+		return m, m.Close()
+	}
+	return m, nil
+}
+
+// slide starts animating the panel towards its open or closed width.
+func (m *Model) slide() tea.Cmd {
+	m.tag++
+	if accessibility.ReducedMotion() {
+		m.shown = 0
+		if m.open {
+			m.shown = m.Width
+		}
+		return nil
+	}
+	return m.nextFrame()
+}
+
+// step moves the panel one frame towards its open or closed width.
+func (m *Model) step() tea.Cmd {
+	target, delta := 0, -m.Width/slideFrames
+	if m.open {
+		target, delta = m.Width, m.Width/slideFrames
+	}
+	if delta == 0 {
+		delta = target - m.shown
+	}
+
+	m.shown += delta
+	if m.open && m.shown >= target || !m.open && m.shown <= target {
+		m.shown = target
+		return nil
+	}
+	return m.nextFrame()
+}
+
+func (m Model) nextFrame() tea.Cmd {
+	id, tag := m.id, m.tag
+	return tea.Tick(time.Second/fps, func(time.Time) tea.Msg {
+		return FrameMsg{id: id, tag: tag}
+	})
+}
+
+// order returns the indices of the notifications in the order they're shown:
+// grouped by source, the group with the most recent notification first, and
+// the most recent first within a group.
+func (m Model) order() []int {
+	latest := make(map[string]time.Time)
+	for _, n := range m.notifications {
+		if n.Time.After(latest[n.Source]) {
+			latest[n.Source] = n.Time
+		}
+	}
+
+	order := make([]int, len(m.notifications))
+	for i := range order {
+		order[i] = len(order) - 1 - i
+	}
+	sort.SliceStable(order, func(i, j int) bool {
+		a, b := m.notifications[order[i]], m.notifications[order[j]]
+		if a.Source != b.Source {
+			la, lb := latest[a.Source], latest[b.Source]
+			if !la.Equal(lb) {
+				return la.After(lb)
+			}
+			return a.Source < b.Source
+		}
+		return a.Time.After(b.Time)
+	})
+	return order
+}
+
+// View renders the panel, as much of it as is shown while it slides.
+func (m Model) View() string {
+	if m.shown == 0 {
+		return ""
+	}
+
+	panel := m.Styles.Panel.Copy().Width(m.Width - m.Styles.Panel.GetHorizontalBorderSize())
+	view := panel.Render(m.contentView(m.Width - m.Styles.Panel.GetHorizontalFrameSize()))
+	if m.shown >= m.Width {
+		return view
+	}
+
+	lines := strings.Split(view, "\n")
+	for i, line := range lines {
+		lines[i] = truncate.String(line, uint(m.shown))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// contentView renders the title and the notifications in the given width.
+func (m Model) contentView(width int) string {
+	title := m.Title
+	if unread := m.Unread(); unread > 0 {
+		title += " (" + strconv.Itoa(unread) + ")"
+	}
+	header := m.Styles.Title.Render(title)
+
+	order := m.order()
+	if len(order) == 0 {
+		return header + "\n" + m.Styles.Empty.Render(i18n.Text("No notifications"))
+	}
+
+	var (
+		lines     []string
+		cursorRow int
+		source    string
+	)
+	for i, index := range order {
+		n := m.notifications[index]
+		if i == 0 || n.Source != source {
+			source = n.Source
+			if i > 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, m.Styles.Source.Render(truncate.StringWithTail(source, uint(width), "…")))
+		}
+		if i == m.cursor {
+			cursorRow = len(lines)
+		}
+		lines = append(lines, m.itemView(n, i == m.cursor, width)...)
+	}
+
+	// Scroll to keep the selected notification in view.
+	if height := m.Height - lipgloss.Height(header) - m.Styles.Panel.GetVerticalFrameSize(); m.Height > 0 && len(lines) > height {
+		height = max(1, height)
+		offset := max(0, min(cursorRow-height/2, len(lines)-height))
+		lines = lines[offset : offset+height]
+	}
+	return header + "\n" + strings.Join(lines, "\n")
+}
+
+// itemView renders a notification: its title, with the unread marker and
+// the time, and its body below.
+func (m Model) itemView(n Notification, selected bool, width int) []string {
+	marker := strings.Repeat(" ", lipgloss.Width(m.Styles.Unread.String()))
+	if !n.Read {
+		marker = m.Styles.Unread.String()
+	}
+	when := m.Styles.Time.Render(n.Time.Format(m.TimeFormat))
+
+	style := m.Styles.Item
+	if selected {
+		style = m.Styles.SelectedItem
+	}
+	titleWidth := max(0, width-lipgloss.Width(marker)-lipgloss.Width(when)-2)
+	title := truncate.StringWithTail(n.Title, uint(titleWidth), "…")
+	title += strings.Repeat(" ", titleWidth-lipgloss.Width(title))
+
+	lines := []string{marker + " " + style.Render(title) + " " + when}
+	if n.Body != "" {
+		indent := strings.Repeat(" ", lipgloss.Width(marker)+1)
+		body := truncate.StringWithTail(n.Body, uint(max(0, width-len(indent))), "…")
+		lines = append(lines, indent+m.Styles.Body.Render(body))
+	}
+	return lines
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,96 @@
+package notifications
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/event"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// open opens the panel and runs its slide animation to the end.
+func open(m Model) Model {
+	cmd := m.Open()
+	for cmd != nil {
+		m, cmd = m.Update(FrameMsg{id: m.id, tag: m.tag})
+	}
+	return m
+}
+
+func TestGrouping(t *testing.T) {
+	start := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	m := New()
+	m.Push(Notification{Source: "build", Title: "Build started", Time: start})
+	m.Push(Notification{Source: "deploy", Title: "Deployed", Time: start.Add(time.Minute)})
+	m.Push(Notification{Source: "build", Title: "Build passed", Time: start.Add(2 * time.Minute)})
+
+	// The build group has the most recent notification, so it comes first,
+	// most recent first.
+	exp := []int{2, 0, 1}
+	got := m.order()
+	for i := range exp {
+		if got[i] != exp[i] {
+			t.Fatalf("expected order %v, got %v", exp, got)
+		}
+	}
+	if m.Unread() != 3 {
+		t.Errorf("expected 3 unread notifications, got %d", m.Unread())
+	}
+}
+
+func TestSlide(t *testing.T) {
+	m := New(WithSize(30, 0))
+	m.Push(Notification{Source: "build", Title: "Build passed"})
+	if m.View() != "" {
+		t.Fatal("expected the closed panel not to render")
+	}
+
+	cmd := m.Open()
+	m, _ = m.Update(FrameMsg{id: m.id, tag: m.tag})
+	if !m.Visible() || m.shown >= m.Width {
+		t.Fatalf("expected the panel to be sliding in, shown %d", m.shown)
+	}
+	for cmd != nil {
+		m, cmd = m.Update(FrameMsg{id: m.id, tag: m.tag})
+	}
+	if m.shown != m.Width {
+		t.Fatalf("expected the panel to be fully shown, shown %d", m.shown)
+	}
+	if view := m.View(); !strings.Contains(view, "Build passed") || !strings.Contains(view, "Notifications (1)") {
+		t.Errorf("expected the panel to list the notification, got %q", view)
+	}
+
+	// Frames of an earlier animation are ignored.
+	if _, cmd := m.Update(FrameMsg{id: m.id, tag: m.tag - 1}); cmd != nil {
+		t.Error("expected a stale frame to be ignored")
+	}
+}
+
+func TestKeys(t *testing.T) {
+	m := New()
+	m.Push(Notification{Source: "a", Title: "one"})
+	m.Push(Notification{Source: "a", Title: "two"})
+	m = open(m)
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if msg, ok := cmd().(event.ActivatedMsg); !ok || msg.Index != 1 || msg.ID != m.ID() {
+		t.Fatalf("expected the most recent notification to be activated, got %#v", msg)
+	}
+	if !m.Notifications()[1].Read || m.Unread() != 1 {
+		t.Fatal("expected the notification to be marked read")
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'d'}})
+	if n := m.Notifications(); len(n) != 1 || n[0].Title != "one" {
+		t.Fatalf("expected the notification to be dismissed, got %v", n)
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'D'}})
+	if len(m.Notifications()) != 0 {
+		t.Fatal("expected all notifications to be dismissed")
+	}
+	if !strings.Contains(m.View(), "No notifications") {
+		t.Errorf("expected the empty panel, got %q", m.View())
+	}
+}
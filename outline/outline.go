@@ -0,0 +1,462 @@
+// Package outline provides a table of contents sidebar: a collapsible tree of
+// the headings of a document, such as the headings of markdown or the symbols
+// of source code, that follows the document as it scrolls.
+//
+// Sync the outline with the viewport showing the document after updating it,
+// and scroll the viewport to the line of a heading when the user jumps to
+// it:
+//
+//	m.viewport, cmd = m.viewport.Update(msg)
+//	m.outline.Sync(m.viewport.YOffset)
+//
+//	case outline.JumpMsg:
+//	    m.viewport.SetYOffset(msg.Line)
+package outline
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/route"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/truncate"
+)
+
+// Heading is a heading of the document.
+type Heading struct {
+	Title string
+
+	// Level is the level of the heading, 1 for the top level. Headings with
+	// a higher level that follow a heading are nested below it.
+	Level int
+
+	// Line is the line of the document the heading is on, counting from 0.
+	Line int
+}
+
+// FromMarkdown returns the ATX headings of markdown, those starting with #,
+// skipping fenced code blocks.
+func FromMarkdown(s string) []Heading {
+	var (
+		headings []Heading
+		fence    string
+	)
+	for i, line := range strings.Split(s, "\n") {
+		trimmed := strings.TrimSpace(line)
+		if fence != "" {
+			if strings.HasPrefix(trimmed, fence) {
+				fence = ""
+			}
+			continue
+		}
+		if strings.HasPrefix(trimmed, "```") || strings.HasPrefix(trimmed, "~~~") {
+			fence = trimmed[:3]
+			continue
+		}
+
+		level := len(trimmed) - len(strings.TrimLeft(trimmed, "#"))
+		if level == 0 || level > 6 || len(trimmed) > level && trimmed[level] != ' ' {
+			continue
+		}
+		title := strings.TrimSpace(strings.TrimRight(trimmed[level:], "#"))
+		headings = append(headings, Heading{Title: title, Level: level, Line: i})
+	}
+	return headings
+}
+
+// JumpMsg is sent when the user jumps to a heading.
+type JumpMsg struct {
+	// ID is the ID of the outline that sent the message.
+	ID int
+
+	// Index is the index of the heading, and Line its line.
+	Index int
+	Line  int
+}
+
+// KeyMap defines the keybindings for moving through the outline, collapsing
+// and expanding headings and jumping to the highlighted heading. It satisfies
+// the help.KeyMap interface, so the bindings can be listed with the help
+// bubble.
+type KeyMap struct {
+	Up       key.Binding
+	Down     key.Binding
+	Collapse key.Binding
+	Expand   key.Binding
+	Toggle   key.Binding
+	Jump     key.Binding
+}
+
+// DefaultKeyMap returns a default set of keybindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "down"),
+		),
+		Collapse: key.NewBinding(
+			key.WithKeys("left", "h"),
+			key.WithHelp("←/h", "collapse"),
+		),
+		Expand: key.NewBinding(
+			key.WithKeys("right", "l"),
+			key.WithHelp("→/l", "expand"),
+		),
+		Toggle: key.NewBinding(
+			key.WithKeys(" ", "tab"),
+			key.WithHelp("space", "toggle"),
+		),
+		Jump: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "jump"),
+		),
+	}
+}
+
+// ShortHelp implements the KeyMap interface.
+func (km KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{km.Up, km.Down, km.Toggle, km.Jump}
+}
+
+// FullHelp implements the KeyMap interface.
+func (km KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{{km.Up, km.Down}, {km.Collapse, km.Expand, km.Toggle}, {km.Jump}}
+}
+
+// Styles contains the styles used to render the outline.
+type Styles struct {
+	Heading lipgloss.Style
+
+	// Current is the heading of the part of the document in view, and
+	// Selected the one the cursor is on while the outline is focused.
+	Current  lipgloss.Style
+	Selected lipgloss.Style
+
+	// Collapsed and Expanded mark headings with headings nested below them.
+	Collapsed lipgloss.Style
+	Expanded  lipgloss.Style
+}
+
+// DefaultStyles returns a set of default style definitions for the outline.
+func DefaultStyles() Styles {
+	return Styles{
+		Heading:   lipgloss.NewStyle().Foreground(lipgloss.Color("250")),
+		Current:   lipgloss.NewStyle().Foreground(lipgloss.Color("212")).Bold(true),
+		Selected:  lipgloss.NewStyle().Foreground(lipgloss.Color("230")).Background(lipgloss.Color("237")),
+		Collapsed: lipgloss.NewStyle().Foreground(lipgloss.Color("240")).SetString("▸"),
+		Expanded:  lipgloss.NewStyle().Foreground(lipgloss.Color("240")).SetString("▾"),
+	}
+}
+
+// Model is the outline.
+type Model struct {
+	KeyMap KeyMap
+	Styles Styles
+
+	// Width is the width of the outline, and Height the most lines it takes
+	// up. Either is unlimited if it's 0.
+	Width  int
+	Height int
+
+	// Indent is the number of columns nested headings are indented by.
+	Indent int
+
+	id        int
+	headings  []Heading
+	collapsed []bool
+	line      int
+	current   int
+	cursor    int
+	focus     bool
+}
+
+// Option is used to set options in New.
+type Option func(*Model)
+
+// New creates a new outline of the given headings, which must be in the order
+// they appear in the document.
+func New(headings []Heading, opts ...Option) Model {
+	m := Model{
+		KeyMap: DefaultKeyMap(),
+		Styles: DefaultStyles(),
+		Indent: 2,
+		id:     route.NextID(),
+	}
+	m.SetHeadings(headings)
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
+}
+
+// WithSize sets the width and the height of the outline.
+func WithSize(width, height int) Option {
+	return func(m *Model) {
+		m.Width = width
+		m.Height = height
+	}
+}
+
+// WithStyles sets the styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) {
+		m.Styles = s
+	}
+}
+
+// WithKeyMap sets the key map.
+func WithKeyMap(km KeyMap) Option {
+	return func(m *Model) {
+		m.KeyMap = km
+	}
+}
+
+// ID returns the outline's unique ID.
+func (m Model) ID() int {
+	return m.id
+}
+
+// SetHeadings replaces the headings, expanding all of them.
+func (m *Model) SetHeadings(headings []Heading) {
+	m.headings = headings
+	m.collapsed = make([]bool, len(headings))
+	m.line, m.current, m.cursor = 0, 0, 0
+}
+
+// Headings returns the headings.
+func (m Model) Headings() []Heading {
+	return m.headings
+}
+
+// Current returns the index of the heading of the part of the document in
+// view, or -1 if the view is above the first heading.
+func (m Model) Current() int {
+	if len(m.headings) == 0 || m.headings[0].Line > m.line {
+		return -1
+	}
+	return m.current
+}
+
+// Sync makes the heading of the document at the given line, usually the
+// offset of the viewport showing it, the current heading. While the outline
+// isn't focused, the cursor follows it.
+func (m *Model) Sync(line int) {
+	m.line = line
+	m.current = 0
+	for i, h := range m.headings {
+		if h.Line > line {
+			break
+		}
+		m.current = i
+	}
+	if !m.focus {
+		m.cursor = m.visibleAncestor(m.current)
+	}
+}
+
+// Focus focuses the outline, so that it handles key presses.
+func (m *Model) Focus() {
+	m.focus = true
+}
+
+// Blur blurs the outline. The cursor follows the current heading again.
+func (m *Model) Blur() {
+	m.focus = false
+	m.cursor = m.visibleAncestor(m.current)
+}
+
+// Focused returns whether the outline is focused.
+func (m Model) Focused() bool {
+	return m.focus
+}
+
+// Collapse hides the headings nested below a heading.
+func (m *Model) Collapse(i int) {
+	if m.hasChildren(i) {
+		m.collapsed[i] = true
+		m.cursor = m.visibleAncestor(m.cursor)
+	}
+}
+
+// Expand shows the headings nested below a heading.
+func (m *Model) Expand(i int) {
+	if i >= 0 && i < len(m.collapsed) {
+		m.collapsed[i] = false
+	}
+}
+
+// Update handles key presses while the outline is focused.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.focus || len(m.headings) == 0 {
+		return m, nil
+	}
+
+	msg, ok := route.Accept(m.id, msg)
+	if !ok {
+		return m, nil
+	}
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	visible := m.visible()
+	pos := indexOf(visible, m.cursor)
+	switch {
+	case key.Matches(keyMsg, m.KeyMap.Up):
+		m.cursor = visible[max(0, pos-1)]
+	case key.Matches(keyMsg, m.KeyMap.Down):
+		m.cursor = visible[min(len(visible)-1, pos+1)]
+	case key.Matches(keyMsg, m.KeyMap.Collapse):
+		if m.hasChildren(m.cursor) && !m.collapsed[m.cursor] {
+			m.Collapse(m.cursor)
+		} else if parent := m.parent(m.cursor); parent >= 0 {
+			m.cursor = parent
+		}
+	case key.Matches(keyMsg, m.KeyMap.Expand):
+		m.Expand(m.cursor)
+	case key.Matches(keyMsg, m.KeyMap.Toggle):
+		if m.collapsed[m.cursor] {
+			m.Expand(m.cursor)
+		} else {
+			m.Collapse(m.cursor)
+		}
+	case key.Matches(keyMsg, m.KeyMap.Jump):
+		id, index, line := m.id, m.cursor, m.headings[m.cursor].Line
+		return m, func() tea.Msg {
+			return JumpMsg{ID: id, Index: index, Line: line}
+		}
+	}
+	return m, nil
+}
+
+// hasChildren returns whether headings are nested below the heading.
+func (m Model) hasChildren(i int) bool {
+	return i >= 0 && i+1 < len(m.headings) && m.headings[i+1].Level > m.headings[i].Level
+}
+
+// parent returns the index of the heading the heading is nested below, or -1
+// if it's at the top level.
+func (m Model) parent(i int) int {
+	for j := i - 1; j >= 0; j-- {
+		if m.headings[j].Level < m.headings[i].Level {
+			return j
+		}
+	}
+	return -1
+}
+
+// visibleAncestor returns the heading itself if it's visible, and the
+// outermost collapsed heading it's nested below otherwise.
+func (m Model) visibleAncestor(i int) int {
+	if i >= len(m.headings) {
+		return 0
+	}
+	visible := i
+	for j := m.parent(i); j >= 0; j = m.parent(j) {
+		if m.collapsed[j] {
+			visible = j
+		}
+	}
+	return visible
+}
+
+// visible returns the indices of the headings that aren't nested below a
+// collapsed heading.
+func (m Model) visible() []int {
+	var (
+		visible []int
+		hideTo  = -1 // hide headings nested deeper than this level
+	)
+	for i, h := range m.headings {
+		if hideTo >= 0 && h.Level > hideTo {
+			continue
+		}
+		hideTo = -1
+		visible = append(visible, i)
+		if m.collapsed[i] {
+			hideTo = h.Level
+		}
+	}
+	return visible
+}
+
+// View renders the outline.
+func (m Model) View() string {
+	visible := m.visible()
+	if len(visible) == 0 {
+		return ""
+	}
+
+	minLevel := m.headings[0].Level
+	for _, h := range m.headings {
+		minLevel = min(minLevel, h.Level)
+	}
+	current := m.visibleAncestor(m.current)
+
+	// Scroll to keep the cursor in view.
+	start, end := 0, len(visible)
+	if m.Height > 0 && len(visible) > m.Height {
+		start = max(0, min(indexOf(visible, m.cursor)-m.Height/2, len(visible)-m.Height))
+		end = start + m.Height
+	}
+
+	lines := make([]string, 0, end-start)
+	for _, i := range visible[start:end] {
+		h := m.headings[i]
+
+		marker := " "
+		if m.hasChildren(i) {
+			marker = m.Styles.Expanded.String()
+			if m.collapsed[i] {
+				marker = m.Styles.Collapsed.String()
+			}
+		}
+		prefix := strings.Repeat(" ", (h.Level-minLevel)*m.Indent) + marker + " "
+
+		title := h.Title
+		if m.Width > 0 {
+			title = truncate.StringWithTail(title, uint(max(0, m.Width-lipgloss.Width(prefix))), "…")
+		}
+
+		style := m.Styles.Heading
+		switch {
+		case m.focus && i == m.cursor:
+			style = m.Styles.Selected
+		case i == current:
+			style = m.Styles.Current
+		}
+		lines = append(lines, prefix+style.Render(title))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func indexOf(indices []int, i int) int {
+	for pos, j := range indices {
+		if j == i {
+			return pos
+		}
+	}
+	return 0
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
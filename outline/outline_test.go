@@ -0,0 +1,99 @@
+package outline
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const doc = `# Title
+intro
+
+## Install
+` + "```sh\n# not a heading\n```" + `
+## Usage
+### Flags
+### Config ##
+# Other`
+
+func plainStyles() Styles {
+	return Styles{
+		Collapsed: lipgloss.NewStyle().SetString("+"),
+		Expanded:  lipgloss.NewStyle().SetString("-"),
+	}
+}
+
+func TestFromMarkdown(t *testing.T) {
+	exp := []Heading{
+		{"Title", 1, 0},
+		{"Install", 2, 3},
+		{"Usage", 2, 7},
+		{"Flags", 3, 8},
+		{"Config", 3, 9},
+		{"Other", 1, 10},
+	}
+	got := FromMarkdown(doc)
+	if len(got) != len(exp) {
+		t.Fatalf("expected %d headings, got %v", len(exp), got)
+	}
+	for i := range exp {
+		if got[i] != exp[i] {
+			t.Errorf("expected heading %d to be %v, got %v", i, exp[i], got[i])
+		}
+	}
+}
+
+func TestSync(t *testing.T) {
+	m := New(FromMarkdown(doc), WithStyles(plainStyles()))
+
+	m.Sync(8)
+	if m.Current() != 3 || m.cursor != 3 {
+		t.Fatalf("expected Flags to be current, got %d", m.Current())
+	}
+
+	// The cursor moves to the collapsed heading of the current one.
+	m.Collapse(2)
+	m.Sync(9)
+	if m.Current() != 4 || m.cursor != 2 {
+		t.Fatalf("expected the cursor on Usage, got %d", m.cursor)
+	}
+
+	exp := "- Title\n    Install\n  + Usage\n  Other"
+	if got := m.View(); got != exp {
+		t.Errorf("expected view:\n%s\ngot:\n%s", exp, got)
+	}
+}
+
+func TestKeys(t *testing.T) {
+	m := New(FromMarkdown(doc), WithStyles(plainStyles()))
+	m.Focus()
+
+	press := func(k tea.KeyType) tea.Cmd {
+		var cmd tea.Cmd
+		m, cmd = m.Update(tea.KeyMsg{Type: k})
+		return cmd
+	}
+
+	press(tea.KeyDown)
+	press(tea.KeyDown)
+	press(tea.KeyLeft) // collapse Usage
+	press(tea.KeyDown)
+	if m.cursor != 5 {
+		t.Fatalf("expected the cursor to skip the collapsed headings, got %d", m.cursor)
+	}
+
+	press(tea.KeyLeft) // Other has no parent
+	press(tea.KeyUp)
+	press(tea.KeyRight)
+	press(tea.KeyDown)
+	press(tea.KeyLeft) // move to the parent of Flags
+	if m.cursor != 2 {
+		t.Fatalf("expected the cursor on Usage, got %d", m.cursor)
+	}
+
+	msg, ok := press(tea.KeyEnter)().(JumpMsg)
+	if !ok || msg.Line != 7 || msg.Index != 2 || msg.ID != m.ID() {
+		t.Fatalf("expected a jump to Usage, got %#v", msg)
+	}
+}
@@ -0,0 +1,126 @@
+package paginator
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/mouse"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// PageChangedMsg is sent when the page was changed with a key or by clicking
+// a dot.
+type PageChangedMsg struct {
+	Page int
+}
+
+// DotStyles defines the styles of the dots of the Dots type.
+type DotStyles struct {
+	Active   lipgloss.Style
+	Inactive lipgloss.Style
+
+	// First and Last style the first and the last dot unless they're
+	// active, on top of Inactive.
+	First lipgloss.Style
+	Last  lipgloss.Style
+
+	// The numeric labels below the dots, if shown.
+	Label       lipgloss.Style
+	ActiveLabel lipgloss.Style
+}
+
+// dotStyle returns the style of a dot.
+func (m Model) dotStyle(page int) lipgloss.Style {
+	s := m.DotStyles
+	switch {
+	case page == m.Page:
+		return s.Active
+	case page == 0:
+		return s.First.Copy().Inherit(s.Inactive)
+	case page == m.TotalPages-1:
+		return s.Last.Copy().Inherit(s.Inactive)
+	}
+	return s.Inactive
+}
+
+// dotWidth returns the width of the cell of every dot: wide enough for the
+// widest label if labels are shown.
+func (m Model) dotWidth() int {
+	w := max(lipgloss.Width(m.ActiveDot), lipgloss.Width(m.InactiveDot))
+	if m.ShowDotLabels {
+		w = max(w, len(strconv.Itoa(m.TotalPages)))
+	}
+	return w
+}
+
+// dotGap returns the width of the space between dots.
+func (m Model) dotGap() int {
+	if m.ShowDotLabels {
+		return 1
+	}
+	return 0
+}
+
+func (m Model) dotsView() string {
+	var (
+		dots   = make([]string, m.TotalPages)
+		labels = make([]string, m.TotalPages)
+		cell   = lipgloss.NewStyle().Width(m.dotWidth()).Align(lipgloss.Center)
+	)
+	for i := range dots {
+		dot := m.InactiveDot
+		if i == m.Page {
+			dot = m.ActiveDot
+		}
+		dots[i] = m.dotStyle(i).Render(dot)
+	}
+	if !m.ShowDotLabels {
+		return strings.Join(dots, "")
+	}
+
+	for i, dot := range dots {
+		labelStyle := m.DotStyles.Label
+		if i == m.Page {
+			labelStyle = m.DotStyles.ActiveLabel
+		}
+		dots[i] = cell.Render(dot)
+		labels[i] = labelStyle.Render(cell.Render(strconv.Itoa(i + 1)))
+	}
+	gap := strings.Repeat(" ", m.dotGap())
+	return strings.Join(dots, gap) + "\n" + strings.Join(labels, gap)
+}
+
+// regions returns the regions of the dots, with the pages as IDs. The labels
+// below the dots, if shown, are part of their dots' regions.
+func (m Model) regions() mouse.Regions {
+	var (
+		regions mouse.Regions
+		height  = 1
+		step    = m.dotWidth() + m.dotGap()
+	)
+	if m.ShowDotLabels {
+		height = 2
+	}
+	for page := 0; page < m.TotalPages; page++ {
+		regions.Add(page, page*step, 0, m.dotWidth(), height)
+	}
+	return regions
+}
+
+// handleClick goes to the page of a clicked dot.
+func (m *Model) handleClick(msg tea.MouseMsg) {
+	if m.Type != Dots || msg.Type != tea.MouseLeft {
+		return
+	}
+	if ev, ok := m.regions().Hit(msg, m.XPosition, m.YPosition); ok {
+		m.Page = ev.ID
+	}
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,56 @@
+package paginator
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/bubbletest"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func dotsModel() Model {
+	m := New()
+	m.Type = Dots
+	m.TotalPages = 3
+	return m
+}
+
+func TestDotsView(t *testing.T) {
+	m := dotsModel()
+	m.Page = 1
+	if got, want := bubbletest.Plain(m.View()), "○•○"; got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+
+	m.ShowDotLabels = true
+	if got, want := bubbletest.Plain(m.View()), "○ • ○\n1 2 3"; got != want {
+		t.Fatalf("expected\n%s\ngot\n%s", want, got)
+	}
+}
+
+func TestDotsClick(t *testing.T) {
+	m := dotsModel()
+	m.ShowDotLabels = true
+	m.MouseEnabled = true
+	m.XPosition, m.YPosition = 10, 5
+
+	// Labels are part of their dots.
+	m, cmd := m.Update(tea.MouseMsg{X: 14, Y: 6, Type: tea.MouseLeft})
+	if m.Page != 2 {
+		t.Fatalf("expected the clicked page, got %d", m.Page)
+	}
+	if msgs := bubbletest.Collect(cmd); len(msgs) != 1 || msgs[0] != (PageChangedMsg{Page: 2}) {
+		t.Fatalf("expected a page change, got %v", msgs)
+	}
+
+	// Clicks on the gaps between the dots are ignored.
+	if m, cmd = m.Update(tea.MouseMsg{X: 11, Y: 5, Type: tea.MouseLeft}); m.Page != 2 || cmd != nil {
+		t.Fatalf("expected the gap click to be ignored, got page %d", m.Page)
+	}
+}
+
+func TestDotsClickDisabled(t *testing.T) {
+	m := dotsModel()
+	if m, _ = m.Update(tea.MouseMsg{X: 2, Y: 0, Type: tea.MouseLeft}); m.Page != 0 {
+		t.Fatalf("expected clicks to be ignored unless enabled, got page %d", m.Page)
+	}
+}
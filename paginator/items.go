@@ -0,0 +1,38 @@
+package paginator
+
+import "fmt"
+
+// SetTotalItems sets the number of items being paginated and calculates the
+// total number of pages from it, moving to the last page if the current one
+// no longer exists.
+func (m *Model) SetTotalItems(items int) {
+	m.totalItems = max(0, items)
+	m.TotalPages = 1
+	m.SetTotalPages(m.totalItems)
+	m.Page = min(m.Page, m.TotalPages-1)
+}
+
+// TotalItems returns the number of items set with SetTotalItems.
+func (m Model) TotalItems() int {
+	return m.totalItems
+}
+
+// ItemBounds returns the start and end bounds of the items on the current
+// page, of the items set with SetTotalItems. They're always within bounds,
+// so they can be used to slice the items:
+//
+//	start, end := model.ItemBounds()
+//	sliceToRender := bunchOfStuff[start:end]
+func (m Model) ItemBounds() (start int, end int) {
+	return m.GetSliceBounds(m.totalItems)
+}
+
+// RangeView renders the range of the items on the current page, such as
+// "21–40 of 183", with the RangeFormat.
+func (m Model) RangeView() string {
+	start, end := m.ItemBounds()
+	if end > start {
+		start++
+	}
+	return fmt.Sprintf(m.RangeFormat, start, end, m.totalItems)
+}
@@ -0,0 +1,38 @@
+package paginator
+
+import "testing"
+
+func TestTotalItems(t *testing.T) {
+	m := New()
+	m.PerPage = 20
+	m.SetTotalItems(45)
+	if m.TotalPages != 3 || m.TotalItems() != 45 {
+		t.Fatalf("expected 3 pages of 45 items, got %d pages of %d", m.TotalPages, m.TotalItems())
+	}
+
+	m.Page = 2
+	if start, end := m.ItemBounds(); start != 40 || end != 45 {
+		t.Fatalf("expected bounds 40–45, got %d–%d", start, end)
+	}
+	m.Type = Range
+	if got, want := m.View(), "41–45 of 45"; got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+
+	// Fewer items move to the last page that's left.
+	m.SetTotalItems(10)
+	if m.TotalPages != 1 || m.Page != 0 {
+		t.Fatalf("expected to be on the only page, got page %d of %d", m.Page, m.TotalPages)
+	}
+	if got, want := m.View(), "1–10 of 10"; got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+
+	m.SetTotalItems(0)
+	if m.TotalPages != 1 || m.ItemsOnPage(m.TotalItems()) != 0 {
+		t.Fatalf("expected a single empty page, got %d pages", m.TotalPages)
+	}
+	if got, want := m.View(), "0–0 of 0"; got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
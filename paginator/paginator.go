@@ -17,6 +17,10 @@ type Type int
 const (
 	Arabic Type = iota
 	Dots
+
+	// Range renders the range of the items on the current page, see
+	// SetTotalItems.
+	Range
 )
 
 // Model is the Bubble Tea model for this user interface.
@@ -28,11 +32,27 @@ type Model struct {
 	ActiveDot         string
 	InactiveDot       string
 	ArabicFormat      string
+	RangeFormat       string
 	UsePgUpPgDownKeys bool
 	UseLeftRightKeys  bool
 	UseUpDownKeys     bool
 	UseHLKeys         bool
 	UseJKKeys         bool
+
+	// DotStyles styles the dots of the Dots type, and ShowDotLabels numbers
+	// them on a line below.
+	DotStyles     DotStyles
+	ShowDotLabels bool
+
+	// Whether or not to go to the page of a dot when it or its label is
+	// clicked. The mouse must be enabled in Bubble Tea for this to work.
+	// XPosition and YPosition are the position of the paginator in the
+	// terminal window.
+	MouseEnabled bool
+	XPosition    int
+	YPosition    int
+
+	totalItems int
 }
 
 // SetTotalPages is a helper function for calculating the total number of pages
@@ -63,15 +83,17 @@ func (m Model) ItemsOnPage(totalItems int) int {
 
 // GetSliceBounds is a helper function for paginating slices. Pass the length
 // of the slice you're rendering and you'll receive the start and end bounds
-// corresponding the to pagination. For example:
+// corresponding the to pagination, which are always within the slice's
+// bounds. For example:
 //
 //	bunchOfStuff := []stuff{...}
 //	start, end := model.GetSliceBounds(len(bunchOfStuff))
 //	sliceToRender := bunchOfStuff[start:end]
 func (m *Model) GetSliceBounds(length int) (start int, end int) {
-	start = m.Page * m.PerPage
+	length = max(0, length)
+	start = min(max(0, m.Page*m.PerPage), length)
 	end = min(m.Page*m.PerPage+m.PerPage, length)
-	return start, end
+	return start, max(start, end)
 }
 
 // PrevPage is a number function for navigating one page backward. It will not
@@ -105,6 +127,7 @@ func New() Model {
 		ActiveDot:         "•",
 		InactiveDot:       "○",
 		ArabicFormat:      "%d/%d",
+		RangeFormat:       "%d–%d of %d",
 		UsePgUpPgDownKeys: true,
 		UseLeftRightKeys:  true,
 		UseUpDownKeys:     false,
@@ -120,7 +143,13 @@ var NewModel = New
 
 // Update is the Tea update function which binds keystrokes to pagination.
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	page := m.Page
+
 	switch msg := msg.(type) {
+	case tea.MouseMsg:
+		if m.MouseEnabled {
+			m.handleClick(msg)
+		}
 	case tea.KeyMsg:
 		if m.UsePgUpPgDownKeys {
 			switch msg.String() {
@@ -164,6 +193,11 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		}
 	}
 
+	if m.Page != page {
+		return m, func() tea.Msg {
+			return PageChangedMsg{Page: m.Page}
+		}
+	}
 	return m, nil
 }
 
@@ -172,23 +206,13 @@ func (m Model) View() string {
 	switch m.Type {
 	case Dots:
 		return m.dotsView()
+	case Range:
+		return m.RangeView()
 	default:
 		return m.arabicView()
 	}
 }
 
-func (m Model) dotsView() string {
-	var s string
-	for i := 0; i < m.TotalPages; i++ {
-		if i == m.Page {
-			s += m.ActiveDot
-			continue
-		}
-		s += m.InactiveDot
-	}
-	return s
-}
-
 func (m Model) arabicView() string {
 	return fmt.Sprintf(m.ArabicFormat, m.Page+1, m.TotalPages)
 }
@@ -0,0 +1,83 @@
+package progress
+
+import (
+	"math"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// EasingFunc maps how far along an animation is in time, from 0 to 1, to how
+// far along it is in distance, which may overshoot 1.
+type EasingFunc func(t float64) float64
+
+// Linear animates at a constant speed.
+func Linear(t float64) float64 {
+	return t
+}
+
+// EaseOut animates quickly at first and slows down toward the end, without
+// overshooting.
+func EaseOut(t float64) float64 {
+	return 1 - math.Pow(1-t, 3) //nolint:gomnd
+}
+
+// EaseInOut animates slowly at the start and the end.
+func EaseInOut(t float64) float64 {
+	if t < 0.5 { //nolint:gomnd
+		return 4 * t * t * t //nolint:gomnd
+	}
+	return 1 - math.Pow(-2*t+2, 3)/2 //nolint:gomnd
+}
+
+// WithEasing animates the progress bar with an easing function, such as
+// Linear or EaseOut, over the given duration instead of the default spring.
+func WithEasing(easing EasingFunc, duration time.Duration) Option {
+	return func(m *Model) {
+		m.easing = easing
+		m.duration = duration
+		m.noAnimation = false
+	}
+}
+
+// WithoutAnimation shows new percentages right away instead of animating to
+// them. SetPercent then doesn't return a command.
+func WithoutAnimation() Option {
+	return func(m *Model) {
+		m.noAnimation = true
+	}
+}
+
+// WithFrameRate sets the number of frames per second the progress bar is
+// animated at, 60 by default. Lower frame rates take fewer updates.
+func WithFrameRate(fps int) Option {
+	return func(m *Model) {
+		m.fps = fps
+		m.SetSpringOptions(m.springFrequency, m.springDamping)
+	}
+}
+
+// frameRate returns the number of frames per second to animate at.
+func (m Model) frameRate() int {
+	if m.fps <= 0 {
+		return defaultFPS
+	}
+	return m.fps
+}
+
+// ease advances an animation with the easing function by a frame.
+func (m *Model) ease() tea.Cmd {
+	m.frame++
+	frames := m.duration.Seconds() * float64(m.frameRate())
+	t := 1.0
+	if frames > 0 {
+		t = math.Min(1, float64(m.frame)/frames)
+	}
+
+	m.percentShown = m.startPercent + (m.targetPercent-m.startPercent)*m.easing(t)
+	if t >= 1 {
+		m.percentShown = m.targetPercent
+		return nil
+	}
+	return m.nextFrame()
+}
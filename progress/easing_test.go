@@ -0,0 +1,69 @@
+package progress
+
+import (
+	"math"
+	"testing"
+	"time"
+)
+
+func TestEasing(t *testing.T) {
+	for name, easing := range map[string]EasingFunc{"Linear": Linear, "EaseOut": EaseOut, "EaseInOut": EaseInOut} {
+		if got := easing(0); got != 0 {
+			t.Errorf("%s: expected to start at 0, got %f", name, got)
+		}
+		if got := easing(1); got != 1 {
+			t.Errorf("%s: expected to end at 1, got %f", name, got)
+		}
+	}
+	if got := EaseInOut(0.5); got != 0.5 {
+		t.Errorf("expected EaseInOut to be halfway at the middle, got %f", got)
+	}
+	if got := EaseOut(0.5); got <= 0.5 {
+		t.Errorf("expected EaseOut to be past halfway at the middle, got %f", got)
+	}
+}
+
+func TestEasingAnimation(t *testing.T) {
+	m := New(WithEasing(Linear, time.Second), WithFrameRate(10))
+	if m.SetPercent(1) == nil {
+		t.Fatal("expected a command to animate")
+	}
+
+	for i := 0; i < 5; i++ {
+		m = frame(t, m, true)
+	}
+	if math.Abs(m.percentShown-0.5) > 1e-9 {
+		t.Fatalf("expected half of the animation after half of the frames, got %f", m.percentShown)
+	}
+
+	// A new percentage starts the animation over from what's shown.
+	m.SetPercent(0)
+	for i := 0; i < 9; i++ {
+		m = frame(t, m, true)
+	}
+	m = frame(t, m, false)
+	if m.percentShown != 0 {
+		t.Fatalf("expected the animation to end on the target, got %f", m.percentShown)
+	}
+}
+
+func TestWithoutAnimation(t *testing.T) {
+	m := New(WithoutAnimation())
+	if cmd := m.SetPercent(0.3); cmd != nil {
+		t.Fatal("expected no command without animation")
+	}
+	if m.percentShown != 0.3 {
+		t.Fatalf("expected the percentage to be shown right away, got %f", m.percentShown)
+	}
+}
+
+// frame advances the animation of m by a frame and checks whether it asks
+// for another one.
+func frame(t *testing.T, m Model, more bool) Model {
+	t.Helper()
+	next, cmd := m.Update(FrameMsg{id: m.id, tag: m.tag})
+	if (cmd != nil) != more {
+		t.Fatalf("expected another frame: %v, got %v", more, cmd != nil)
+	}
+	return next.(Model)
+}
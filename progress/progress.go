@@ -7,6 +7,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/charmbracelet/bubbles/accessibility"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/harmonica"
 	"github.com/charmbracelet/lipgloss"
@@ -31,7 +32,7 @@ func nextID() int {
 }
 
 const (
-	fps              = 60
+	defaultFPS       = 60
 	defaultWidth     = 40
 	defaultFrequency = 18.0
 	defaultDamping   = 1.0
@@ -104,6 +105,8 @@ func WithSpringOptions(frequency, damping float64) Option {
 	return func(m *Model) {
 		m.SetSpringOptions(frequency, damping)
 		m.springCustomized = true
+		m.easing = nil
+		m.noAnimation = false
 	}
 }
 
@@ -132,6 +135,23 @@ type Model struct {
 	// Total width of the progress bar, including percentage, if set.
 	Width int
 
+	// Vertical renders the progress bar filling up from the bottom, Height
+	// rows tall including the percentage, if set, which is shown below it.
+	// Width is then the width of the bar alone. See WithVertical.
+	Vertical bool
+	Height   int
+
+	// Steps renders the progress bar as this many discrete steps, with the
+	// step count in place of the percentage. See WithSteps.
+	Steps      int
+	StepLabels []string
+	step       int
+
+	// Settings for rendering the current step.
+	CurrentStepColor      string
+	StepLabelStyle        lipgloss.Style
+	CurrentStepLabelStyle lipgloss.Style
+
 	// "Filled" sections of the progress bar.
 	Full      rune
 	FullColor string
@@ -148,9 +168,19 @@ type Model struct {
 	// Members for animated transitions.
 	spring           harmonica.Spring
 	springCustomized bool
+	springFrequency  float64
+	springDamping    float64
 	percentShown     float64 // percent currently displaying
 	targetPercent    float64 // percent to which we're animating
 	velocity         float64
+	fps              int
+
+	// Members for animating with an easing function instead of the spring.
+	easing       EasingFunc
+	duration     time.Duration
+	startPercent float64 // percent shown when the animation started
+	frame        int
+	noAnimation  bool
 
 	// Gradient settings
 	useRamp    bool
@@ -178,6 +208,11 @@ func New(opts ...Option) Model {
 		ShowPercentage: true,
 		PercentFormat:  " %3.0f%%",
 		colorProfile:   termenv.ColorProfile(),
+		fps:            defaultFPS,
+
+		CurrentStepColor:      "#EE6FF8",
+		StepLabelStyle:        lipgloss.NewStyle().Foreground(lipgloss.Color("#606060")),
+		CurrentStepLabelStyle: lipgloss.NewStyle().Bold(true),
 	}
 	if !m.springCustomized {
 		m.SetSpringOptions(defaultFrequency, defaultDamping)
@@ -210,6 +245,10 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			return m, nil
 		}
 
+		if m.easing != nil {
+			return m, m.ease()
+		}
+
 		// If we've more or less reached equilibrium, stop updating.
 		dist := math.Abs(m.percentShown - m.targetPercent)
 		if dist < 0.001 && m.velocity < 0.01 {
@@ -229,7 +268,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 //
 // https://github.com/charmbracelet/harmonica
 func (m *Model) SetSpringOptions(frequency, damping float64) {
-	m.spring = harmonica.NewSpring(harmonica.FPS(fps), frequency, damping)
+	m.springFrequency, m.springDamping = frequency, damping
+	m.spring = harmonica.NewSpring(harmonica.FPS(m.frameRate()), frequency, damping)
 }
 
 // Percent returns the current visible percentage on the model. This is only
@@ -247,6 +287,12 @@ func (m Model) Percent() float64 {
 func (m *Model) SetPercent(p float64) tea.Cmd {
 	m.targetPercent = math.Max(0, math.Min(1, p))
 	m.tag++
+	if m.noAnimation || accessibility.ReducedMotion() {
+		m.percentShown = m.targetPercent
+		return nil
+	}
+	m.startPercent = m.percentShown
+	m.frame = 0
 	return m.nextFrame()
 }
 
@@ -269,11 +315,18 @@ func (m *Model) DecrPercent(v float64) tea.Cmd {
 // View renders the an animated progress bar in its current state. To render
 // a static progress bar based on your own calculations use ViewAs instead.
 func (m Model) View() string {
+	if m.Steps > 0 {
+		return m.stepsView()
+	}
 	return m.ViewAs(m.percentShown)
 }
 
 // ViewAs renders the progress bar with a given percentage.
 func (m Model) ViewAs(percent float64) string {
+	if m.Vertical {
+		return m.verticalView(percent)
+	}
+
 	b := strings.Builder{}
 	percentView := m.percentageView(percent)
 	m.barView(&b, percent, ansi.PrintableRuneWidth(percentView))
@@ -282,7 +335,7 @@ func (m Model) ViewAs(percent float64) string {
 }
 
 func (m *Model) nextFrame() tea.Cmd {
-	return tea.Tick(time.Second/time.Duration(fps), func(time.Time) tea.Msg {
+	return tea.Tick(time.Second/time.Duration(m.frameRate()), func(time.Time) tea.Msg {
 		return FrameMsg{id: m.id, tag: m.tag}
 	})
 }
@@ -347,6 +400,9 @@ func (m *Model) setRamp(colorA, colorB string, scaled bool) {
 }
 
 func (m Model) color(c string) termenv.Color {
+	if accessibility.NoColor() {
+		return termenv.NoColor{}
+	}
 	return m.colorProfile.Color(c)
 }
 
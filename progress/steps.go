@@ -0,0 +1,105 @@
+package progress
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/ansi"
+	"github.com/muesli/reflow/truncate"
+	"github.com/muesli/termenv"
+)
+
+// WithSteps renders the progress bar as a number of discrete steps, such as
+// the pages of a wizard, instead of a percentage. Every step is a segment of
+// the bar, with an optional label below it: completed steps are filled, the
+// current one is highlighted and the rest are empty. Move through the steps
+// with SetStep and NextStep.
+func WithSteps(n int, labels ...string) Option {
+	return func(m *Model) {
+		m.Steps = n
+		m.StepLabels = labels
+	}
+}
+
+// SetStep sets the current step, counting from zero. Setting it to the
+// number of steps completes all of them.
+func (m *Model) SetStep(step int) {
+	m.step = max(0, min(m.Steps, step))
+}
+
+// NextStep completes the current step and moves on to the next one.
+func (m *Model) NextStep() {
+	m.SetStep(m.step + 1)
+}
+
+// Step returns the current step, counting from zero.
+func (m Model) Step() int {
+	return m.step
+}
+
+// stepsView renders the progress bar's steps and their labels.
+func (m Model) stepsView() string {
+	n := m.Steps
+	countView := ""
+	if m.ShowPercentage {
+		countView = m.PercentageStyle.Inline(true).Render(fmt.Sprintf(" %d/%d", min(m.step+1, n), n))
+	}
+
+	var (
+		tw     = max(0, m.Width-ansi.PrintableRuneWidth(countView)-(n-1)) // total width of the segments
+		bar    strings.Builder
+		labels strings.Builder
+	)
+	for i := 0; i < n; i++ {
+		w := tw / n
+		if i < tw%n {
+			w++
+		}
+		if i > 0 {
+			bar.WriteString(" ")
+			labels.WriteString(" ")
+		}
+
+		var segment string
+		switch {
+		case i < m.step:
+			segment = termenv.String(string(m.Full)).Foreground(m.stepColor(i)).String()
+		case i == m.step:
+			segment = termenv.String(string(m.Full)).Foreground(m.color(m.CurrentStepColor)).String()
+		default:
+			segment = termenv.String(string(m.Empty)).Foreground(m.color(m.EmptyColor)).String()
+		}
+		bar.WriteString(strings.Repeat(segment, w))
+
+		var label string
+		if i < len(m.StepLabels) {
+			label = truncate.String(m.StepLabels[i], uint(w))
+		}
+		style := m.StepLabelStyle
+		if i == m.step {
+			style = m.CurrentStepLabelStyle
+		}
+		labels.WriteString(style.Inline(true).Render(label + strings.Repeat(" ", w-ansi.PrintableRuneWidth(label))))
+	}
+	bar.WriteString(countView)
+
+	if len(m.StepLabels) == 0 {
+		return bar.String()
+	}
+	return lipgloss.JoinVertical(lipgloss.Left, bar.String(), labels.String())
+}
+
+// stepColor returns the color of a completed step.
+func (m Model) stepColor(i int) termenv.Color {
+	if !m.useRamp {
+		return m.color(m.FullColor)
+	}
+	var p float64
+	if m.scaleRamp {
+		p = float64(i) / float64(max(1, m.step))
+	} else {
+		p = float64(i) / float64(m.Steps)
+	}
+	return m.color(m.rampColorA.BlendLuv(m.rampColorB, p).Hex())
+}
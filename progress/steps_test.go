@@ -0,0 +1,47 @@
+package progress
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/bubbletest"
+	"github.com/muesli/termenv"
+)
+
+func TestSteps(t *testing.T) {
+	m := New(WithSteps(3, "cart", "ship", "pay"), WithWidth(15), WithColorProfile(termenv.Ascii))
+
+	want := "███ ░░░ ░░░ 1/3\ncar shi pay"
+	if got := bubbletest.Plain(m.View()); got != want {
+		t.Fatalf("expected\n%s\ngot\n%s", want, got)
+	}
+
+	m.NextStep()
+	want = "███ ███ ░░░ 2/3\ncar shi pay"
+	if got := bubbletest.Plain(m.View()); got != want {
+		t.Fatalf("expected\n%s\ngot\n%s", want, got)
+	}
+
+	// Completing the last step fills the bar and the count stays at the end.
+	m.SetStep(10)
+	if m.Step() != 3 {
+		t.Fatalf("expected the step to be clamped to 3, got %d", m.Step())
+	}
+	want = "███ ███ ███ 3/3\ncar shi pay"
+	if got := bubbletest.Plain(m.View()); got != want {
+		t.Fatalf("expected\n%s\ngot\n%s", want, got)
+	}
+}
+
+func TestStepsWithoutLabels(t *testing.T) {
+	m := New(WithSteps(4), WithWidth(10), WithoutPercentage(), WithColorProfile(termenv.Ascii))
+	m.SetStep(-1)
+	if m.Step() != 0 {
+		t.Fatalf("expected the step to be clamped to 0, got %d", m.Step())
+	}
+
+	// The width left after the gaps is spread over the steps, the first ones
+	// getting the remainder.
+	if got, want := m.View(), "██ ░░ ░░ ░"; got != want {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+}
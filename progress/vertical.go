@@ -0,0 +1,74 @@
+package progress
+
+import (
+	"math"
+	"strings"
+
+	"github.com/muesli/termenv"
+)
+
+// partialBlocks are the blocks the top of a vertical bar is filled with, in
+// eighths of a cell.
+var partialBlocks = []rune{'▁', '▂', '▃', '▄', '▅', '▆', '▇'}
+
+// WithVertical renders the progress bar vertically, filling up from the
+// bottom, with the given height in rows, including the percentage if it's
+// shown. The bar is one column wide; use WithWidth after it to make it wider.
+func WithVertical(height int) Option {
+	return func(m *Model) {
+		m.Vertical = true
+		m.Height = height
+		m.Width = 1
+	}
+}
+
+// verticalView renders the progress bar vertically with a given percentage.
+func (m Model) verticalView(percent float64) string {
+	percentView := m.percentageView(percent)
+	th := m.Height // total height
+	if percentView != "" {
+		th--
+		percentView = strings.TrimLeft(percentView, " ")
+	}
+	th = max(0, th)
+
+	var (
+		eighths = int(math.Round(float64(th*8) * math.Max(0, math.Min(1, percent)))) //nolint:gomnd
+		fh      = eighths / 8                                                        //nolint:gomnd // filled height
+		partial = eighths % 8                                                        //nolint:gomnd
+		rows    = make([]string, th)
+	)
+	for i := range rows {
+		row := th - 1 - i // counting from the bottom
+		var cell string
+		switch {
+		case row < fh:
+			cell = termenv.String(string(m.Full)).Foreground(m.rowColor(row, th, eighths)).String()
+		case row == fh && partial > 0:
+			cell = termenv.String(string(partialBlocks[partial-1])).Foreground(m.rowColor(row, th, eighths)).String()
+		default:
+			cell = termenv.String(string(m.Empty)).Foreground(m.color(m.EmptyColor)).String()
+		}
+		rows[i] = strings.Repeat(cell, max(0, m.Width))
+	}
+
+	if percentView != "" {
+		rows = append(rows, percentView)
+	}
+	return strings.Join(rows, "\n")
+}
+
+// rowColor returns the color of a filled row of a vertical bar, counting from
+// the bottom.
+func (m Model) rowColor(row, th, eighths int) termenv.Color {
+	if !m.useRamp {
+		return m.color(m.FullColor)
+	}
+	var p float64
+	if m.scaleRamp {
+		p = float64(row) / math.Ceil(float64(eighths)/8) //nolint:gomnd
+	} else {
+		p = float64(row) / float64(th)
+	}
+	return m.color(m.rampColorA.BlendLuv(m.rampColorB, p).Hex())
+}
@@ -0,0 +1,33 @@
+package progress
+
+import (
+	"testing"
+
+	"github.com/muesli/termenv"
+)
+
+func TestVertical(t *testing.T) {
+	m := New(WithVertical(5), WithColorProfile(termenv.Ascii))
+
+	tests := []struct {
+		percent float64
+		want    string
+	}{
+		{0, "░\n░\n░\n░\n0%"},
+		{0.5, "░\n░\n█\n█\n50%"},
+		{0.3, "░\n░\n▂\n█\n30%"},
+		{1, "█\n█\n█\n█\n100%"},
+	}
+	for _, tc := range tests {
+		if got := m.ViewAs(tc.percent); got != tc.want {
+			t.Errorf("%.0f%%: expected\n%s\ngot\n%s", tc.percent*100, tc.want, got)
+		}
+	}
+}
+
+func TestVerticalWidth(t *testing.T) {
+	m := New(WithVertical(3), WithWidth(2), WithoutPercentage(), WithColorProfile(termenv.Ascii))
+	if got, want := m.ViewAs(0.5), "░░\n▄▄\n██"; got != want {
+		t.Fatalf("expected\n%s\ngot\n%s", want, got)
+	}
+}
@@ -0,0 +1,467 @@
+// Package prompt provides a readline-style prompt for REPLs and chat-style
+// interfaces. It combines a text input with history, searchable with ctrl+r,
+// completion, continuation lines for multi-line input, and a transcript of
+// the input and the output above it.
+//
+// When the user submits input the prompt sends a SubmittedMsg. Write the
+// output with Println:
+//
+//	case prompt.SubmittedMsg:
+//	    m.prompt.Println(eval(msg.Value))
+package prompt
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/historypicker"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/route"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// SubmittedMsg is sent when the user submits input.
+type SubmittedMsg struct {
+	// ID is the ID of the prompt that sent the message.
+	ID int
+
+	// Value is the input, with its continuation lines separated by
+	// newlines.
+	Value string
+}
+
+// KeyMap defines the keybindings for submitting input, recalling and
+// searching the history, and scrolling the transcript. It satisfies the
+// help.KeyMap interface, so the bindings can be listed with the help bubble.
+type KeyMap struct {
+	Submit      key.Binding
+	HistoryPrev key.Binding
+	HistoryNext key.Binding
+	Search      key.Binding
+
+	// Scrolling the transcript.
+	ScrollUp   key.Binding
+	ScrollDown key.Binding
+}
+
+// DefaultKeyMap returns a default set of keybindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Submit: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "submit"),
+		),
+		HistoryPrev: key.NewBinding(
+			key.WithKeys("up", "ctrl+p"),
+			key.WithHelp("↑", "previous"),
+		),
+		HistoryNext: key.NewBinding(
+			key.WithKeys("down", "ctrl+n"),
+			key.WithHelp("↓", "next"),
+		),
+		Search: key.NewBinding(
+			key.WithKeys("ctrl+r"),
+			key.WithHelp("ctrl+r", "search history"),
+		),
+		ScrollUp: key.NewBinding(
+			key.WithKeys("pgup"),
+			key.WithHelp("pgup", "scroll up"),
+		),
+		ScrollDown: key.NewBinding(
+			key.WithKeys("pgdown"),
+			key.WithHelp("pgdn", "scroll down"),
+		),
+	}
+}
+
+// ShortHelp implements the KeyMap interface.
+func (km KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{km.Submit, km.HistoryPrev, km.HistoryNext, km.Search}
+}
+
+// FullHelp implements the KeyMap interface.
+func (km KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{km.ShortHelp(), {km.ScrollUp, km.ScrollDown}}
+}
+
+// Styles contains the styles used to render the prompt.
+type Styles struct {
+	// Input styles the submitted input in the transcript, and Output the
+	// output written with Println.
+	Input  lipgloss.Style
+	Output lipgloss.Style
+}
+
+// DefaultStyles returns a set of default style definitions for the prompt.
+func DefaultStyles() Styles {
+	return Styles{
+		Input:  lipgloss.NewStyle().Foreground(lipgloss.Color("240")),
+		Output: lipgloss.NewStyle(),
+	}
+}
+
+// ContinueFunc reports whether the input needs another line, such as when
+// it has unbalanced brackets.
+type ContinueFunc func(input string) bool
+
+// ContinueBackslash is a ContinueFunc that continues input ending with a
+// backslash, like shells do. The backslashes are removed from the submitted
+// input.
+func ContinueBackslash(input string) bool {
+	return strings.HasSuffix(input, "\\")
+}
+
+// Model is the prompt.
+type Model struct {
+	KeyMap KeyMap
+	Styles Styles
+
+	// Input is the text input. Set its Complete function to complete input
+	// with tab.
+	Input textinput.Model
+
+	// Transcript shows the submitted input and the output.
+	Transcript viewport.Model
+
+	// Prompt is shown before the first line of input, and
+	// ContinuationPrompt before the lines that continue it.
+	Prompt             string
+	ContinuationPrompt string
+
+	// Continue reports whether the input needs another line. Input is never
+	// continued if it's nil.
+	Continue ContinueFunc
+
+	id      int
+	height  int
+	lines   []string
+	output  []string
+	history []string
+
+	// The position in history while browsing it, and the input before
+	// browsing started.
+	historyIndex int
+	draft        string
+
+	search historypicker.Model
+}
+
+// Option is used to set options in New.
+type Option func(*Model)
+
+// New creates a new prompt. It's focused, so call Focus in Init to make the
+// cursor blink.
+func New(opts ...Option) Model {
+	m := Model{
+		KeyMap:             DefaultKeyMap(),
+		Styles:             DefaultStyles(),
+		Input:              textinput.New(),
+		Transcript:         viewport.New(0, 0),
+		Prompt:             "> ",
+		ContinuationPrompt: ". ",
+		Continue:           ContinueBackslash,
+		id:                 route.NextID(),
+		search:             historypicker.New(nil, historypicker.WithHeight(5), historypicker.WithoutPreview()),
+	}
+	m.Input.Prompt = m.Prompt
+	m.Input.Focus()
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
+}
+
+// WithHistory sets the history, oldest first.
+func WithHistory(history []string) Option {
+	return func(m *Model) {
+		m.SetHistory(history)
+	}
+}
+
+// WithPrompts sets the prompt and the continuation prompt.
+func WithPrompts(prompt, continuation string) Option {
+	return func(m *Model) {
+		m.Prompt = prompt
+		m.ContinuationPrompt = continuation
+		m.Input.Prompt = prompt
+	}
+}
+
+// WithContinue sets the function that reports whether the input needs
+// another line.
+func WithContinue(f ContinueFunc) Option {
+	return func(m *Model) {
+		m.Continue = f
+	}
+}
+
+// WithCompletion sets the function that completes the input when tab is
+// pressed.
+func WithCompletion(f textinput.CompleteFunc) Option {
+	return func(m *Model) {
+		m.Input.Complete = f
+	}
+}
+
+// WithStyles sets the styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) {
+		m.Styles = s
+	}
+}
+
+// WithKeyMap sets the key map.
+func WithKeyMap(km KeyMap) Option {
+	return func(m *Model) {
+		m.KeyMap = km
+	}
+}
+
+// ID returns the prompt's unique ID.
+func (m Model) ID() int {
+	return m.id
+}
+
+// Focus focuses the input. It returns the command that makes its cursor blink.
+func (m *Model) Focus() tea.Cmd {
+	return m.Input.Focus()
+}
+
+// Blur blurs the input.
+func (m *Model) Blur() {
+	m.Input.Blur()
+}
+
+// SetSize sets the size of the prompt. The transcript takes up the height
+// that's left by the input.
+func (m *Model) SetSize(width, height int) {
+	m.height = height
+	m.Transcript.Width = width
+	m.Input.Width = max(0, width-lipgloss.Width(m.Prompt)-1)
+	m.search.Width = width
+	m.layout()
+}
+
+// MinSize returns the size of the input alone, with room for a character. It
+// satisfies the layout.Sizable interface along with SetSize.
+func (m Model) MinSize() (width, height int) {
+	return lipgloss.Width(m.Prompt) + 2, lipgloss.Height(m.inputView())
+}
+
+// PreferredSize returns the height of the input and all of the output. The
+// prompt would rather fill the width.
+func (m Model) PreferredSize() (width, height int) {
+	_, h := m.Transcript.PreferredSize()
+	return 0, h + lipgloss.Height(m.inputView())
+}
+
+// SetHistory sets the history, oldest first.
+func (m *Model) SetHistory(history []string) {
+	m.history = history
+	m.historyIndex = len(history)
+	m.search.SetEntries(history)
+}
+
+// History returns the history, oldest first. Submitted input is added to it.
+func (m Model) History() []string {
+	return m.history
+}
+
+// Println writes output to the transcript and scrolls to the bottom.
+func (m *Model) Println(s string) {
+	m.output = append(m.output, m.Styles.Output.Render(s))
+	m.updateTranscript()
+}
+
+// Clear clears the transcript.
+func (m *Model) Clear() {
+	m.output = nil
+	m.updateTranscript()
+}
+
+// Update handles key presses.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case historypicker.AcceptedMsg:
+		if msg.ID == m.search.ID() {
+			m.Input.SetValue(msg.Value)
+			m.Input.CursorEnd()
+			m.layout()
+		}
+		return m, nil
+	case historypicker.CanceledMsg:
+		if msg.ID == m.search.ID() {
+			m.layout()
+		}
+		return m, nil
+	}
+
+	if m.search.Active() {
+		var cmd tea.Cmd
+		m.search, cmd = m.search.Update(msg)
+		return m, cmd
+	}
+
+	if !m.Input.Focused() {
+		return m, nil
+	}
+
+	msg, ok := route.Accept(m.id, msg)
+	if !ok {
+		return m, nil
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.KeyMap.Submit):
+			return m, m.submit()
+		case key.Matches(msg, m.KeyMap.HistoryPrev):
+			m.browseHistory(-1)
+			return m, nil
+		case key.Matches(msg, m.KeyMap.HistoryNext):
+			m.browseHistory(1)
+			return m, nil
+		case key.Matches(msg, m.KeyMap.Search):
+			cmd := m.search.Open()
+			m.layout()
+			return m, cmd
+		case key.Matches(msg, m.KeyMap.ScrollUp):
+			m.Transcript.ViewUp()
+			return m, nil
+		case key.Matches(msg, m.KeyMap.ScrollDown):
+			m.Transcript.ViewDown()
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.Input, cmd = m.Input.Update(msg)
+	return m, cmd
+}
+
+// submit adds the input's line to the input, and submits it unless it needs
+// another line.
+func (m *Model) submit() tea.Cmd {
+	line := m.Input.Value()
+	m.lines = append(m.lines, line)
+	m.Input.Reset()
+	input := strings.Join(m.lines, "\n")
+
+	if m.Continue != nil && m.Continue(input) {
+		m.Input.Prompt = m.ContinuationPrompt
+		m.layout()
+		return nil
+	}
+
+	var echo []string
+	for i, line := range m.lines {
+		prompt := m.ContinuationPrompt
+		if i == 0 {
+			prompt = m.Prompt
+		}
+		echo = append(echo, prompt+line)
+	}
+	m.output = append(m.output, m.Styles.Input.Render(strings.Join(echo, "\n")))
+
+	if m.Continue != nil {
+		for i, line := range m.lines {
+			m.lines[i] = strings.TrimSuffix(line, "\\")
+		}
+		input = strings.Join(m.lines, "\n")
+	}
+	m.lines = nil
+	m.Input.Prompt = m.Prompt
+
+	if strings.TrimSpace(input) != "" && (len(m.history) == 0 || m.history[len(m.history)-1] != input) {
+		m.SetHistory(append(m.history, input))
+	}
+	m.historyIndex = len(m.history)
+	m.updateTranscript()
+
+	id := m.id
+	return func() tea.Msg {
+		return SubmittedMsg{ID: id, Value: input}
+	}
+}
+
+// browseHistory moves through the history by n entries, keeping the input
+// from before browsing started to come back to.
+func (m *Model) browseHistory(n int) {
+	if len(m.history) == 0 {
+		return
+	}
+	if m.historyIndex == len(m.history) {
+		m.draft = m.Input.Value()
+	}
+	m.historyIndex = clamp(m.historyIndex+n, 0, len(m.history))
+	if m.historyIndex == len(m.history) {
+		m.Input.SetValue(m.draft)
+	} else {
+		m.Input.SetValue(m.history[m.historyIndex])
+	}
+	m.Input.CursorEnd()
+}
+
+// updateTranscript renders the transcript and scrolls to the bottom.
+func (m *Model) updateTranscript() {
+	m.Transcript.SetContent(strings.Join(m.output, "\n"))
+	m.layout()
+	m.Transcript.GotoBottom()
+}
+
+// layout sizes the transcript to leave room for the input, keeping the
+// bottom of the transcript in view.
+func (m *Model) layout() {
+	atBottom := m.Transcript.AtBottom()
+	m.Transcript.Height = max(0, m.height-lipgloss.Height(m.inputView()))
+	if atBottom {
+		m.Transcript.GotoBottom()
+	}
+}
+
+// View renders the transcript above the input, or the history search while
+// it's open.
+func (m Model) View() string {
+	if m.Transcript.Height == 0 {
+		return m.inputView()
+	}
+	return m.Transcript.View() + "\n" + m.inputView()
+}
+
+// inputView renders the lines of input so far and the input, or the history
+// search while it's open.
+func (m Model) inputView() string {
+	if m.search.Active() {
+		return m.search.View()
+	}
+	var lines []string
+	for i, line := range m.lines {
+		prompt := m.ContinuationPrompt
+		if i == 0 {
+			prompt = m.Prompt
+		}
+		lines = append(lines, prompt+line)
+	}
+	return strings.Join(append(lines, m.Input.View()), "\n")
+}
+
+func clamp(v, low, high int) int {
+	if v < low {
+		return low
+	}
+	if v > high {
+		return high
+	}
+	return v
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,110 @@
+package prompt
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/bubbletest"
+	"github.com/charmbracelet/bubbles/historypicker"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func submit(t *testing.T, m Model) (Model, tea.Msg) {
+	t.Helper()
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd == nil {
+		return m, nil
+	}
+	return m, cmd()
+}
+
+func TestSubmit(t *testing.T) {
+	m := New()
+	m.SetSize(20, 5)
+
+	m = bubbletest.TypeString(m, "1 + 1").(Model)
+	m, msg := submit(t, m)
+	if msg, ok := msg.(SubmittedMsg); !ok || msg.Value != "1 + 1" || msg.ID != m.ID() {
+		t.Fatalf("expected 1 + 1 to be submitted, got %#v", msg)
+	}
+	m.Println("2")
+
+	if m.Input.Value() != "" {
+		t.Errorf("expected the input to be reset, got %q", m.Input.Value())
+	}
+	if h := m.History(); len(h) != 1 || h[0] != "1 + 1" {
+		t.Errorf("expected the input to be added to the history, got %q", h)
+	}
+	view := m.View()
+	if !strings.Contains(view, "> 1 + 1") || !strings.Contains(view, "2") {
+		t.Errorf("expected the transcript to show the input and the output, got %q", view)
+	}
+	if n := strings.Count(view, "\n") + 1; n != 5 {
+		t.Errorf("expected the view to be 5 lines tall, got %d", n)
+	}
+
+	// Submitting the same input again doesn't repeat it in the history.
+	m = bubbletest.TypeString(m, "1 + 1").(Model)
+	m, _ = submit(t, m)
+	if h := m.History(); len(h) != 1 {
+		t.Errorf("expected no duplicate in the history, got %q", h)
+	}
+}
+
+func TestContinuation(t *testing.T) {
+	m := New()
+
+	m = bubbletest.TypeString(m, `echo one \`).(Model)
+	m, msg := submit(t, m)
+	if msg != nil {
+		t.Fatalf("expected the input to continue, got %#v", msg)
+	}
+	if m.Input.Prompt != m.ContinuationPrompt {
+		t.Errorf("expected the continuation prompt, got %q", m.Input.Prompt)
+	}
+
+	m = bubbletest.TypeString(m, "two").(Model)
+	m, msg = submit(t, m)
+	if msg, ok := msg.(SubmittedMsg); !ok || msg.Value != "echo one \ntwo" {
+		t.Fatalf("expected both lines to be submitted, got %#v", msg)
+	}
+	if m.Input.Prompt != m.Prompt {
+		t.Errorf("expected the prompt to be restored, got %q", m.Input.Prompt)
+	}
+}
+
+func TestHistory(t *testing.T) {
+	m := New(WithHistory([]string{"first", "second"}))
+	m = bubbletest.TypeString(m, "draft").(Model)
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	if v := m.Input.Value(); v != "second" {
+		t.Fatalf("expected the most recent entry, got %q", v)
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	if v := m.Input.Value(); v != "first" {
+		t.Fatalf("expected the oldest entry, got %q", v)
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if v := m.Input.Value(); v != "draft" {
+		t.Fatalf("expected the draft to come back, got %q", v)
+	}
+}
+
+func TestSearch(t *testing.T) {
+	m := New(WithHistory([]string{"git status", "ls"}))
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyCtrlR})
+	m = bubbletest.TypeString(m, "git").(Model)
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	msg, ok := cmd().(historypicker.AcceptedMsg)
+	if !ok {
+		t.Fatalf("expected the search to accept an entry, got %#v", msg)
+	}
+	m, _ = m.Update(msg)
+	if v := m.Input.Value(); v != "git status" {
+		t.Errorf("expected the accepted entry in the input, got %q", v)
+	}
+}
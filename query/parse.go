@@ -0,0 +1,283 @@
+package query
+
+import (
+	"errors"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/table"
+)
+
+// TokenKind is the kind of a token of a query.
+type TokenKind int
+
+// Token kinds.
+const (
+	// TokenTerm is text to find anywhere, such as error or "not found".
+	TokenTerm TokenKind = iota
+
+	// TokenField is text to find in a field, such as status:open or
+	// title:"bug report".
+	TokenField
+
+	TokenAnd
+	TokenOr
+	TokenNot
+)
+
+// Token is a token of a query.
+type Token struct {
+	Kind TokenKind
+
+	// Text is the text of the token as it was typed, and Start and End are
+	// its offsets into the query's runes.
+	Text  string
+	Start int
+	End   int
+
+	// Field is the field of a TokenField, and Value the text to find,
+	// without quotes.
+	Field  string
+	Value  string
+	Quoted bool
+
+	// Err is why the token is invalid, if it is.
+	Err error
+}
+
+// Errors of invalid tokens.
+var (
+	ErrUnterminatedQuote = errors.New("unterminated quote")
+	ErrMissingValue      = errors.New("missing value")
+	ErrMissingOperand    = errors.New("missing term")
+)
+
+// Tokenize splits a query into tokens. Terms are separated by spaces unless
+// they're quoted. AND, OR and NOT, in capitals, are operators.
+func Tokenize(s string) []Token {
+	var (
+		tokens []Token
+		runes  = []rune(s)
+		i      int
+	)
+	for i < len(runes) {
+		if unicode.IsSpace(runes[i]) {
+			i++
+			continue
+		}
+
+		t := Token{Start: i}
+		if runes[i] == '"' {
+			t.Value, i, t.Err = readQuoted(runes, i)
+			t.Quoted = true
+		} else {
+			start := i
+			for i < len(runes) && !unicode.IsSpace(runes[i]) && runes[i] != ':' && runes[i] != '"' {
+				i++
+			}
+			word := string(runes[start:i])
+
+			if i < len(runes) && runes[i] == ':' && word != "" {
+				t.Kind, t.Field = TokenField, word
+				i++
+				if i < len(runes) && runes[i] == '"' {
+					t.Value, i, t.Err = readQuoted(runes, i)
+					t.Quoted = true
+				} else {
+					start := i
+					for i < len(runes) && !unicode.IsSpace(runes[i]) {
+						i++
+					}
+					t.Value = string(runes[start:i])
+					if t.Value == "" {
+						t.Err = ErrMissingValue
+					}
+				}
+			} else {
+				// Read the rest of the word, up to a space.
+				for i < len(runes) && !unicode.IsSpace(runes[i]) {
+					i++
+				}
+				t.Value = string(runes[start:i])
+				switch t.Value {
+				case "AND":
+					t.Kind = TokenAnd
+				case "OR":
+					t.Kind = TokenOr
+				case "NOT":
+					t.Kind = TokenNot
+				}
+			}
+		}
+		t.End = i
+		t.Text = string(runes[t.Start:t.End])
+		tokens = append(tokens, t)
+	}
+	return tokens
+}
+
+// readQuoted reads a quoted string starting at the quote at i, with \" and
+// \\ as escapes. It returns the string and the offset after the closing
+// quote.
+func readQuoted(runes []rune, i int) (string, int, error) {
+	var b strings.Builder
+	for i++; i < len(runes); i++ {
+		switch {
+		case runes[i] == '\\' && i+1 < len(runes) && (runes[i+1] == '"' || runes[i+1] == '\\'):
+			i++
+			b.WriteRune(runes[i])
+		case runes[i] == '"':
+			return b.String(), i + 1, nil
+		default:
+			b.WriteRune(runes[i])
+		}
+	}
+	return b.String(), i, ErrUnterminatedQuote
+}
+
+// clause is a term or a field to find, possibly negated.
+type clause struct {
+	Token
+	negate bool
+}
+
+// Query is a parsed query: groups of clauses joined by OR, where all clauses
+// of a group must match, joined by AND or just spaces. AND binds tighter
+// than OR, so a b OR c matches either both a and b, or c.
+type Query struct {
+	groups [][]clause
+}
+
+// Parse parses a query. It returns the tokens with the errors of invalid
+// ones set, and the query of the valid tokens.
+func Parse(s string) (Query, []Token) {
+	tokens := Tokenize(s)
+
+	var (
+		q       Query
+		group   []clause
+		negate  bool
+		pending = -1 // the operator waiting for a term
+	)
+	for i := range tokens {
+		t := &tokens[i]
+		switch t.Kind {
+		case TokenAnd, TokenOr:
+			if len(group) == 0 || pending >= 0 {
+				t.Err = ErrMissingOperand
+				continue
+			}
+			if t.Kind == TokenOr {
+				q.groups = append(q.groups, group)
+				group = nil
+			}
+			pending = i
+		case TokenNot:
+			negate = !negate
+			pending = i
+		default:
+			pending = -1
+			if t.Err == nil {
+				group = append(group, clause{*t, negate})
+			}
+			negate = false
+		}
+	}
+	if pending >= 0 {
+		tokens[pending].Err = ErrMissingOperand
+	}
+	if len(group) > 0 {
+		q.groups = append(q.groups, group)
+	}
+	return q, tokens
+}
+
+// Empty returns whether the query has no terms, and so matches everything.
+func (q Query) Empty() bool {
+	return len(q.groups) == 0
+}
+
+// Match returns whether the query matches something, given functions that
+// return whether a term or a field's value matches it.
+func (q Query) Match(term func(value string) bool, field func(name, value string) bool) bool {
+	if q.Empty() {
+		return true
+	}
+	for _, group := range q.groups {
+		matched := true
+		for _, c := range group {
+			var ok bool
+			if c.Kind == TokenField {
+				ok = field(c.Field, c.Value)
+			} else {
+				ok = term(c.Value)
+			}
+			if ok == c.negate {
+				matched = false
+				break
+			}
+		}
+		if matched {
+			return true
+		}
+	}
+	return false
+}
+
+// MatchText returns whether the query matches a text, ignoring case. Fields
+// match where the text contains the field and its value, such as
+// "status:open".
+func (q Query) MatchText(text string) bool {
+	text = strings.ToLower(text)
+	return q.Match(func(value string) bool {
+		return strings.Contains(text, strings.ToLower(value))
+	}, func(name, value string) bool {
+		return strings.Contains(text, strings.ToLower(name+":"+value))
+	})
+}
+
+// MatchRow returns a predicate for table.Model.MatchRows that matches rows
+// against the query, ignoring case. Terms match any cell, and fields the cell
+// in the column of the same name.
+//
+//	q, _ := query.Parse("status:open bug")
+//	m.table.MatchRows(q.MatchRow([]string{"Title", "Status"}))
+func (q Query) MatchRow(columns []string) func(row table.Row) bool {
+	return func(row table.Row) bool {
+		return q.Match(func(value string) bool {
+			for _, cell := range row {
+				if containsFold(cell, value) {
+					return true
+				}
+			}
+			return false
+		}, func(name, value string) bool {
+			for i, col := range columns {
+				if strings.EqualFold(col, name) && i < len(row) {
+					return containsFold(row[i], value)
+				}
+			}
+			return false
+		})
+	}
+}
+
+// ListFilter is a list.FilterFunc that matches the items' filter values
+// against a query, keeping the order of the items.
+//
+//	l.Filter = query.ListFilter
+func ListFilter(term string, targets []string) []list.Rank {
+	q, _ := Parse(term)
+	var ranks []list.Rank
+	for i, target := range targets {
+		if q.MatchText(target) {
+			ranks = append(ranks, list.Rank{Index: i})
+		}
+	}
+	return ranks
+}
+
+func containsFold(s, substr string) bool {
+	return strings.Contains(strings.ToLower(s), strings.ToLower(substr))
+}
@@ -0,0 +1,396 @@
+// Package query provides a query bar for structured filters: an input that
+// splits what's typed into terms, fields such as status:open, quoted strings
+// and the AND, OR and NOT operators, colors every token by its kind, marks
+// invalid ones and completes field names and values with tab.
+//
+// The parsed query can filter a table or a list:
+//
+//	m.query = query.New(query.WithFields(
+//	    query.Field{Name: "status", Values: []string{"open", "closed"}},
+//	    query.Field{Name: "title"},
+//	))
+//
+//	m.query, cmd = m.query.Update(msg)
+//	m.table.MatchRows(m.query.Query().MatchRow([]string{"title", "status"}))
+package query
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/i18n"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// SubmittedMsg is sent when the user submits a valid query.
+type SubmittedMsg struct {
+	// ID is the ID of the query bar that sent the message.
+	ID int
+
+	Query Query
+	Text  string
+}
+
+// Field is a field queries can filter on.
+type Field struct {
+	Name string
+
+	// Values are the values the field's value is completed to.
+	Values []string
+
+	// Validate returns an error if a value isn't valid for the field.
+	Validate func(value string) error
+}
+
+// KeyMap defines the keybindings that apply and clear the query. It satisfies
+// the help.KeyMap interface, so the bindings can be listed with the help
+// bubble.
+type KeyMap struct {
+	Submit key.Binding
+	Clear  key.Binding
+}
+
+// DefaultKeyMap returns a default set of keybindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Submit: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "apply"),
+		),
+		Clear: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "clear"),
+		),
+	}
+}
+
+// ShortHelp implements the KeyMap interface.
+func (km KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{km.Submit, km.Clear}
+}
+
+// FullHelp implements the KeyMap interface.
+func (km KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{km.ShortHelp()}
+}
+
+// Styles contains the styles used to render the query bar.
+type Styles struct {
+	Term     lipgloss.Style
+	Field    lipgloss.Style
+	Value    lipgloss.Style
+	Operator lipgloss.Style
+
+	// Invalid is applied to invalid tokens, on top of the style of their
+	// kind, and Error to the error of the first one, shown below the input.
+	Invalid lipgloss.Style
+	Error   lipgloss.Style
+}
+
+// DefaultStyles returns a set of default style definitions for the query bar.
+func DefaultStyles() Styles {
+	return Styles{
+		Term:     lipgloss.NewStyle(),
+		Field:    lipgloss.NewStyle().Foreground(lipgloss.Color("39")),
+		Value:    lipgloss.NewStyle().Foreground(lipgloss.Color("214")),
+		Operator: lipgloss.NewStyle().Foreground(lipgloss.Color("170")).Bold(true),
+		Invalid:  lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Underline(true),
+		Error:    lipgloss.NewStyle().Foreground(lipgloss.Color("196")),
+	}
+}
+
+// Model is the query bar.
+type Model struct {
+	KeyMap KeyMap
+	Styles Styles
+
+	// Input is the text input the query is typed in. The query bar renders
+	// its value itself, with colored tokens.
+	Input textinput.Model
+
+	// Fields are the fields queries can filter on. Fields not among them
+	// are invalid, unless there are none.
+	Fields []Field
+
+	query  Query
+	tokens []Token
+}
+
+// Option is used to set options in New.
+type Option func(*Model)
+
+// New creates a new query bar.
+func New(opts ...Option) Model {
+	m := Model{
+		KeyMap: DefaultKeyMap(),
+		Styles: DefaultStyles(),
+		Input:  textinput.New(),
+	}
+	m.Input.Prompt = "/ "
+	m.Input.Placeholder = i18n.Text("Filter…")
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	m.Input.Complete = m.complete
+	return m
+}
+
+// WithFields sets the fields queries can filter on.
+func WithFields(fields ...Field) Option {
+	return func(m *Model) {
+		m.Fields = fields
+	}
+}
+
+// WithStyles sets the styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) {
+		m.Styles = s
+	}
+}
+
+// WithKeyMap sets the key map.
+func WithKeyMap(km KeyMap) Option {
+	return func(m *Model) {
+		m.KeyMap = km
+	}
+}
+
+// ID returns the query bar's unique ID.
+func (m Model) ID() int {
+	return m.Input.ID()
+}
+
+// Focus focuses the query bar. It returns the command that makes the cursor
+// blink.
+func (m *Model) Focus() tea.Cmd {
+	return m.Input.Focus()
+}
+
+// Blur blurs the query bar.
+func (m *Model) Blur() {
+	m.Input.Blur()
+}
+
+// Focused returns whether the query bar is focused.
+func (m Model) Focused() bool {
+	return m.Input.Focused()
+}
+
+// SetFields sets the fields queries can filter on, and validates the query
+// again.
+func (m *Model) SetFields(fields []Field) {
+	m.Fields = fields
+	m.Input.Complete = m.complete
+	m.parse()
+}
+
+// SetValue sets the text of the query.
+func (m *Model) SetValue(s string) {
+	m.Input.SetValue(s)
+	m.parse()
+}
+
+// Value returns the text of the query.
+func (m Model) Value() string {
+	return m.Input.Value()
+}
+
+// Query returns the parsed query, without the invalid tokens.
+func (m Model) Query() Query {
+	return m.query
+}
+
+// Tokens returns the tokens of the query.
+func (m Model) Tokens() []Token {
+	return m.tokens
+}
+
+// Err returns the error of the first invalid token, if any.
+func (m Model) Err() error {
+	for _, t := range m.tokens {
+		if t.Err != nil {
+			return fmt.Errorf("%s: %w", t.Text, t.Err)
+		}
+	}
+	return nil
+}
+
+// parse parses the query and validates its fields.
+func (m *Model) parse() {
+	m.query, m.tokens = Parse(m.Input.Value())
+	if len(m.Fields) == 0 {
+		return
+	}
+
+	invalid := make(map[int]bool)
+	for i := range m.tokens {
+		t := &m.tokens[i]
+		if t.Kind != TokenField || t.Err != nil {
+			continue
+		}
+		f, ok := m.field(t.Field)
+		switch {
+		case !ok:
+			t.Err = fmt.Errorf("unknown field %q", t.Field)
+		case f.Validate != nil:
+			t.Err = f.Validate(t.Value)
+		}
+		if t.Err != nil {
+			invalid[t.Start] = true
+		}
+	}
+
+	// Leave the invalid fields out of the query.
+	for g, group := range m.query.groups {
+		valid := group[:0:0]
+		for _, c := range group {
+			if !invalid[c.Start] {
+				valid = append(valid, c)
+			}
+		}
+		m.query.groups[g] = valid
+	}
+}
+
+// field returns the field of the given name, ignoring case.
+func (m Model) field(name string) (Field, bool) {
+	for _, f := range m.Fields {
+		if strings.EqualFold(f.Name, name) {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
+
+// complete completes the word before the cursor to field names, and the
+// values of a field after its colon.
+func (m Model) complete(text string) []string {
+	start := strings.LastIndexAny(text, " \t") + 1
+	before, word := text[:start], text[start:]
+
+	var completions []string
+	if i := strings.Index(word, ":"); i >= 0 {
+		f, ok := m.field(word[:i])
+		if !ok {
+			return nil
+		}
+		prefix := strings.TrimPrefix(word[i+1:], `"`)
+		for _, v := range f.Values {
+			if strings.HasPrefix(strings.ToLower(v), strings.ToLower(prefix)) {
+				if strings.ContainsAny(v, " \t") {
+					v = `"` + v + `"`
+				}
+				completions = append(completions, before+word[:i+1]+v)
+			}
+		}
+	} else {
+		for _, f := range m.Fields {
+			if strings.HasPrefix(strings.ToLower(f.Name), strings.ToLower(word)) {
+				completions = append(completions, before+f.Name+":")
+			}
+		}
+	}
+	sort.Strings(completions)
+	return completions
+}
+
+// Update handles key presses while the query bar is focused.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.Input.Focused() {
+		return m, nil
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.KeyMap.Submit):
+			if m.Err() != nil {
+				return m, nil
+			}
+			id, q, text := m.ID(), m.query, m.Value()
+			return m, func() tea.Msg {
+				return SubmittedMsg{ID: id, Query: q, Text: text}
+			}
+		case key.Matches(msg, m.KeyMap.Clear):
+			m.SetValue("")
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.Input, cmd = m.Input.Update(msg)
+	m.parse()
+	return m, cmd
+}
+
+// View renders the query bar, with the error of the first invalid token below
+// it.
+func (m Model) View() string {
+	view := m.Input.View()
+	if m.Value() != "" {
+		// Replace the input's line with the colored tokens, keeping what
+		// it renders below it, such as completions.
+		var rest string
+		if i := strings.Index(view, "\n"); i >= 0 {
+			rest = view[i:]
+		}
+		view = m.Input.PromptStyle.Render(m.Input.Prompt) + m.valueView() + rest
+	}
+	if err := m.Err(); err != nil {
+		view += "\n" + m.Styles.Error.Render(err.Error())
+	}
+	return view
+}
+
+// valueView renders the value with its tokens colored and the cursor.
+func (m Model) valueView() string {
+	runes := []rune(m.Value())
+	styles := make([]lipgloss.Style, len(runes))
+	for i := range styles {
+		styles[i] = m.Styles.Term
+	}
+	for _, t := range m.tokens {
+		for i := t.Start; i < t.End; i++ {
+			var style lipgloss.Style
+			switch t.Kind {
+			case TokenField:
+				style = m.Styles.Value
+				if i <= t.Start+len([]rune(t.Field)) {
+					style = m.Styles.Field
+				}
+			case TokenAnd, TokenOr, TokenNot:
+				style = m.Styles.Operator
+			default:
+				style = m.Styles.Term
+			}
+			if t.Err != nil {
+				style = m.Styles.Invalid.Copy().Inherit(style)
+			}
+			styles[i] = style
+		}
+	}
+
+	var b strings.Builder
+	pos := m.Input.Position()
+	for i, r := range runes {
+		if i == pos && m.Input.Focused() {
+			c := m.Input.Cursor
+			c.SetChar(string(r))
+			b.WriteString(c.View())
+			continue
+		}
+		b.WriteString(styles[i].Inline(true).Render(string(r)))
+	}
+	if pos >= len(runes) && m.Input.Focused() {
+		c := m.Input.Cursor
+		c.SetChar(" ")
+		b.WriteString(c.View())
+	}
+	return b.String()
+}
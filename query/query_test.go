@@ -0,0 +1,167 @@
+package query
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/bubbletest"
+	"github.com/charmbracelet/bubbles/table"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestTokenize(t *testing.T) {
+	tokens := Tokenize(`status:open "not found" OR title:"bug \"x\"" NOT old`)
+
+	var kinds []TokenKind
+	for _, tok := range tokens {
+		kinds = append(kinds, tok.Kind)
+	}
+	exp := []TokenKind{TokenField, TokenTerm, TokenOr, TokenField, TokenNot, TokenTerm}
+	if !reflect.DeepEqual(kinds, exp) {
+		t.Fatalf("expected kinds %v, got %v", exp, kinds)
+	}
+
+	if tok := tokens[1]; tok.Value != "not found" || !tok.Quoted || tok.Start != 12 || tok.End != 23 {
+		t.Errorf("unexpected quoted term %#v", tok)
+	}
+	if tok := tokens[3]; tok.Field != "title" || tok.Value != `bug "x"` {
+		t.Errorf("unexpected quoted field %#v", tok)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		query string
+		index int
+		err   error
+	}{
+		{`"open`, 0, ErrUnterminatedQuote},
+		{`status:`, 0, ErrMissingValue},
+		{`OR open`, 0, ErrMissingOperand},
+		{`open AND`, 1, ErrMissingOperand},
+		{`open OR AND closed`, 2, ErrMissingOperand},
+	}
+	for _, tt := range tests {
+		_, tokens := Parse(tt.query)
+		if err := tokens[tt.index].Err; !errors.Is(err, tt.err) {
+			t.Errorf("%s: expected %v, got %v", tt.query, tt.err, err)
+		}
+	}
+}
+
+func TestMatchText(t *testing.T) {
+	tests := []struct {
+		query string
+		text  string
+		match bool
+	}{
+		{``, "anything", true},
+		{`bug`, "Bug report", true},
+		{`bug crash`, "bug report", false},
+		{`bug crash OR report`, "bug report", true},
+		{`bug AND NOT report`, "bug report", false},
+		{`status:open`, "status:open bug", true},
+		{`"bug report"`, "a bug report", true},
+	}
+	for _, tt := range tests {
+		q, _ := Parse(tt.query)
+		if got := q.MatchText(tt.text); got != tt.match {
+			t.Errorf("%q in %q: expected %t, got %t", tt.query, tt.text, tt.match, got)
+		}
+	}
+}
+
+func TestMatchRow(t *testing.T) {
+	q, _ := Parse(`status:open crash`)
+	match := q.MatchRow([]string{"Title", "Status"})
+
+	if !match(table.Row{"Crash on start", "Open"}) {
+		t.Error("expected the row to match")
+	}
+	if match(table.Row{"Crash on start", "Closed"}) {
+		t.Error("expected the status not to match")
+	}
+	if match(table.Row{"Status is open", "Closed"}) {
+		t.Error("expected fields to match their column only")
+	}
+}
+
+func TestListFilter(t *testing.T) {
+	ranks := ListFilter("go NOT test", []string{"go build", "go test", "make"})
+	if len(ranks) != 1 || ranks[0].Index != 0 {
+		t.Fatalf("expected only the first item to match, got %v", ranks)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	m := New(WithFields(
+		Field{Name: "status", Validate: func(v string) error {
+			if v != "open" && v != "closed" {
+				return errors.New("expected open or closed")
+			}
+			return nil
+		}},
+		Field{Name: "title"},
+	))
+	m.Focus()
+
+	m = bubbletest.TypeString(m, "status:done bug").(Model)
+	if m.Err() == nil {
+		t.Fatal("expected an invalid status")
+	}
+	if !m.Query().MatchText("bug") {
+		t.Error("expected the invalid field to be left out of the query")
+	}
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter}); cmd != nil {
+		t.Error("expected an invalid query not to be submitted")
+	}
+
+	m.SetValue("owner:me")
+	if m.Err() == nil {
+		t.Fatal("expected an unknown field")
+	}
+
+	m.SetValue("status:open bug")
+	if err := m.Err(); err != nil {
+		t.Fatalf("expected a valid query, got %v", err)
+	}
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	msg, ok := cmd().(SubmittedMsg)
+	if !ok || msg.ID != m.ID() || msg.Text != "status:open bug" || msg.Query.Empty() {
+		t.Fatalf("expected the query to be submitted, got %#v", msg)
+	}
+}
+
+func TestComplete(t *testing.T) {
+	m := New(WithFields(
+		Field{Name: "status", Values: []string{"open", "closed", "in review"}},
+		Field{Name: "state"},
+		Field{Name: "title"},
+	))
+
+	if got, exp := m.complete("bug st"), []string{"bug state:", "bug status:"}; !reflect.DeepEqual(got, exp) {
+		t.Errorf("expected field completions %q, got %q", exp, got)
+	}
+	if got, exp := m.complete("status:"), []string{`status:"in review"`, "status:closed", "status:open"}; !reflect.DeepEqual(got, exp) {
+		t.Errorf("expected value completions %q, got %q", exp, got)
+	}
+	if got, exp := m.complete("status:o"), []string{"status:open"}; !reflect.DeepEqual(got, exp) {
+		t.Errorf("expected value completions %q, got %q", exp, got)
+	}
+	if got := m.complete("owner:"); got != nil {
+		t.Errorf("expected no completions for an unknown field, got %q", got)
+	}
+}
+
+func TestView(t *testing.T) {
+	m := New(WithFields(Field{Name: "status"}))
+	m.Focus()
+	m = bubbletest.TypeString(m, "owner:me").(Model)
+
+	view := m.View()
+	if !strings.Contains(view, `owner:me: unknown field "owner"`) {
+		t.Errorf("expected the error below the input, got %q", view)
+	}
+}
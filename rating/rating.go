@@ -0,0 +1,270 @@
+// Package rating provides a star rating input, such as ★★★☆☆. The rating is
+// changed with the arrow keys or by typing its number, optionally in half
+// steps, and an event.EditedMsg is sent when it changes.
+package rating
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/event"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/route"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// KeyMap defines the keybindings that add or remove a star and clear the
+// rating. It satisfies the help.KeyMap interface, so the bindings can be
+// listed with the help bubble.
+type KeyMap struct {
+	Increase key.Binding
+	Decrease key.Binding
+	Clear    key.Binding
+}
+
+// DefaultKeyMap returns a default set of keybindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Increase: key.NewBinding(
+			key.WithKeys("right", "l", "+"),
+			key.WithHelp("→/l", "more"),
+		),
+		Decrease: key.NewBinding(
+			key.WithKeys("left", "h", "-"),
+			key.WithHelp("←/h", "less"),
+		),
+		Clear: key.NewBinding(
+			key.WithKeys("backspace", "delete"),
+			key.WithHelp("backspace", "clear"),
+		),
+	}
+}
+
+// ShortHelp implements the KeyMap interface.
+func (km KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{km.Decrease, km.Increase, km.Clear}
+}
+
+// FullHelp implements the KeyMap interface.
+func (km KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{km.ShortHelp()}
+}
+
+// Styles contains the styles used to render the rating. The glyphs are the
+// styles' strings.
+type Styles struct {
+	Full  lipgloss.Style
+	Half  lipgloss.Style
+	Empty lipgloss.Style
+
+	// Focused is applied to the stars while the rating is focused, on top of
+	// the other styles.
+	Focused lipgloss.Style
+}
+
+// DefaultStyles returns a set of default style definitions for the rating.
+func DefaultStyles() Styles {
+	return Styles{
+		Full:    lipgloss.NewStyle().Foreground(lipgloss.Color("220")).SetString("★"),
+		Half:    lipgloss.NewStyle().Foreground(lipgloss.Color("220")).SetString("⯪"),
+		Empty:   lipgloss.NewStyle().Foreground(lipgloss.Color("240")).SetString("☆"),
+		Focused: lipgloss.NewStyle().Bold(true),
+	}
+}
+
+// Model is the rating input.
+type Model struct {
+	KeyMap KeyMap
+	Styles Styles
+
+	// Max is the number of stars.
+	Max int
+
+	// HalfSteps lets the rating be changed in half stars.
+	HalfSteps bool
+
+	// Gap is the number of columns between stars.
+	Gap int
+
+	id    int
+	value float64
+	focus bool
+}
+
+// Option is used to set options in New.
+type Option func(*Model)
+
+// New creates a new rating input of five stars.
+func New(opts ...Option) Model {
+	m := Model{
+		KeyMap: DefaultKeyMap(),
+		Styles: DefaultStyles(),
+		Max:    5,
+		id:     route.NextID(),
+	}
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
+}
+
+// WithMax sets the number of stars.
+func WithMax(n int) Option {
+	return func(m *Model) {
+		m.Max = n
+	}
+}
+
+// WithHalfSteps lets the rating be changed in half stars.
+func WithHalfSteps() Option {
+	return func(m *Model) {
+		m.HalfSteps = true
+	}
+}
+
+// WithGlyphs sets the glyphs of full, half and empty stars.
+func WithGlyphs(full, half, empty string) Option {
+	return func(m *Model) {
+		m.Styles.Full = m.Styles.Full.Copy().SetString(full)
+		m.Styles.Half = m.Styles.Half.Copy().SetString(half)
+		m.Styles.Empty = m.Styles.Empty.Copy().SetString(empty)
+	}
+}
+
+// WithStyles sets the styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) {
+		m.Styles = s
+	}
+}
+
+// WithKeyMap sets the key map.
+func WithKeyMap(km KeyMap) Option {
+	return func(m *Model) {
+		m.KeyMap = km
+	}
+}
+
+// ID returns the rating's unique ID.
+func (m Model) ID() int {
+	return m.id
+}
+
+// Value returns the rating.
+func (m Model) Value() float64 {
+	return m.value
+}
+
+// SetValue sets the rating, rounded to a whole star, or to a half star with
+// HalfSteps.
+func (m *Model) SetValue(v float64) {
+	if m.HalfSteps {
+		v = float64(int(v*2+0.5)) / 2
+	} else {
+		v = float64(int(v + 0.5))
+	}
+	if v < 0 {
+		v = 0
+	}
+	if v > float64(m.Max) {
+		v = float64(m.Max)
+	}
+	m.value = v
+}
+
+// Focus focuses the rating, so that it handles key presses.
+func (m *Model) Focus() {
+	m.focus = true
+}
+
+// Blur blurs the rating.
+func (m *Model) Blur() {
+	m.focus = false
+}
+
+// Focused returns whether the rating is focused.
+func (m Model) Focused() bool {
+	return m.focus
+}
+
+// step returns the amount the keys change the rating by.
+func (m Model) step() float64 {
+	if m.HalfSteps {
+		return 0.5
+	}
+	return 1
+}
+
+// Update handles key presses while the rating is focused. Typing a number
+// sets the rating to it, and typing it again while it's set takes half a
+// star off with HalfSteps.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.focus {
+		return m, nil
+	}
+	msg, ok := route.Accept(m.id, msg)
+	if !ok {
+		return m, nil
+	}
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	old := m.value
+	switch {
+	case key.Matches(keyMsg, m.KeyMap.Increase):
+		m.SetValue(m.value + m.step())
+	case key.Matches(keyMsg, m.KeyMap.Decrease):
+		m.SetValue(m.value - m.step())
+	case key.Matches(keyMsg, m.KeyMap.Clear):
+		m.SetValue(0)
+	case keyMsg.Type == tea.KeyRunes && len(keyMsg.Runes) == 1 && keyMsg.Runes[0] >= '0' && keyMsg.Runes[0] <= '9':
+		n := float64(keyMsg.Runes[0] - '0')
+		if n > float64(m.Max) {
+			return m, nil
+		}
+		if m.HalfSteps && n > 0 && m.value == n {
+			n -= 0.5
+		}
+		m.SetValue(n)
+	default:
+		return m, nil
+	}
+
+	if m.value == old {
+		return m, nil
+	}
+	return m, event.Cmd(event.EditedMsg{
+		ID:    m.id,
+		Old:   format(old),
+		Value: format(m.value),
+	})
+}
+
+// View renders the stars.
+func (m Model) View() string {
+	stars := make([]string, m.Max)
+	for i := range stars {
+		style := m.Styles.Empty
+		switch {
+		case m.value >= float64(i+1):
+			style = m.Styles.Full
+		case m.value > float64(i):
+			style = m.Styles.Half
+		}
+		if m.focus {
+			style = style.Copy().Inherit(m.Styles.Focused)
+		}
+		stars[i] = style.String()
+	}
+	return strings.Join(stars, strings.Repeat(" ", m.Gap))
+}
+
+// format formats a rating for an event.EditedMsg, such as "3" or "3.5".
+func format(v float64) string {
+	return strconv.FormatFloat(v, 'f', -1, 64)
+}
@@ -0,0 +1,49 @@
+package rating
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/event"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestRating(t *testing.T) {
+	m := New(WithHalfSteps(), WithGlyphs("*", "+", "."))
+	m.Styles.Focused = m.Styles.Focused.UnsetBold()
+	m.Focus()
+
+	m, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'3'}})
+	msg, ok := cmd().(event.EditedMsg)
+	if !ok || msg.Old != "0" || msg.Value != "3" || msg.ID != m.ID() {
+		t.Fatalf("expected the rating to change to 3, got %#v", msg)
+	}
+
+	// Typing the number again takes half a star off.
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'3'}})
+	if m.Value() != 2.5 {
+		t.Fatalf("expected a rating of 2.5, got %v", m.Value())
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRight})
+	if m.Value() != 3.5 {
+		t.Fatalf("expected a rating of 3.5, got %v", m.Value())
+	}
+
+	m.Styles = Styles{
+		Full:  m.Styles.Full.Copy().UnsetForeground(),
+		Half:  m.Styles.Half.Copy().UnsetForeground(),
+		Empty: m.Styles.Empty.Copy().UnsetForeground(),
+	}
+	if exp, got := "***+.", m.View(); exp != got {
+		t.Errorf("expected view %q, got %q", exp, got)
+	}
+
+	// Numbers above the maximum and changes past the bounds are ignored.
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'9'}}); cmd != nil {
+		t.Error("expected 9 to be ignored")
+	}
+	m.SetValue(5)
+	if _, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRight}); cmd != nil {
+		t.Error("expected no change past the maximum")
+	}
+}
@@ -0,0 +1,70 @@
+// Package route helps applications with several instances of the same bubble,
+// such as two tables side by side, send messages to just one of them.
+//
+// Components that support routing get a unique ID from NextID, tag the
+// messages they send with it and pass incoming messages through Accept.
+// Parent models can then address any message, key presses included, to a
+// single component by wrapping it with To:
+//
+//	// Only the left table moves, even though both receive the message.
+//	msg = route.To(m.left.ID(), msg)
+//	m.left, cmd = m.left.Update(msg)
+//	m.right, cmd = m.right.Update(msg)
+//
+// Messages that aren't wrapped are delivered to every component, as usual.
+package route
+
+import (
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var (
+	lastID int
+	idMtx  sync.Mutex
+)
+
+// NextID returns a new component ID. IDs are unique across all components
+// that get theirs from this function, regardless of their type.
+func NextID() int {
+	idMtx.Lock()
+	defer idMtx.Unlock()
+	lastID++
+	return lastID
+}
+
+// Msg is a message addressed to a single component.
+type Msg struct {
+	// ID is the ID of the component the message is for.
+	ID int
+
+	// Msg is the wrapped message.
+	Msg tea.Msg
+}
+
+// To addresses a message to the component with the given ID.
+func To(id int, msg tea.Msg) tea.Msg {
+	return Msg{ID: id, Msg: msg}
+}
+
+// Cmd returns a command that sends msg to the component with the given ID.
+func Cmd(id int, msg tea.Msg) tea.Cmd {
+	return func() tea.Msg {
+		return To(id, msg)
+	}
+}
+
+// Accept unwraps messages addressed with To. It returns the message that the
+// component with the given ID should handle and whether it should handle it
+// at all: messages addressed to other components are rejected, and messages
+// that aren't addressed are passed through unchanged.
+func Accept(id int, msg tea.Msg) (tea.Msg, bool) {
+	if r, ok := msg.(Msg); ok {
+		if r.ID != id {
+			return nil, false
+		}
+		return r.Msg, true
+	}
+	return msg, true
+}
@@ -0,0 +1,27 @@
+package route
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestAccept(t *testing.T) {
+	a, b := NextID(), NextID()
+	if a == b {
+		t.Fatal("expected unique IDs")
+	}
+
+	size := tea.WindowSizeMsg{Width: 80, Height: 24}
+	msg := To(a, size)
+
+	if got, ok := Accept(a, msg); !ok || got != size {
+		t.Fatalf("expected component %d to accept the unwrapped message, got %v, %t", a, got, ok)
+	}
+	if _, ok := Accept(b, msg); ok {
+		t.Fatalf("expected component %d to reject a message addressed to %d", b, a)
+	}
+	if got, ok := Accept(b, size); !ok || got != size {
+		t.Fatal("expected unaddressed messages to pass through")
+	}
+}
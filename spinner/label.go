@@ -0,0 +1,65 @@
+package spinner
+
+import (
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// DefaultElapsedStyle is the default style of the elapsed time.
+var DefaultElapsedStyle = lipgloss.NewStyle().Foreground(lipgloss.Color("240"))
+
+// WithLabel is an option to set the text rendered after the spinner.
+func WithLabel(label string) Option {
+	return func(m *Model) {
+		m.Label = label
+	}
+}
+
+// WithElapsed is an option to render the time elapsed since the spinner
+// started after it, such as "Building… 12s".
+func WithElapsed() Option {
+	return func(m *Model) {
+		m.ShowElapsed = true
+	}
+}
+
+// Elapsed returns the time elapsed between the first tick the spinner
+// received and the last one.
+func (m Model) Elapsed() time.Duration {
+	if m.started.IsZero() {
+		return 0
+	}
+	return m.lastTick.Sub(m.started)
+}
+
+// ResetElapsed restarts counting the elapsed time at the next tick.
+func (m *Model) ResetElapsed() {
+	m.started = time.Time{}
+	m.lastTick = time.Time{}
+}
+
+// track records the time of a tick for the elapsed time.
+func (m *Model) track(t time.Time) {
+	if m.started.IsZero() {
+		m.started = t
+	}
+	m.lastTick = t
+}
+
+// suffixView renders the label and the elapsed time.
+func (m Model) suffixView() string {
+	var parts []string
+	if m.Label != "" {
+		parts = append(parts, m.LabelStyle.Render(m.Label))
+	}
+	if m.ShowElapsed {
+		elapsed := m.Elapsed().Truncate(time.Second).String()
+		parts = append(parts, m.ElapsedStyle.Render(elapsed))
+	}
+	if len(parts) == 0 {
+		return ""
+	}
+	return " " + strings.Join(parts, " ")
+}
@@ -4,6 +4,7 @@ import (
 	"sync"
 	"time"
 
+	"github.com/charmbracelet/bubbles/accessibility"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -98,6 +99,17 @@ type Model struct {
 	// https://github.com/charmbracelet/lipgloss
 	Style lipgloss.Style
 
+	// Label is rendered after the spinner, followed by the elapsed time if
+	// ShowElapsed is set.
+	Label        string
+	LabelStyle   lipgloss.Style
+	ShowElapsed  bool
+	ElapsedStyle lipgloss.Style
+
+	// The times of the first and the last tick, for the elapsed time.
+	started  time.Time
+	lastTick time.Time
+
 	frame int
 	id    int
 	tag   int
@@ -111,8 +123,9 @@ func (m Model) ID() int {
 // New returns a model with default values.
 func New(opts ...Option) Model {
 	m := Model{
-		Spinner: Line,
-		id:      nextID(),
+		Spinner:      Line,
+		ElapsedStyle: DefaultElapsedStyle,
+		id:           nextID(),
 	}
 
 	for _, opt := range opts {
@@ -151,6 +164,7 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			return m, nil
 		}
 
+		m.track(msg.Time)
 		m.frame++
 		if m.frame >= len(m.Spinner.Frames) {
 			m.frame = 0
@@ -158,6 +172,10 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
 		m.tag++
 		return m, m.tick(m.id, m.tag)
+	case SharedTickMsg:
+		m.track(msg.Time)
+		m.frame = m.frameAt(msg.Time)
+		return m, nil
 	default:
 		return m, nil
 	}
@@ -169,7 +187,13 @@ func (m Model) View() string {
 		return "(error)"
 	}
 
-	return m.Style.Render(m.Spinner.Frames[m.frame])
+	// With reduced motion the spinner stays on its first frame, but keeps
+	// ticking to update the elapsed time.
+	frame := m.frame
+	if accessibility.ReducedMotion() {
+		frame = 0
+	}
+	return m.Style.Render(m.Spinner.Frames[frame]) + m.suffixView()
 }
 
 // Tick is the command used to advance the spinner one frame. Use this command
@@ -2,8 +2,12 @@ package spinner_test
 
 import (
 	"testing"
+	"time"
 
+	"github.com/charmbracelet/bubbles/accessibility"
 	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
 )
 
 func TestSpinnerNew(t *testing.T) {
@@ -59,3 +63,56 @@ func TestSpinnerNew(t *testing.T) {
 		})
 	}
 }
+
+func TestTicker(t *testing.T) {
+	ticker := spinner.NewTicker(spinner.MiniDot.FPS)
+	spinners := []spinner.Model{
+		spinner.New(spinner.WithSpinner(spinner.MiniDot)),
+		spinner.New(spinner.WithSpinner(spinner.Dot)),
+	}
+
+	msg := ticker.Tick()
+	ticker, cmd := ticker.Update(msg)
+	if cmd == nil {
+		t.Fatal("expecting the ticker to schedule the next tick")
+	}
+	for i, s := range spinners {
+		var cmd tea.Cmd
+		spinners[i], cmd = s.Update(msg)
+		if cmd != nil {
+			t.Errorf("expecting spinner %d not to schedule its own tick", i)
+		}
+	}
+
+	// Ticks of another ticker are ignored.
+	if _, cmd := ticker.Update(spinner.NewTicker(time.Second).Tick()); cmd != nil {
+		t.Error("expecting the ticker to ignore ticks of other tickers")
+	}
+}
+
+func TestSpinnerLabel(t *testing.T) {
+	s := spinner.New(spinner.WithLabel("Building…"), spinner.WithElapsed())
+	s.ElapsedStyle = lipgloss.NewStyle()
+
+	start := time.Now()
+	s, _ = s.Update(spinner.TickMsg{Time: start, ID: s.ID()})
+	s, _ = s.Update(spinner.TickMsg{Time: start.Add(12500 * time.Millisecond), ID: s.ID()})
+
+	if s.Elapsed() != 12500*time.Millisecond {
+		t.Errorf("expecting 12.5s elapsed, got %s", s.Elapsed())
+	}
+	if exp, got := "- Building… 12s", s.View(); exp != got {
+		t.Errorf("expecting view %q, got %q", exp, got)
+	}
+}
+
+func TestSpinnerReducedMotion(t *testing.T) {
+	accessibility.SetReducedMotion(true)
+	defer accessibility.SetReducedMotion(false)
+
+	s := spinner.New()
+	s, _ = s.Update(s.Tick())
+	if got := s.View(); got != spinner.Line.Frames[0] {
+		t.Errorf("expecting the first frame with reduced motion, got %q", got)
+	}
+}
@@ -0,0 +1,81 @@
+package spinner
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// SharedTickMsg indicates that a Ticker has ticked and every spinner it drives
+// should render the frame for the time of the tick.
+type SharedTickMsg struct {
+	Time time.Time
+	ID   int
+	tag  int
+}
+
+// Ticker drives any number of spinners from a single stream of ticks, so a
+// program with many spinners, such as one per row of a list, handles one
+// message per tick instead of one per spinner.
+//
+// Start the ticker with its Tick method instead of starting the spinners, and
+// pass every SharedTickMsg to the ticker's Update function and to the
+// spinners'. Spinners render the frame for the time of the tick, so spinners
+// with different FPS can share a ticker that ticks at the fastest of them.
+type Ticker struct {
+	// Interval is the time between ticks.
+	Interval time.Duration
+
+	id  int
+	tag int
+}
+
+// NewTicker returns a ticker that ticks at the given interval.
+func NewTicker(interval time.Duration) Ticker {
+	return Ticker{
+		Interval: interval,
+		id:       nextID(),
+	}
+}
+
+// ID returns the ticker's unique ID.
+func (t Ticker) ID() int {
+	return t.id
+}
+
+// Tick is the command used to start the ticker.
+func (t Ticker) Tick() tea.Msg {
+	return SharedTickMsg{
+		Time: time.Now(),
+		ID:   t.id,
+		tag:  t.tag,
+	}
+}
+
+// Update schedules the next tick.
+func (t Ticker) Update(msg tea.Msg) (Ticker, tea.Cmd) {
+	tick, ok := msg.(SharedTickMsg)
+	if !ok || tick.ID != t.id {
+		return t, nil
+	}
+
+	// Reject ticks of streams started before the last one, so starting the
+	// ticker twice doesn't make it tick twice as often.
+	if tick.tag > 0 && tick.tag != t.tag {
+		return t, nil
+	}
+
+	t.tag++
+	id, tag := t.id, t.tag
+	return t, tea.Tick(t.Interval, func(now time.Time) tea.Msg {
+		return SharedTickMsg{Time: now, ID: id, tag: tag}
+	})
+}
+
+// frameAt returns the frame of the spinner at a given time.
+func (m Model) frameAt(t time.Time) int {
+	if m.Spinner.FPS <= 0 || len(m.Spinner.Frames) == 0 {
+		return 0
+	}
+	return int(t.UnixNano() / int64(m.Spinner.FPS) % int64(len(m.Spinner.Frames)))
+}
@@ -0,0 +1,65 @@
+// Package state saves and restores the state of bubbles, such as the cursor
+// and the sorting of a table or the filter of a list, so applications can
+// restore their UI across sessions.
+//
+// Bubbles that have state worth keeping implement Stateful. Save and Load
+// keep the states of several of them in a single document:
+//
+//	components := map[string]state.Stateful{
+//	    "files":  &m.files,
+//	    "editor": &m.editor,
+//	}
+//	data, err := state.Save(components)
+//	// Write data to a file, and in the next session:
+//	err = state.Load(data, components)
+package state
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Stateful is a bubble whose state can be saved and restored. Pass pointers
+// to your models so their state can be restored.
+type Stateful interface {
+	// MarshalState returns the bubble's state.
+	MarshalState() ([]byte, error)
+
+	// UnmarshalState restores a state returned by MarshalState. Parts of the
+	// state that don't fit the bubble anymore, such as a cursor past the
+	// last row, are adjusted or ignored.
+	UnmarshalState(data []byte) error
+}
+
+// Save returns the states of the given components, by name, as a JSON
+// object.
+func Save(components map[string]Stateful) ([]byte, error) {
+	states := make(map[string]json.RawMessage, len(components))
+	for name, c := range components {
+		data, err := c.MarshalState()
+		if err != nil {
+			return nil, fmt.Errorf("saving %s: %w", name, err)
+		}
+		states[name] = data
+	}
+	return json.Marshal(states)
+}
+
+// Load restores the states returned by Save to the components of the same
+// names. Components without a saved state are left as they are.
+func Load(data []byte, components map[string]Stateful) error {
+	var states map[string]json.RawMessage
+	if err := json.Unmarshal(data, &states); err != nil {
+		return err
+	}
+	for name, c := range components {
+		s, ok := states[name]
+		if !ok {
+			continue
+		}
+		if err := c.UnmarshalState(s); err != nil {
+			return fmt.Errorf("loading %s: %w", name, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,47 @@
+package state
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+type counter struct {
+	n   int
+	err error
+}
+
+func (c *counter) MarshalState() ([]byte, error) {
+	return []byte{byte('0' + c.n)}, c.err
+}
+
+func (c *counter) UnmarshalState(data []byte) error {
+	c.n = int(data[0] - '0')
+	return nil
+}
+
+func TestSaveLoad(t *testing.T) {
+	a, b := &counter{n: 3}, &counter{n: 7}
+	data, err := Save(map[string]Stateful{"a": a, "b": b})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	ra, rb, rc := &counter{}, &counter{}, &counter{n: 5}
+	if err := Load(data, map[string]Stateful{"a": ra, "b": rb, "c": rc}); err != nil {
+		t.Fatal(err)
+	}
+	if ra.n != 3 || rb.n != 7 {
+		t.Errorf("expected the states to be restored, got %d and %d", ra.n, rb.n)
+	}
+	if rc.n != 5 {
+		t.Errorf("expected a component without a saved state to be left alone, got %d", rc.n)
+	}
+}
+
+func TestSaveError(t *testing.T) {
+	_, err := Save(map[string]Stateful{"broken": &counter{err: errors.New("boom")}})
+	if err == nil || !strings.Contains(err.Error(), "saving broken: boom") {
+		t.Fatalf("expected the error to name the component, got %v", err)
+	}
+}
@@ -0,0 +1,67 @@
+package stopwatch
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Snapshot is the state of a stopwatch, which can be saved, such as encoded to
+// JSON, and restored with Restore to keep timing across program restarts.
+type Snapshot struct {
+	// Elapsed is the time the stopwatch was running.
+	Elapsed time.Duration `json:"elapsed"`
+
+	// StartedAt is when the stopwatch was first started, if it was.
+	StartedAt time.Time `json:"started_at"`
+
+	// Running is whether the stopwatch was running when the snapshot was
+	// taken, at SavedAt.
+	Running bool      `json:"running"`
+	SavedAt time.Time `json:"saved_at"`
+}
+
+// Snapshot returns the state of the stopwatch.
+func (m Model) Snapshot() Snapshot {
+	return Snapshot{
+		Elapsed:   m.d,
+		StartedAt: m.startedAt,
+		Running:   m.running,
+		SavedAt:   time.Now(),
+	}
+}
+
+// Restore restores the state of a stopwatch from a snapshot. A stopwatch that
+// was running when the snapshot was taken kept running in the meantime, like
+// a real stopwatch would: the time since is added to the elapsed time and
+// the returned command starts it again.
+func (m *Model) Restore(s Snapshot) tea.Cmd {
+	m.d = s.Elapsed
+	m.startedAt = s.StartedAt
+	m.running = false
+	if !s.Running {
+		return nil
+	}
+	if !s.SavedAt.IsZero() {
+		m.d += time.Since(s.SavedAt)
+	}
+	return m.Start()
+}
+
+// WallTime returns the time since the stopwatch was first started, including
+// the time it was stopped, as opposed to Elapsed.
+func (m Model) WallTime() time.Duration {
+	if m.startedAt.IsZero() {
+		return 0
+	}
+	return time.Since(m.startedAt)
+}
+
+// PausedTime returns the time the stopwatch was stopped since it was first
+// started.
+func (m Model) PausedTime() time.Duration {
+	if paused := m.WallTime() - m.d; paused > 0 {
+		return paused
+	}
+	return 0
+}
@@ -0,0 +1,52 @@
+package stopwatch
+
+import (
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/bubbletest"
+)
+
+func TestRestoreRunning(t *testing.T) {
+	started := time.Now().Add(-time.Minute)
+	s := Snapshot{
+		Elapsed:   5 * time.Second,
+		StartedAt: started,
+		Running:   true,
+		SavedAt:   time.Now().Add(-2 * time.Second),
+	}
+
+	m := NewWithInterval(time.Millisecond)
+	cmd := m.Restore(s)
+	if cmd == nil {
+		t.Fatal("expected a command to start the stopwatch")
+	}
+	// The time since the snapshot was taken counts as running.
+	if m.Elapsed() < 7*time.Second || m.Elapsed() > 8*time.Second {
+		t.Fatalf("expected about 7s elapsed, got %s", m.Elapsed())
+	}
+
+	for _, msg := range bubbletest.Collect(cmd) {
+		m, _ = m.Update(msg)
+	}
+	if !m.Running() {
+		t.Fatal("expected the stopwatch to run again")
+	}
+	if w := m.WallTime(); w < time.Minute {
+		t.Fatalf("expected the wall time to count from the original start, got %s", w)
+	}
+}
+
+func TestRestoreStopped(t *testing.T) {
+	m := New()
+	if cmd := m.Restore(Snapshot{Elapsed: 5 * time.Second, SavedAt: time.Now().Add(-time.Hour)}); cmd != nil {
+		t.Fatal("expected no command for a stopped snapshot")
+	}
+	if m.Running() || m.Elapsed() != 5*time.Second {
+		t.Fatalf("expected a stopped stopwatch at 5s, got %s", m.Elapsed())
+	}
+
+	if s := m.Snapshot(); s.Running || s.Elapsed != 5*time.Second {
+		t.Fatalf("expected the snapshot to round trip, got %+v", s)
+	}
+}
@@ -49,6 +49,9 @@ type Model struct {
 	id      int
 	running bool
 
+	// When the stopwatch was first started, for the wall time.
+	startedAt time.Time
+
 	// How long to wait before every tick. Defaults to 1 second.
 	Interval time.Duration
 }
@@ -84,7 +87,8 @@ func (m Model) Start() tea.Cmd {
 	}, tick(m.id, m.Interval))
 }
 
-// Stop stops the stopwatch.
+// Stop stops, or pauses, the stopwatch. The time it's stopped doesn't count
+// towards the elapsed time, but does towards the wall time.
 func (m Model) Stop() tea.Cmd {
 	return func() tea.Msg {
 		return StartStopMsg{ID: m.id, running: false}
@@ -119,11 +123,18 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			return m, nil
 		}
 		m.running = msg.running
+		if m.running && m.startedAt.IsZero() {
+			m.startedAt = time.Now()
+		}
 	case ResetMsg:
 		if msg.ID != m.id {
 			return m, nil
 		}
 		m.d = 0
+		m.startedAt = time.Time{}
+		if m.running {
+			m.startedAt = time.Now()
+		}
 	case TickMsg:
 		if !m.running || msg.ID != m.id {
 			break
@@ -135,7 +146,7 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	return m, nil
 }
 
-// Elapsed returns the time elapsed.
+// Elapsed returns the time elapsed while the stopwatch was running.
 func (m Model) Elapsed() time.Duration {
 	return m.d
 }
@@ -0,0 +1,290 @@
+package table
+
+import (
+	"github.com/charmbracelet/bubbles/confirm"
+	"github.com/charmbracelet/bubbles/event"
+	"github.com/charmbracelet/bubbles/i18n"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Action is something the user can do with a key press. Every action has a
+// keybinding in the KeyMap, and can also be performed with Dispatch.
+type Action int
+
+// Available actions.
+const (
+	// ActionActivate activates the selected row, sending an
+	// event.ActivatedMsg with the row's index in the table's rows.
+	ActionActivate Action = iota
+
+	// ActionDeleteRow removes the selected row from the table, sending a
+	// RowDeletedMsg. Its keybinding is disabled by default, and it doesn't
+	// apply while the table is pivoted.
+	ActionDeleteRow
+
+	// Actions that move the cursor.
+	ActionLineUp
+	ActionLineDown
+	ActionPageUp
+	ActionPageDown
+	ActionHalfPageUp
+	ActionHalfPageDown
+	ActionGotoTop
+	ActionGotoBottom
+
+	// ActionCellLeft and ActionCellRight move between cells in cell
+	// selection mode.
+	ActionCellLeft
+	ActionCellRight
+
+	// ActionSwapPane makes the other pane active in split mode.
+	ActionSwapPane
+
+	// ActionShowCell shows the selected cell's value in a popup.
+	ActionShowCell
+
+	// ActionToggleStats shows or hides the selected column's statistics.
+	ActionToggleStats
+
+	// ActionNextDuplicate moves to the next duplicate row when duplicates
+	// are highlighted.
+	ActionNextDuplicate
+
+	// ActionTogglePivot switches between the summary and the raw rows when a
+	// pivot is configured.
+	ActionTogglePivot
+
+	// ActionToggleMark marks or unmarks the selected row when
+	// multi-selection is enabled.
+	ActionToggleMark
+
+	// ActionFilterColumn opens the quick filter prompt for the selected
+	// column.
+	ActionFilterColumn
+
+	// ActionClearFilters removes all column filters.
+	ActionClearFilters
+
+	// ActionNextFilterPreset applies the next filter preset when filter
+	// presets are configured.
+	ActionNextFilterPreset
+
+	// ActionEditCell edits the selected cell and ActionAddRow adds a row
+	// below the selected one when editing is enabled.
+	ActionEditCell
+	ActionAddRow
+
+	// Actions that extend the selected range of cells in cell selection
+	// mode.
+	ActionSelectUp
+	ActionSelectDown
+	ActionSelectLeft
+	ActionSelectRight
+
+	// ActionCopy copies the selected range, cell or row to the clipboard.
+	ActionCopy
+
+	// ActionRefresh refreshes the rows and ActionToggleAutoRefresh turns
+	// auto-refresh on or off when refreshing is configured.
+	ActionRefresh
+	ActionToggleAutoRefresh
+)
+
+// RowDeletedMsg is sent when the user has deleted a row.
+type RowDeletedMsg struct {
+	// ID is the ID of the table the row was deleted from.
+	ID int
+
+	// Index is the index the row had in the table's rows.
+	Index int
+
+	// Row is the deleted row.
+	Row Row
+}
+
+// Confirmation configures how an action is confirmed before it's performed.
+type Confirmation struct {
+	// Prompt is the question asked, or the hint shown if Repeat is set.
+	Prompt string
+
+	// Repeat asks the user to press the action's key a second time instead
+	// of showing a yes/no prompt. Any other key cancels the action.
+	Repeat bool
+}
+
+// WithConfirm asks the user to confirm the given action before it's
+// performed, for example:
+//
+//	table.New(table.WithConfirm(table.ActionDeleteRow, table.Confirmation{
+//	    Prompt: "Delete this row?",
+//	}))
+func WithConfirm(a Action, c Confirmation) Option {
+	return func(m *Model) {
+		m.SetConfirm(a, c)
+	}
+}
+
+// WithConfirmPrompt sets the prompt used to confirm actions, for example to
+// change its styles or labels.
+func WithConfirmPrompt(p confirm.Model) Option {
+	return func(m *Model) {
+		m.confirm = p
+	}
+}
+
+// SetConfirm asks the user to confirm the given action before it's
+// performed.
+func (m *Model) SetConfirm(a Action, c Confirmation) {
+	if m.confirmations == nil {
+		m.confirmations = make(map[Action]Confirmation)
+	}
+	m.confirmations[a] = c
+}
+
+// RemoveConfirm performs the given action without asking for confirmation.
+func (m *Model) RemoveConfirm(a Action) {
+	delete(m.confirmations, a)
+}
+
+// Confirming returns whether the table is waiting for the user to confirm an
+// action.
+func (m Model) Confirming() bool {
+	return m.pending != nil
+}
+
+// RemoveRow removes the row at the given index in the table's rows.
+func (m *Model) RemoveRow(i int) {
+	if i < 0 || i >= len(m.rows) {
+		return
+	}
+	m.rows = append(m.rows[:i:i], m.rows[i+1:]...)
+
+	m.disabled = removeIndex(m.disabled, i)
+	m.marked = removeIndex(m.marked, i)
+
+	m.recalc()
+	m.findDuplicates()
+	m.reorder()
+	m.cursor = clamp(m.cursor, 0, len(m.order)-1)
+	m.skipDisabled(1)
+	m.UpdateViewport()
+}
+
+// removeIndex returns a set of row indices without the given index, shifting
+// the indices after it down by one.
+func removeIndex(set map[int]bool, i int) map[int]bool {
+	if len(set) == 0 {
+		return set
+	}
+	shifted := make(map[int]bool, len(set))
+	for j := range set {
+		switch {
+		case j < i:
+			shifted[j] = true
+		case j > i:
+			shifted[j-1] = true
+		}
+	}
+	return shifted
+}
+
+// trigger performs an action on the selected row, or asks for confirmation
+// first if the action requires it.
+func (m *Model) trigger(a Action) tea.Cmd {
+	if m.cursor < 0 || m.cursor >= len(m.order) || m.rowDisabled(m.cursor) {
+		return nil
+	}
+
+	c, ok := m.confirmations[a]
+	if !ok {
+		return m.perform(a)
+	}
+
+	m.pending = &pendingAction{action: a, repeat: c.Repeat, prompt: c.Prompt}
+	if !c.Repeat {
+		prompt := c.Prompt
+		if prompt == "" {
+			prompt = i18n.Text("Are you sure?")
+		}
+		m.confirm.Ask(prompt)
+	}
+	return nil
+}
+
+// handleConfirm handles messages while an action waits for confirmation. It
+// returns whether the message was consumed.
+func (m *Model) handleConfirm(msg tea.Msg) (bool, tea.Cmd) {
+	if res, ok := msg.(confirm.ResultMsg); ok && res.ID == m.confirm.ID() {
+		if m.pending == nil {
+			return true, nil
+		}
+		a := m.pending.action
+		m.pending = nil
+		if res.Confirmed {
+			return true, m.perform(a)
+		}
+		return true, nil
+	}
+
+	if m.pending == nil {
+		return false, nil
+	}
+
+	if !m.pending.repeat {
+		var cmd tea.Cmd
+		m.confirm, cmd = m.confirm.Update(msg)
+		return true, cmd
+	}
+
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return false, nil
+	}
+	a := m.pending.action
+	m.pending = nil
+	if key.Matches(keyMsg, m.binding(a)) {
+		return true, m.perform(a)
+	}
+	// Any other key cancels the action and is handled as usual.
+	return false, nil
+}
+
+// perform performs an action on the selected row.
+func (m *Model) perform(a Action) tea.Cmd {
+	if m.cursor < 0 || m.cursor >= len(m.order) {
+		return nil
+	}
+
+	switch a {
+	case ActionActivate:
+		return event.Cmd(event.ActivatedMsg{ID: m.id, Index: m.order[m.cursor]})
+	case ActionDeleteRow:
+		i := m.order[m.cursor]
+		row := m.rows[i]
+		m.RemoveRow(i)
+		return event.Cmd(RowDeletedMsg{ID: m.id, Index: i, Row: row})
+	}
+	return nil
+}
+
+// confirmView renders the confirmation prompt or hint, if any.
+func (m Model) confirmView() string {
+	switch {
+	case m.pending == nil:
+		return ""
+	case m.pending.repeat:
+		prompt := m.pending.prompt
+		if prompt == "" {
+			prompt = i18n.Format("Press %s again to confirm", m.binding(m.pending.action).Help().Key)
+		}
+		return m.confirm.Styles.Prompt.Render(prompt)
+	}
+	return m.confirm.View()
+}
+
+type pendingAction struct {
+	action Action
+	repeat bool
+	prompt string
+}
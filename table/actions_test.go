@@ -0,0 +1,118 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/bubbletest"
+	"github.com/charmbracelet/bubbles/confirm"
+	"github.com/charmbracelet/bubbles/event"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var actionFixture = []Option{
+	WithColumns([]Column{{Title: "Name", Width: 10}}),
+	WithRows([]Row{{"a"}, {"b"}, {"c"}}),
+	WithFocused(true),
+	func(m *Model) { m.KeyMap.DeleteRow.SetEnabled(true) },
+}
+
+// run updates the table with the given messages, feeding the messages of the
+// returned commands back into the table.
+func run(t *testing.T, m Model, msgs ...tea.Msg) (Model, []tea.Msg) {
+	t.Helper()
+	h := bubbletest.New(t, m)
+	h.Send(msgs...)
+	return h.Model().(Model), h.Msgs()
+}
+
+func TestDeleteRowWithoutConfirmation(t *testing.T) {
+	m, out := run(t, testTable(actionFixture), tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	if len(m.rows) != 2 {
+		t.Fatalf("expected row to be deleted, got %d rows", len(m.rows))
+	}
+	if len(out) != 1 {
+		t.Fatalf("expected one message, got %v", out)
+	}
+	if msg, ok := out[0].(RowDeletedMsg); !ok || msg.Row[0] != "a" {
+		t.Fatalf("expected row a to be deleted, got %#v", out[0])
+	}
+}
+
+func TestDeleteRowConfirmPrompt(t *testing.T) {
+	m := testTable(actionFixture, WithConfirm(ActionDeleteRow, Confirmation{Prompt: "Delete?"}))
+
+	m, _ = run(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	if !m.Confirming() || len(m.rows) != 3 {
+		t.Fatal("expected table to wait for confirmation")
+	}
+	// Movement keys go to the prompt while it's open.
+	m, _ = run(t, m, tea.KeyMsg{Type: tea.KeyDown})
+	if m.Cursor() != 0 {
+		t.Fatal("expected cursor not to move while confirming")
+	}
+
+	m, _ = run(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	if m.Confirming() || len(m.rows) != 3 {
+		t.Fatal("expected delete to be cancelled")
+	}
+
+	m, _ = run(t, m,
+		tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")},
+		tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")},
+	)
+	if len(m.rows) != 2 {
+		t.Fatal("expected row to be deleted after confirming")
+	}
+}
+
+func TestActivateConfirmRepeat(t *testing.T) {
+	m := testTable(actionFixture, WithConfirm(ActionActivate, Confirmation{Repeat: true}))
+
+	m, out := run(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	if len(out) != 0 || !m.Confirming() {
+		t.Fatal("expected activation to wait for the key to be pressed again")
+	}
+	m, out = run(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	if len(out) != 1 {
+		t.Fatalf("expected activation, got %v", out)
+	}
+	if _, ok := out[0].(event.ActivatedMsg); !ok {
+		t.Fatalf("expected activation, got %#v", out[0])
+	}
+
+	// Other keys cancel and are handled as usual.
+	m, _ = run(t, m, tea.KeyMsg{Type: tea.KeyEnter}, tea.KeyMsg{Type: tea.KeyDown})
+	if m.Confirming() || m.Cursor() != 1 {
+		t.Fatal("expected other key to cancel the activation and move the cursor")
+	}
+}
+
+func TestActivateSorted(t *testing.T) {
+	m := testTable(actionFixture, WithSort(0, Descending))
+
+	_, out := run(t, m, tea.KeyMsg{Type: tea.KeyEnter})
+	if len(out) != 1 {
+		t.Fatalf("expected activation, got %v", out)
+	}
+	if msg, ok := out[0].(event.ActivatedMsg); !ok || msg.Index != 2 {
+		t.Fatalf("expected the index of row c in the table's rows, got %#v", out[0])
+	}
+}
+
+func TestConfirmResultForOtherPrompt(t *testing.T) {
+	m := testTable(actionFixture, WithConfirm(ActionDeleteRow, Confirmation{}))
+	m, _ = run(t, m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("x")})
+	other := confirm.New()
+	m, _ = run(t, m, confirm.ResultMsg{ID: other.ID(), Confirmed: true})
+	if len(m.rows) != 3 {
+		t.Fatal("expected result of other prompt to be ignored")
+	}
+}
+
+func TestRemoveRowShiftsDisabledRows(t *testing.T) {
+	m := testTable(actionFixture, WithDisabledRows(2))
+	m.RemoveRow(0)
+	if !m.Disabled(1) || m.Disabled(2) {
+		t.Fatal("expected disabled row to move up")
+	}
+}
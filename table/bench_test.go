@@ -0,0 +1,102 @@
+package table
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+)
+
+func benchTable(rows, cols int) Model {
+	columns := make([]Column, cols)
+	for i := range columns {
+		columns[i] = Column{Title: "Column " + strconv.Itoa(i), Width: 12}
+	}
+	data := make([]Row, rows)
+	for i := range data {
+		r := make(Row, cols)
+		for j := range r {
+			r[j] = fmt.Sprintf("value %d/%d with some padding", i, j)
+		}
+		data[i] = r
+	}
+	return New(
+		WithColumns(columns),
+		WithRows(data),
+		WithHeight(40),
+		WithFocused(true),
+	)
+}
+
+var benchSizes = []struct {
+	name       string
+	rows, cols int
+}{
+	{"10x3", 10, 3},
+	{"10x20", 10, 20},
+	{"1kx3", 1000, 3},
+	{"1kx20", 1000, 20},
+	{"100kx3", 100000, 3},
+	{"100kx20", 100000, 20},
+}
+
+func BenchmarkView(b *testing.B) {
+	for _, size := range benchSizes {
+		m := benchTable(size.rows, size.cols)
+		b.Run(size.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				_ = m.View()
+			}
+		})
+	}
+}
+
+func BenchmarkMoveDown(b *testing.B) {
+	for _, size := range benchSizes {
+		m := benchTable(size.rows, size.cols)
+		b.Run(size.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if m.Cursor() == size.rows-1 {
+					m.GotoTop()
+				}
+				m.MoveDown(1)
+				_ = m.View()
+			}
+		})
+	}
+}
+
+func BenchmarkSort(b *testing.B) {
+	for _, size := range benchSizes {
+		if size.cols != 3 {
+			continue
+		}
+		m := benchTable(size.rows, size.cols)
+		m.cols[0].Compare = CompareNatural
+		b.Run(size.name, func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				order := Ascending
+				if i%2 == 1 {
+					order = Descending
+				}
+				m.SortBy(0, order)
+			}
+		})
+	}
+}
+
+func TestRenderStats(t *testing.T) {
+	var stats []Stats
+	m := benchTable(100, 3)
+	m.OnRenderStats(func(s Stats) { stats = append(stats, s) })
+	m.MoveDown(1)
+
+	if len(stats) != 1 {
+		t.Fatalf("expected stats for one render, got %d", len(stats))
+	}
+	if s := stats[0]; s.RenderedRows != 40 || s.TotalRows != 100 || s.Duration <= 0 {
+		t.Fatalf("unexpected stats %+v", s)
+	}
+}
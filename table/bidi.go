@@ -0,0 +1,66 @@
+package table
+
+import (
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Direction is the writing direction of a column's text.
+type Direction int
+
+// Available directions.
+const (
+	// LeftToRight is for scripts such as Latin, Cyrillic or CJK.
+	LeftToRight Direction = iota
+
+	// RightToLeft is for scripts such as Arabic or Hebrew.
+	RightToLeft
+
+	// AutoDirection picks the direction of each cell from its first
+	// character with a strong direction, so columns with mixed data align
+	// every value correctly.
+	AutoDirection
+)
+
+// Right-to-left cells are aligned to the right and truncated at their logical
+// end, which is the visual left. The cell text is written in logical order,
+// leaving the reordering of characters to the terminal, as terminals with
+// bidi support expect.
+
+// resolve returns the direction of the given text.
+func (d Direction) resolve(s string) Direction {
+	if d != AutoDirection {
+		return d
+	}
+	for _, r := range s {
+		switch {
+		case isRTL(r):
+			return RightToLeft
+		case unicode.IsLetter(r):
+			return LeftToRight
+		}
+	}
+	return LeftToRight
+}
+
+// align returns the horizontal alignment for text in the given direction.
+func (d Direction) align(s string) lipgloss.Position {
+	if d.resolve(s) == RightToLeft {
+		return lipgloss.Right
+	}
+	return lipgloss.Left
+}
+
+// isRTL returns whether a rune belongs to a right-to-left script.
+func isRTL(r rune) bool {
+	return unicode.In(r,
+		unicode.Hebrew,
+		unicode.Arabic,
+		unicode.Syriac,
+		unicode.Thaana,
+		unicode.Nko,
+		unicode.Samaritan,
+		unicode.Mandaic,
+	)
+}
@@ -0,0 +1,56 @@
+package table
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDirectionResolve(t *testing.T) {
+	tests := []struct {
+		s    string
+		want Direction
+	}{
+		{"hello", LeftToRight},
+		{"שלום", RightToLeft},
+		{"مرحبا", RightToLeft},
+		{"42 שלום", RightToLeft},
+		{"abc שלום", LeftToRight},
+		{"42", LeftToRight},
+		{"", LeftToRight},
+	}
+	for _, tc := range tests {
+		if got := AutoDirection.resolve(tc.s); got != tc.want {
+			t.Errorf("%q: expected direction %d, got %d", tc.s, tc.want, got)
+		}
+	}
+	if got := LeftToRight.resolve("שלום"); got != LeftToRight {
+		t.Errorf("expected a fixed direction to be kept, got %d", got)
+	}
+}
+
+func TestBidiCells(t *testing.T) {
+	table := New(
+		WithColumns([]Column{
+			{Title: "Auto", Width: 6, Direction: AutoDirection},
+			{Title: "LTR", Width: 6},
+		}),
+		WithRows([]Row{
+			{"42 אב", "אב"},
+			{"42", "abc"},
+		}),
+		WithStyles(Styles{}),
+		WithHeight(2),
+	)
+
+	lines := strings.Split(table.View(), "\n")
+	want := []string{
+		"Auto  LTR   ",
+		" 42 אבאב    ",
+		"42    abc   ",
+	}
+	for i, w := range want {
+		if lines[i] != w {
+			t.Errorf("line %d: expected %q, got %q", i, w, lines[i])
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package table
+
+import (
+	"encoding/base64"
+	"io"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Cell ranges and the clipboard
+//
+// In cell selection mode, the SelectUp, SelectDown, SelectLeft and SelectRight
+// bindings extend a rectangular range of cells from the selected cell. Moving
+// the cursor with any other binding, or changing the rows, clears the range.
+// The Copy binding, enabled with WithCopy, copies the range, or the selected
+// cell or row if there's none, to the clipboard as tab-separated values that
+// spreadsheets paste as cells. The system clipboard is used by default; over
+// SSH, WithClipboardOutput sets the clipboard with an OSC 52 escape sequence
+// instead, in terminals that support it.
+
+// CopiedMsg is sent when the table has copied values to the clipboard.
+type CopiedMsg struct {
+	// ID is the ID of the table the values were copied from.
+	ID int
+
+	// Text is the copied text.
+	Text string
+
+	// Err is the error setting the clipboard, if any.
+	Err error
+}
+
+// cellPos is the position of a cell: the row's position in the display
+// order and the column's index.
+type cellPos struct {
+	pos, col int
+}
+
+// WithCopy lets the user copy the selected range of cells, cell or row with
+// the Copy binding.
+func WithCopy(v bool) Option {
+	return func(m *Model) {
+		m.KeyMap.Copy.SetEnabled(v)
+	}
+}
+
+// WithClipboardOutput sets the clipboard by writing an OSC 52 escape sequence
+// to the given writer instead of using the system clipboard. The writer must
+// not be the program's output while it's rendering, such as os.Stdout, since
+// the sequence could end up in the middle of a frame; a terminal device
+// opened separately, such as /dev/tty, works.
+func WithClipboardOutput(w io.Writer) Option {
+	return func(m *Model) {
+		m.clipboard = w
+	}
+}
+
+// SelectedRange returns the selected range of cells: the positions of its
+// first and last rows in the display order, and the indices of its first and
+// last columns. ok is false if no range is selected.
+func (m Model) SelectedRange() (top, left, bottom, right int, ok bool) {
+	if m.anchor == nil || !m.cellSelect {
+		return 0, 0, 0, 0, false
+	}
+	top, bottom = m.anchor.pos, m.cursor
+	if top > bottom {
+		top, bottom = bottom, top
+	}
+	left, right = m.anchor.col, m.colCursor
+	if left > right {
+		left, right = right, left
+	}
+	return top, left, bottom, right, true
+}
+
+// ClearRange clears the selected range of cells.
+func (m *Model) ClearRange() {
+	m.anchor = nil
+	m.UpdateViewport()
+}
+
+// extendRange moves the cursor, extending the selected range of cells from
+// where the cursor was.
+func (m *Model) extendRange(move func()) {
+	if m.anchor == nil {
+		m.anchor = &cellPos{pos: m.cursor, col: m.colCursor}
+	}
+	move()
+	m.UpdateViewport()
+}
+
+// inRange returns whether the cell of the row at the given index in rows is
+// in the selected range.
+func (m Model) inRange(rowID, col int) bool {
+	top, left, bottom, right, ok := m.SelectedRange()
+	if !ok || col < left || col > right {
+		return false
+	}
+	for pos := top; pos <= bottom && pos < len(m.order); pos++ {
+		if m.order[pos] == rowID {
+			return true
+		}
+	}
+	return false
+}
+
+// SelectionTSV returns the selected range of cells as tab-separated values,
+// or the selected cell or row if no range is selected. Hidden columns are
+// left out, and formulas are evaluated.
+func (m Model) SelectionTSV() string {
+	if m.cursor < 0 || m.cursor >= len(m.order) {
+		return ""
+	}
+
+	top, left, bottom, right, ok := m.SelectedRange()
+	switch {
+	case ok:
+	case m.cellSelect:
+		top, bottom, left, right = m.cursor, m.cursor, m.colCursor, m.colCursor
+	default:
+		top, bottom, left, right = m.cursor, m.cursor, 0, len(m.cols)-1
+	}
+
+	lines := make([]string, 0, bottom-top+1)
+	for pos := top; pos <= bottom && pos < len(m.order); pos++ {
+		var fields []string
+		for col := left; col <= right; col++ {
+			if m.cols[col].Hidden {
+				continue
+			}
+			v := ""
+			if col < len(m.rows[m.order[pos]]) {
+				v = m.CellValue(m.order[pos], col)
+			}
+			fields = append(fields, tsvField(v))
+		}
+		lines = append(lines, strings.Join(fields, "\t"))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// tsvField quotes a value the way spreadsheets do if it contains tabs,
+// newlines or quotes.
+func tsvField(s string) string {
+	if !strings.ContainsAny(s, "\t\r\n\"") {
+		return s
+	}
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+// CopySelection copies the selected range of cells to the clipboard, see
+// SelectionTSV. The returned command sends a CopiedMsg.
+func (m Model) CopySelection() tea.Cmd {
+	text := m.SelectionTSV()
+	if text == "" {
+		return nil
+	}
+	id, w := m.id, m.clipboard
+	return func() tea.Msg {
+		var err error
+		if w != nil {
+			_, err = io.WriteString(w, osc52(text))
+		} else {
+			err = clipboard.WriteAll(text)
+		}
+		return CopiedMsg{ID: id, Text: text, Err: err}
+	}
+}
+
+// osc52 returns the escape sequence that sets the clipboard to the given text.
+func osc52(s string) string {
+	return "\x1b]52;c;" + base64.StdEncoding.EncodeToString([]byte(s)) + "\x07"
+}
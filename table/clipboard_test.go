@@ -0,0 +1,75 @@
+package table
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/bubbletest"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestCopyRange(t *testing.T) {
+	var out bytes.Buffer
+	table := New(
+		WithColumns([]Column{{Title: "A", Width: 4}, {Title: "B", Width: 4}, {Title: "C", Width: 4}}),
+		WithRows([]Row{{"a1", "b1", "c1"}, {"a2", "b\t2", "c2"}, {"a3", "b3", "c3"}}),
+		WithFocused(true),
+		WithCopy(true),
+		WithClipboardOutput(&out),
+	)
+	table.SetCellSelect(true)
+
+	for _, k := range []tea.KeyType{tea.KeyShiftDown, tea.KeyShiftRight} {
+		table, _ = table.Update(tea.KeyMsg{Type: k})
+	}
+	top, left, bottom, right, ok := table.SelectedRange()
+	if !ok || top != 0 || left != 0 || bottom != 1 || right != 1 {
+		t.Fatalf("unexpected range %d,%d to %d,%d", top, left, bottom, right)
+	}
+
+	_, cmd := table.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	msgs := bubbletest.Collect(cmd)
+	if len(msgs) != 1 {
+		t.Fatalf("expected a CopiedMsg, got %v", msgs)
+	}
+	msg, ok := msgs[0].(CopiedMsg)
+	if !ok {
+		t.Fatalf("expected a CopiedMsg, got %T", msgs[0])
+	}
+	want := "a1\tb1\na2\t\"b\t2\""
+	if msg.Text != want {
+		t.Fatalf("expected %q, got %q", want, msg.Text)
+	}
+	if got := out.String(); got != osc52(want) {
+		t.Fatalf("expected the OSC 52 sequence, got %q", got)
+	}
+
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if _, _, _, _, ok := table.SelectedRange(); ok {
+		t.Fatal("expected moving the cursor to clear the range")
+	}
+}
+
+func TestCopyRow(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "A", Width: 4}, {Title: "B", Width: 4, Hidden: true}, {Title: "C", Width: 4}}),
+		WithRows([]Row{{"a1", "b1", "c1"}}),
+	)
+	if got := table.SelectionTSV(); got != "a1\tc1" {
+		t.Fatalf("expected the visible cells of the row, got %q", got)
+	}
+}
+
+func TestCopyDisabled(t *testing.T) {
+	var out bytes.Buffer
+	table := New(
+		WithColumns([]Column{{Title: "A", Width: 4}}),
+		WithRows([]Row{{"a1"}}),
+		WithFocused(true),
+		WithClipboardOutput(&out),
+	)
+	_, cmd := table.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("y")})
+	if msgs := bubbletest.Collect(cmd); len(msgs) != 0 || out.Len() != 0 {
+		t.Fatalf("expected the copy binding to be disabled by default, got %v", msgs)
+	}
+}
@@ -0,0 +1,196 @@
+package table
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Column filters
+//
+// Besides the table-wide filter, every column can have its own filter. Active
+// column filters are shown as chips under the header. With WithColumnFiltering,
+// the user opens a quick filter prompt for the selected column with the
+// FilterColumn binding, or by clicking a column header while holding alt or
+// ctrl. Mouse coordinates are expected to be relative to the top left corner
+// of the table.
+
+// WithColumnFiltering lets the user filter columns with the quick filter
+// prompt.
+func WithColumnFiltering(v bool) Option {
+	return func(m *Model) {
+		m.columnFiltering = v
+		m.KeyMap.FilterColumn.SetEnabled(v)
+	}
+}
+
+// SetColumnFilter only shows the rows whose cell in the given column contains
+// the given text, ignoring case. Filters on columns that aren't text can also
+// compare values, see Kind, and filters starting with "re:" are regular
+// expressions, see RegexFilter. Filters starting with ! exclude the rows
+// that match the rest instead. An empty filter removes the column filter.
+func (m *Model) SetColumnFilter(col int, s string) {
+	if col < 0 || col >= len(m.cols) {
+		return
+	}
+	if s == "" {
+		delete(m.columnFilters, col)
+	} else {
+		if m.columnFilters == nil {
+			m.columnFilters = make(map[int]string)
+		}
+		m.columnFilters[col] = s
+	}
+	m.reselect()
+}
+
+// ColumnFilter returns the filter of the given column.
+func (m Model) ColumnFilter(col int) string {
+	return m.columnFilters[col]
+}
+
+// ClearColumnFilters removes all column filters.
+func (m *Model) ClearColumnFilters() {
+	m.columnFilters = nil
+	m.reselect()
+}
+
+// FilteringColumn returns whether the quick filter prompt is open.
+func (m Model) FilteringColumn() bool {
+	return m.filtering
+}
+
+// OpenColumnFilter opens the quick filter prompt for the given column.
+func (m *Model) OpenColumnFilter(col int) tea.Cmd {
+	if col < 0 || col >= len(m.cols) {
+		return nil
+	}
+	m.filtering = true
+	m.filterCol = col
+	m.filterBefore = m.columnFilters[col]
+	m.filterInput = textinput.New()
+	m.filterInput.Prompt = m.cols[col].Title + ": "
+	m.filterInput.SetValue(m.columnFilters[col])
+	m.filterInput.CursorEnd()
+	return m.filterInput.Focus()
+}
+
+// handleColumnFilter handles messages while the quick filter prompt is open.
+// It returns whether the message was consumed. The column filter is updated
+// as the user types; AcceptFilter closes the prompt and CancelFilter restores
+// the filter the column had before.
+func (m *Model) handleColumnFilter(msg tea.Msg) (bool, tea.Cmd) {
+	if !m.filtering {
+		return false, nil
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(keyMsg, m.KeyMap.AcceptFilter):
+			m.filtering = false
+			return true, nil
+		case key.Matches(keyMsg, m.KeyMap.CancelFilter):
+			m.filtering = false
+			m.SetColumnFilter(m.filterCol, m.filterBefore)
+			return true, nil
+		case key.Matches(keyMsg, m.KeyMap.ToggleRegex):
+			m.toggleRegex()
+			m.SetColumnFilter(m.filterCol, m.filterInput.Value())
+			return true, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.filterInput, cmd = m.filterInput.Update(msg)
+	if v := m.filterInput.Value(); v != m.columnFilters[m.filterCol] {
+		m.SetColumnFilter(m.filterCol, v)
+	}
+	return true, cmd
+}
+
+// handleHeaderClick opens the quick filter prompt for a column when its
+// header is clicked while holding alt or ctrl, if mouse support is enabled.
+func (m *Model) handleHeaderClick(msg tea.Msg) (bool, tea.Cmd) {
+	click, ok := msg.(tea.MouseMsg)
+	if !ok || !m.mouse || !m.columnFiltering || click.Type != tea.MouseLeft || !(click.Alt || click.Ctrl) || m.headerHidden {
+		return false, nil
+	}
+	if ev, ok := m.headerRegions().Hit(click, m.xPosition, m.yPosition); ok {
+		return true, m.OpenColumnFilter(ev.ID)
+	}
+	return false, nil
+}
+
+// HeaderAt returns the index of the column whose header covers the given x
+// coordinate, relative to the left edge of the table.
+func (m Model) HeaderAt(x int) (col int, ok bool) {
+	r, ok := m.headerRegions().At(x, m.titleRow())
+	return r.ID, ok
+}
+
+// titleRow returns the line of the table view the column titles are on.
+func (m Model) titleRow() int {
+	y := 0
+	if m.refresh.enabled {
+		y++
+	}
+	if m.formulas {
+		y++
+	}
+	if m.groupHeaderView() != "" {
+		y++
+	}
+	return y
+}
+
+// columnFilterView renders the quick filter prompt if it's open, or the
+// chips of the active column filters otherwise. It returns an empty string if
+// there's nothing to show.
+func (m Model) columnFilterView() string {
+	if m.filtering {
+		return m.clip(m.filterInput.View())
+	}
+	if len(m.columnFilters) == 0 {
+		return ""
+	}
+
+	cols := make([]int, 0, len(m.columnFilters))
+	for col := range m.columnFilters {
+		cols = append(cols, col)
+	}
+	sort.Ints(cols)
+
+	chips := make([]string, 0, len(cols))
+	for _, col := range cols {
+		chips = append(chips, m.styles.FilterChip.Render(m.cols[col].Title+": "+m.columnFilters[col]+" ×"))
+	}
+	return m.clip(strings.Join(chips, " "))
+}
+
+// matchesColumnFilters returns whether the row at the given index matches
+// all column filters.
+func (m Model) matchesColumnFilters(row int) bool {
+	for col, filter := range m.columnFilters {
+		filter, exclude := splitNegation(filter)
+		if filter == "" {
+			continue
+		}
+		if m.cellMatches(row, col, filter) == exclude {
+			return false
+		}
+	}
+	return true
+}
+
+// cellMatches returns whether the cell of the row at the given index in the
+// given column matches a column filter, without its !.
+func (m Model) cellMatches(row, col int, filter string) bool {
+	s := m.CellValue(row, col)
+	if matched, ok := m.matchesRegex(filter, s, m.cols[col].format(s)); ok {
+		return matched
+	}
+	return m.cols[col].matches(s, filter)
+}
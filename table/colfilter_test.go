@@ -0,0 +1,97 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestColumnFilters(t *testing.T) {
+	table := testTable(filesFixture)
+	table.SetColumnFilter(1, "FILE")
+	if rows := table.VisibleRows(); len(rows) != 2 {
+		t.Fatalf("expected 2 files, got %v", rows)
+	}
+	if !strings.Contains(table.View(), "Kind: FILE ×") {
+		t.Fatalf("expected a filter chip in view:\n%s", table.View())
+	}
+
+	table.Focus()
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("X")})
+	if rows := table.VisibleRows(); len(rows) != 4 {
+		t.Fatalf("expected filters to be cleared, got %v", rows)
+	}
+	if strings.Contains(table.View(), "×") {
+		t.Fatalf("expected no chips in view:\n%s", table.View())
+	}
+}
+
+func TestColumnFilterPrompt(t *testing.T) {
+	table := testTable(filesFixture, WithColumnFiltering(true), WithMouse(0, 0))
+	table.Focus()
+
+	table, _ = table.Update(tea.MouseMsg{X: 12, Y: 0, Type: tea.MouseLeft, Alt: true})
+	if !table.FilteringColumn() {
+		t.Fatal("expected the filter prompt to open")
+	}
+	for _, r := range "dir" {
+		table, _ = table.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	if got := table.ColumnFilter(1); got != "dir" {
+		t.Fatalf("expected the Kind column to be filtered, got %q", got)
+	}
+	if rows := table.VisibleRows(); len(rows) != 2 {
+		t.Fatalf("expected the filter to apply while typing, got %v", rows)
+	}
+
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if table.FilteringColumn() || table.ColumnFilter(1) != "" {
+		t.Fatal("expected esc to close the prompt and restore the filter")
+	}
+
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("go")})
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if table.FilteringColumn() || table.ColumnFilter(0) != "go" {
+		t.Fatalf("expected enter to keep the filter, got %q", table.ColumnFilter(0))
+	}
+}
+
+func TestColumnFilteringDisabled(t *testing.T) {
+	table := testTable(filesFixture)
+	table.Focus()
+
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	table, _ = table.Update(tea.MouseMsg{X: 12, Y: 0, Type: tea.MouseLeft, Alt: true})
+	if table.FilteringColumn() {
+		t.Fatal("expected the filter prompt to be disabled by default")
+	}
+}
+
+func TestColumnFilterHeaderClickNeedsMouse(t *testing.T) {
+	table := testTable(filesFixture, WithColumnFiltering(true))
+	table.Focus()
+
+	table, _ = table.Update(tea.MouseMsg{X: 12, Y: 0, Type: tea.MouseLeft, Alt: true})
+	if table.FilteringColumn() {
+		t.Fatal("expected header clicks to be ignored without WithMouse")
+	}
+}
+
+func TestColumnFilterPromptKeyMap(t *testing.T) {
+	table := testTable(filesFixture, WithColumnFiltering(true))
+	table.KeyMap.AcceptFilter.SetKeys("ctrl+s")
+	table.Focus()
+
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyCtrlF})
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("go")})
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if !table.FilteringColumn() {
+		t.Fatal("expected enter not to close the prompt once AcceptFilter is rebound")
+	}
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyCtrlS})
+	if table.FilteringColumn() || table.ColumnFilter(0) != "go" {
+		t.Fatalf("expected the rebound key to keep the filter, got %q", table.ColumnFilter(0))
+	}
+}
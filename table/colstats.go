@@ -0,0 +1,182 @@
+package table
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/i18n"
+)
+
+// ColumnStats are statistics of the values in a column.
+type ColumnStats struct {
+	// Count is the number of non-empty values and Distinct the number of
+	// different ones.
+	Count    int
+	Distinct int
+
+	// Min and Max are the smallest and largest values, in the column's sort
+	// order.
+	Min string
+	Max string
+
+	// Numeric is set if all non-empty values are numbers, in which case Mean
+	// and Median are set. For Bytes and Duration columns, they're in bytes
+	// and nanoseconds.
+	Numeric bool
+	Mean    float64
+	Median  float64
+}
+
+// ColumnStats computes statistics of the values in the given column of the
+// rows that pass the filters.
+func (m Model) ColumnStats(col int) ColumnStats {
+	var stats ColumnStats
+	if col < 0 || col >= len(m.cols) {
+		return stats
+	}
+
+	kind := m.cols[col].Kind
+	cmp := m.cols[col].comparator()
+	numeric := kind == KindText || kind == KindNumber || kind == KindBytes || kind == KindDuration
+
+	seen := make(map[string]bool)
+	var numbers []float64
+	for _, i := range m.order {
+		v := m.CellValue(i, col)
+		if strings.TrimSpace(v) == "" {
+			continue
+		}
+
+		stats.Count++
+		if !seen[v] {
+			seen[v] = true
+			stats.Distinct++
+		}
+		if stats.Count == 1 || cmp(v, stats.Min) < 0 {
+			stats.Min = v
+		}
+		if stats.Count == 1 || cmp(v, stats.Max) > 0 {
+			stats.Max = v
+		}
+
+		if numeric {
+			n, ok := numberValue(kind, v)
+			if ok {
+				numbers = append(numbers, n)
+			} else {
+				numeric = false
+			}
+		}
+	}
+
+	if !numeric || len(numbers) == 0 {
+		return stats
+	}
+
+	// Text columns of numbers are sorted as strings, so find the smallest
+	// and largest numbers again.
+	if kind == KindText {
+		stats.Min, stats.Max = "", ""
+		minimum, maximum := numbers[0], numbers[0]
+		for _, i := range m.order {
+			v := m.CellValue(i, col)
+			n, ok := numberValue(kind, v)
+			if !ok {
+				continue
+			}
+			if stats.Min == "" || n < minimum {
+				stats.Min, minimum = v, n
+			}
+			if stats.Max == "" || n > maximum {
+				stats.Max, maximum = v, n
+			}
+		}
+	}
+
+	stats.Numeric = true
+	var sum float64
+	for _, n := range numbers {
+		sum += n
+	}
+	stats.Mean = sum / float64(len(numbers))
+
+	sort.Float64s(numbers)
+	mid := len(numbers) / 2
+	if len(numbers)%2 == 0 {
+		stats.Median = (numbers[mid-1] + numbers[mid]) / 2
+	} else {
+		stats.Median = numbers[mid]
+	}
+	return stats
+}
+
+// numberValue returns a value of a column of the given kind as a number.
+// Text is parsed as a plain number.
+func numberValue(kind Kind, s string) (float64, bool) {
+	if kind == KindText {
+		f, err := parseNumber(s)
+		return f, err == nil
+	}
+	return kind.value(s)
+}
+
+// WithColumnStats lets the user show and hide the statistics of the selected
+// column with the ToggleStats binding.
+func WithColumnStats(v bool) Option {
+	return func(m *Model) {
+		m.KeyMap.ToggleStats.SetEnabled(v)
+	}
+}
+
+// ShowColumnStats returns whether the statistics of the selected column are
+// shown under the table.
+func (m Model) ShowColumnStats() bool {
+	return m.showStats
+}
+
+// SetShowColumnStats shows or hides the statistics of the selected column
+// under the table. They're computed over the rows that pass the filters
+// whenever the table is rendered.
+func (m *Model) SetShowColumnStats(v bool) {
+	m.showStats = v
+}
+
+// statsView renders the statistics of the selected column, if they're shown.
+func (m Model) statsView() string {
+	if !m.showStats || m.colCursor < 0 || m.colCursor >= len(m.cols) {
+		return ""
+	}
+	col := m.cols[m.colCursor]
+	stats := m.ColumnStats(m.colCursor)
+
+	parts := []string{
+		col.Title,
+		i18n.Format("count %d", stats.Count),
+		i18n.Format("distinct %d", stats.Distinct),
+	}
+	if stats.Count > 0 {
+		parts = append(parts, i18n.Format("min %s", col.format(stats.Min)), i18n.Format("max %s", col.format(stats.Max)))
+	}
+	if stats.Numeric {
+		parts = append(parts,
+			i18n.Format("mean %s", formatStat(col.Kind, stats.Mean)),
+			i18n.Format("median %s", formatStat(col.Kind, stats.Median)),
+		)
+	}
+	return m.clip(m.styles.StatusBar.Render(strings.Join(parts, " · ")))
+}
+
+// formatStat formats a mean or median of a column of the given kind.
+func formatStat(kind Kind, n float64) string {
+	switch kind {
+	case KindBytes:
+		return formatBytes(n)
+	case KindDuration:
+		return time.Duration(n).Round(time.Millisecond).String()
+	}
+	s := strconv.FormatFloat(n, 'f', 2, 64)
+	s = strings.TrimRight(strings.TrimRight(s, "0"), ".")
+	return groupThousands(s)
+}
@@ -0,0 +1,55 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestColumnStats(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "Name", Width: 6}, {Title: "Score", Width: 6}}),
+		WithRows([]Row{{"a", "9"}, {"b", "10"}, {"c", "2"}, {"d", "10"}, {"e", ""}}),
+	)
+
+	stats := table.ColumnStats(1)
+	if stats.Count != 4 || stats.Distinct != 3 {
+		t.Fatalf("expected 4 values, 3 distinct, got %+v", stats)
+	}
+	if !stats.Numeric || stats.Min != "2" || stats.Max != "10" {
+		t.Fatalf("expected numeric min and max, got %+v", stats)
+	}
+	if stats.Mean != 7.75 || stats.Median != 9.5 {
+		t.Fatalf("expected mean 7.75 and median 9.5, got %+v", stats)
+	}
+
+	table.SetColumnFilter(0, "a")
+	if stats := table.ColumnStats(1); stats.Count != 1 || stats.Max != "9" {
+		t.Fatalf("expected stats over the filtered rows, got %+v", stats)
+	}
+
+	if stats := table.ColumnStats(0); stats.Numeric || stats.Min != "a" {
+		t.Fatalf("expected text stats, got %+v", stats)
+	}
+}
+
+func TestColumnStatsView(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "Size", Width: 10, Kind: KindBytes}}),
+		WithRows([]Row{{"1KiB"}, {"3KiB"}}),
+		WithFocused(true),
+	)
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("S")})
+	if table.ShowColumnStats() {
+		t.Fatal("expected the stats binding to be disabled by default")
+	}
+
+	WithColumnStats(true)(&table)
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("S")})
+
+	want := "Size · count 2 · distinct 2 · min 1.0 KiB · max 3.0 KiB · mean 2.0 KiB · median 2.0 KiB"
+	if view := table.View(); !strings.Contains(view, want) {
+		t.Fatalf("expected %q in view:\n%s", want, view)
+	}
+}
@@ -0,0 +1,89 @@
+package table
+
+// DisabledPolicy determines how the cursor treats disabled rows.
+type DisabledPolicy int
+
+const (
+	// SkipDisabled makes the cursor skip over disabled rows.
+	SkipDisabled DisabledPolicy = iota
+
+	// SelectDisabled lets the cursor select disabled rows, but they can't be
+	// activated.
+	SelectDisabled
+)
+
+// WithDisabledRows marks the rows at the given indices as disabled.
+func WithDisabledRows(indices ...int) Option {
+	return func(m *Model) {
+		for _, i := range indices {
+			m.setDisabled(i, true)
+		}
+	}
+}
+
+// WithDisabledPolicy sets how the cursor treats disabled rows. The default is
+// SkipDisabled.
+func WithDisabledPolicy(p DisabledPolicy) Option {
+	return func(m *Model) {
+		m.disabledPolicy = p
+	}
+}
+
+// SetDisabled marks the row at the given index as disabled or enabled.
+// Disabled rows are rendered with the Disabled style and can't be activated.
+// Rows are identified by index, so the disabled state is kept when the rows
+// are replaced with SetRows.
+func (m *Model) SetDisabled(i int, disabled bool) {
+	m.setDisabled(i, disabled)
+	m.skipDisabled(1)
+	m.UpdateViewport()
+}
+
+// Disabled returns whether the row at the given index is disabled.
+func (m Model) Disabled(i int) bool {
+	return m.disabled[i]
+}
+
+func (m *Model) setDisabled(i int, disabled bool) {
+	if !disabled {
+		delete(m.disabled, i)
+		return
+	}
+	if m.disabled == nil {
+		m.disabled = make(map[int]bool)
+	}
+	m.disabled[i] = true
+}
+
+// skipDisabled moves the cursor off a disabled row if the policy requires
+// it, preferring the given direction.
+func (m *Model) skipDisabled(dir int) {
+	if m.disabledPolicy == SkipDisabled {
+		m.cursor = m.enabledRow(m.cursor, dir)
+	}
+}
+
+// rowDisabled returns whether the row at the given position is disabled.
+func (m Model) rowDisabled(pos int) bool {
+	return pos >= 0 && pos < len(m.order) && m.disabled[m.order[pos]]
+}
+
+// enabledRow returns the position of the enabled row closest to position i,
+// searching in the given direction first and in the opposite direction if
+// there's no enabled row that way. It returns i if all rows are disabled.
+func (m Model) enabledRow(i, dir int) int {
+	if !m.rowDisabled(i) {
+		return i
+	}
+	if dir == 0 {
+		dir = 1
+	}
+	for _, d := range []int{dir, -dir} {
+		for j := i + d; j >= 0 && j < len(m.order); j += d {
+			if !m.rowDisabled(j) {
+				return j
+			}
+		}
+	}
+	return i
+}
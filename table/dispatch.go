@@ -0,0 +1,318 @@
+package table
+
+import (
+	"github.com/charmbracelet/bubbles/event"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// keyActions are the actions with keybindings, in the order their bindings
+// are matched.
+var keyActions = []Action{
+	ActionLineUp,
+	ActionLineDown,
+	ActionPageUp,
+	ActionPageDown,
+	ActionHalfPageUp,
+	ActionHalfPageDown,
+	ActionGotoTop,
+	ActionGotoBottom,
+	ActionCellLeft,
+	ActionCellRight,
+	ActionActivate,
+	ActionDeleteRow,
+	ActionSwapPane,
+	ActionShowCell,
+	ActionToggleStats,
+	ActionNextDuplicate,
+	ActionTogglePivot,
+	ActionToggleMark,
+	ActionFilterColumn,
+	ActionClearFilters,
+	ActionNextFilterPreset,
+	ActionEditCell,
+	ActionAddRow,
+	ActionSelectUp,
+	ActionSelectDown,
+	ActionSelectLeft,
+	ActionSelectRight,
+	ActionCopy,
+	ActionRefresh,
+	ActionToggleAutoRefresh,
+}
+
+// HandleKey handles a key press the way Update does, even if the table isn't
+// focused. It lets parent models forward keys to the table from elsewhere,
+// like a command palette.
+func (m *Model) HandleKey(msg tea.KeyMsg) tea.Cmd {
+	return m.handle(msg)
+}
+
+// Dispatch performs an action as if its keybinding was pressed, whatever the
+// KeyMap and even if the table isn't focused or the binding is disabled.
+// Actions that don't apply to the table's current mode, such as moving
+// between cells outside of cell selection mode, do nothing. Actions that
+// require confirmation still ask for it.
+func (m *Model) Dispatch(a Action) tea.Cmd {
+	if !m.available(a) {
+		return nil
+	}
+	return m.observe(func() tea.Cmd {
+		return m.do(a)
+	})
+}
+
+// handle handles a message for the table.
+func (m *Model) handle(msg tea.Msg) tea.Cmd {
+	m.recordMacroKey(msg)
+
+	if handled, cmd := m.handleConfirm(msg); handled {
+		return cmd
+	}
+	if handled, cmd := m.handleEdit(msg); handled {
+		return cmd
+	}
+	if handled, cmd := m.handlePopup(msg); handled {
+		return cmd
+	}
+	if handled, cmd := m.handleColumnFilter(msg); handled {
+		return cmd
+	}
+	if handled, cmd := m.handleHeaderClick(msg); handled {
+		return cmd
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		if m.acceptsTypeAhead(msg) {
+			return m.observe(func() tea.Cmd {
+				m.handleTypeAhead(msg)
+				return nil
+			})
+		}
+		if handled, cmd := m.handleMacroKey(msg); handled {
+			return cmd
+		}
+	}
+
+	return m.observe(func() tea.Cmd {
+		switch msg := msg.(type) {
+		case tea.KeyMsg:
+			if a, ok := m.actionFor(msg); ok {
+				return m.do(a)
+			}
+		case tea.MouseMsg:
+			if m.mouse {
+				return m.handleMouse(msg)
+			}
+			if m.embedded {
+				return m.updateEmbedded(msg)
+			}
+		}
+		return nil
+	})
+}
+
+// observe runs f and adds the commands that report its effects: an
+// event.SelectionChangedMsg if the cursor moved, and a viewport sync if the
+// body is rendered into an embedded viewport with high performance
+// rendering.
+func (m *Model) observe(f func() tea.Cmd) tea.Cmd {
+	prev, prevOffset, prevRows := m.cursor, m.offset, len(m.order)
+
+	cmds := []tea.Cmd{f()}
+
+	if m.cursor != prev {
+		cmds = append(cmds, event.Cmd(event.SelectionChangedMsg{
+			ID:       m.id,
+			Index:    m.cursor,
+			Previous: prev,
+		}))
+	}
+
+	if m.embedded && m.viewport.HighPerformanceRendering &&
+		(m.cursor != prev || m.offset != prevOffset || len(m.order) != prevRows) {
+		cmds = append(cmds, viewport.Sync(m.viewport))
+	}
+
+	return tea.Batch(cmds...)
+}
+
+// actionFor returns the action whose keybinding matches a key press.
+func (m Model) actionFor(msg tea.KeyMsg) (Action, bool) {
+	for _, a := range keyActions {
+		if m.available(a) && key.Matches(msg, m.binding(a)) {
+			return a, true
+		}
+	}
+	return 0, false
+}
+
+// available returns whether an action applies to the table's current mode.
+func (m Model) available(a Action) bool {
+	switch a {
+	case ActionCellLeft, ActionCellRight,
+		ActionSelectUp, ActionSelectDown, ActionSelectLeft, ActionSelectRight:
+		return m.cellSelect
+	case ActionSwapPane:
+		return m.split
+	case ActionNextDuplicate:
+		return m.dupCols != nil
+	case ActionTogglePivot:
+		return m.pivot != nil
+	case ActionToggleMark:
+		return m.multiSelect
+	case ActionClearFilters:
+		return len(m.columnFilters) > 0
+	case ActionNextFilterPreset:
+		return len(m.filterPresets) > 0
+	case ActionRefresh:
+		return m.refresh.enabled
+	case ActionToggleAutoRefresh:
+		return m.refresh.enabled && m.refresh.Interval > 0
+	case ActionEditCell, ActionAddRow:
+		return m.editable && m.pivoted == nil
+	case ActionDeleteRow:
+		return m.pivoted == nil
+	}
+	return true
+}
+
+// binding returns the keybinding of an action.
+func (m Model) binding(a Action) key.Binding {
+	switch a {
+	case ActionActivate:
+		return m.KeyMap.Activate
+	case ActionDeleteRow:
+		return m.KeyMap.DeleteRow
+	case ActionLineUp:
+		return m.KeyMap.LineUp
+	case ActionLineDown:
+		return m.KeyMap.LineDown
+	case ActionPageUp:
+		return m.KeyMap.PageUp
+	case ActionPageDown:
+		return m.KeyMap.PageDown
+	case ActionHalfPageUp:
+		return m.KeyMap.HalfPageUp
+	case ActionHalfPageDown:
+		return m.KeyMap.HalfPageDown
+	case ActionGotoTop:
+		return m.KeyMap.GotoTop
+	case ActionGotoBottom:
+		return m.KeyMap.GotoBottom
+	case ActionCellLeft:
+		return m.KeyMap.CellLeft
+	case ActionCellRight:
+		return m.KeyMap.CellRight
+	case ActionSwapPane:
+		return m.KeyMap.SwapPane
+	case ActionShowCell:
+		return m.KeyMap.ShowCell
+	case ActionToggleStats:
+		return m.KeyMap.ToggleStats
+	case ActionNextDuplicate:
+		return m.KeyMap.NextDuplicate
+	case ActionTogglePivot:
+		return m.KeyMap.TogglePivot
+	case ActionToggleMark:
+		return m.KeyMap.ToggleMark
+	case ActionFilterColumn:
+		return m.KeyMap.FilterColumn
+	case ActionClearFilters:
+		return m.KeyMap.ClearFilters
+	case ActionNextFilterPreset:
+		return m.KeyMap.NextFilterPreset
+	case ActionEditCell:
+		return m.KeyMap.EditCell
+	case ActionAddRow:
+		return m.KeyMap.AddRow
+	case ActionSelectUp:
+		return m.KeyMap.SelectUp
+	case ActionSelectDown:
+		return m.KeyMap.SelectDown
+	case ActionSelectLeft:
+		return m.KeyMap.SelectLeft
+	case ActionSelectRight:
+		return m.KeyMap.SelectRight
+	case ActionCopy:
+		return m.KeyMap.Copy
+	case ActionRefresh:
+		return m.KeyMap.Refresh
+	case ActionToggleAutoRefresh:
+		return m.KeyMap.ToggleAutoRefresh
+	}
+	return key.Binding{}
+}
+
+// do performs an action.
+func (m *Model) do(a Action) tea.Cmd {
+	switch a {
+	case ActionLineUp, ActionLineDown, ActionPageUp, ActionPageDown,
+		ActionHalfPageUp, ActionHalfPageDown, ActionGotoTop, ActionGotoBottom,
+		ActionCellLeft, ActionCellRight:
+		m.anchor = nil
+	}
+
+	switch a {
+	case ActionActivate, ActionDeleteRow:
+		return m.trigger(a)
+	case ActionLineUp:
+		m.MoveUp(1)
+	case ActionLineDown:
+		m.MoveDown(1)
+	case ActionPageUp:
+		m.MoveUp(m.pageSize())
+	case ActionPageDown:
+		m.MoveDown(m.pageSize())
+	case ActionHalfPageUp:
+		m.MoveUp(m.pageSize() / 2)
+	case ActionHalfPageDown:
+		m.MoveDown(m.pageSize() / 2)
+	case ActionGotoTop:
+		m.GotoTop()
+	case ActionGotoBottom:
+		m.GotoBottom()
+	case ActionCellLeft:
+		m.MoveLeft(1)
+	case ActionCellRight:
+		m.MoveRight(1)
+	case ActionSwapPane:
+		m.SwapPane()
+	case ActionShowCell:
+		m.OpenCellPopup()
+	case ActionToggleStats:
+		m.SetShowColumnStats(!m.showStats)
+	case ActionNextDuplicate:
+		m.NextDuplicate()
+	case ActionTogglePivot:
+		m.SetPivoted(m.pivoted == nil)
+	case ActionToggleMark:
+		m.toggleMark()
+	case ActionFilterColumn:
+		return m.OpenColumnFilter(m.colCursor)
+	case ActionClearFilters:
+		m.ClearColumnFilters()
+	case ActionNextFilterPreset:
+		m.NextFilterPreset()
+	case ActionEditCell:
+		return m.EditCell()
+	case ActionAddRow:
+		return m.AddRow()
+	case ActionSelectUp:
+		m.extendRange(func() { m.MoveUp(1) })
+	case ActionSelectDown:
+		m.extendRange(func() { m.MoveDown(1) })
+	case ActionSelectLeft:
+		m.extendRange(func() { m.MoveLeft(1) })
+	case ActionSelectRight:
+		m.extendRange(func() { m.MoveRight(1) })
+	case ActionCopy:
+		return m.CopySelection()
+	case ActionRefresh:
+		return m.Refresh()
+	case ActionToggleAutoRefresh:
+		return m.SetAutoRefresh(!m.refresh.auto)
+	}
+	return nil
+}
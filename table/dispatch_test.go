@@ -0,0 +1,55 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/bubbletest"
+	"github.com/charmbracelet/bubbles/event"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var dispatchFixture = []Option{
+	WithColumns([]Column{{Title: "A", Width: 4}, {Title: "B", Width: 4}}),
+	WithRows([]Row{{"1", "a"}, {"2", "b"}, {"3", "c"}}),
+}
+
+func TestDispatch(t *testing.T) {
+	table := testTable(dispatchFixture)
+
+	msgs := bubbletest.Collect(table.Dispatch(ActionGotoBottom))
+	if table.Cursor() != 2 {
+		t.Fatalf("expected the cursor at the bottom, got %d", table.Cursor())
+	}
+	if len(msgs) != 1 {
+		t.Fatalf("expected a selection change, got %v", msgs)
+	}
+	if msg, ok := msgs[0].(event.SelectionChangedMsg); !ok || msg.Index != 2 || msg.Previous != 0 {
+		t.Fatalf("unexpected message %#v", msgs[0])
+	}
+
+	// Moving between cells only applies in cell selection mode.
+	table.Dispatch(ActionCellRight)
+	if _, col := table.SelectedCell(); col != 0 {
+		t.Fatalf("expected the column cursor not to move, got %d", col)
+	}
+
+	// The binding of ActionDeleteRow is disabled, but it can be dispatched.
+	table.Dispatch(ActionDeleteRow)
+	if len(table.VisibleRows()) != 2 {
+		t.Fatalf("expected a row to be deleted, got %v", table.VisibleRows())
+	}
+}
+
+func TestHandleKeyWithoutFocus(t *testing.T) {
+	table := testTable(dispatchFixture)
+
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyDown})
+	if table.Cursor() != 0 {
+		t.Fatal("expected Update to ignore keys without focus")
+	}
+
+	table.HandleKey(tea.KeyMsg{Type: tea.KeyDown})
+	if table.Cursor() != 1 {
+		t.Fatalf("expected HandleKey to move the cursor, got %d", table.Cursor())
+	}
+}
@@ -0,0 +1,103 @@
+package table
+
+import (
+	"strconv"
+	"strings"
+)
+
+// HighlightDuplicates flags the rows that are identical to another row in the
+// given columns, or in all columns if none are given, and styles them with the
+// Duplicate style. The NextDuplicate binding jumps to the next flagged row.
+// Duplicates are found again whenever the rows change.
+func (m *Model) HighlightDuplicates(cols ...int) {
+	m.dupCols = append([]int{}, cols...)
+	m.KeyMap.NextDuplicate.SetEnabled(true)
+	m.findDuplicates()
+	m.UpdateViewport()
+}
+
+// ClearDuplicates stops highlighting duplicate rows.
+func (m *Model) ClearDuplicates() {
+	m.dupCols = nil
+	m.duplicates = nil
+	m.KeyMap.NextDuplicate.SetEnabled(false)
+	m.UpdateViewport()
+}
+
+// Duplicate returns whether the row at the given index in the table's rows is
+// flagged as a duplicate.
+func (m Model) Duplicate(i int) bool {
+	return m.duplicates[i]
+}
+
+// Duplicates returns the indices of the rows flagged as duplicates, in the
+// table's rows.
+func (m Model) Duplicates() []int {
+	var indices []int
+	for i := range m.rows {
+		if m.duplicates[i] {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// NextDuplicate moves the cursor to the next row flagged as a duplicate,
+// wrapping around at the end of the table.
+func (m *Model) NextDuplicate() {
+	n := len(m.order)
+	for d := 1; d <= n; d++ {
+		pos := (m.cursor + d) % n
+		if m.duplicates[m.order[pos]] && !(m.disabledPolicy == SkipDisabled && m.rowDisabled(pos)) {
+			m.SetCursor(pos)
+			return
+		}
+	}
+}
+
+// findDuplicates flags the rows that have the same values as another row in
+// the duplicate columns.
+func (m *Model) findDuplicates() {
+	if m.dupCols == nil {
+		m.duplicates = nil
+		return
+	}
+
+	n := m.scrollingRows()
+	first := make(map[string]int, n)
+	m.duplicates = make(map[int]bool)
+	for i := 0; i < n; i++ {
+		key := m.duplicateKey(i)
+		if j, ok := first[key]; ok {
+			m.duplicates[i] = true
+			m.duplicates[j] = true
+			continue
+		}
+		first[key] = i
+	}
+}
+
+// duplicateKey returns the values of a row in the duplicate columns, joined
+// so that different values never give the same key.
+func (m Model) duplicateKey(i int) string {
+	cols := m.dupCols
+	if len(cols) == 0 {
+		cols = make([]int, len(m.rows[i]))
+		for col := range cols {
+			cols[col] = col
+		}
+	}
+
+	var b strings.Builder
+	for _, col := range cols {
+		v := ""
+		if col >= 0 && col < len(m.rows[i]) {
+			v = m.CellValue(i, col)
+		}
+		// Prefix every value with its length to keep the key unambiguous.
+		b.WriteString(strconv.Itoa(len(v)))
+		b.WriteByte(':')
+		b.WriteString(v)
+	}
+	return b.String()
+}
@@ -0,0 +1,52 @@
+package table
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestHighlightDuplicates(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "Name", Width: 6}, {Title: "Email", Width: 12}}),
+		WithRows([]Row{
+			{"Ann", "ann@x.org"},
+			{"Bob", "bob@x.org"},
+			{"Ann", "ann@y.org"},
+			{"Cy", "bob@x.org"},
+		}),
+		WithFocused(true),
+	)
+
+	table.HighlightDuplicates(0)
+	if got := table.Duplicates(); len(got) != 2 || got[0] != 0 || got[1] != 2 {
+		t.Fatalf("expected rows 0 and 2, got %v", got)
+	}
+
+	table.HighlightDuplicates(1)
+	if got := table.Duplicates(); len(got) != 2 || got[0] != 1 || got[1] != 3 {
+		t.Fatalf("expected rows 1 and 3, got %v", got)
+	}
+
+	next := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("D")}
+	table, _ = table.Update(next)
+	if table.Cursor() != 1 {
+		t.Fatalf("expected to jump to row 1, got %d", table.Cursor())
+	}
+	table, _ = table.Update(next)
+	table, _ = table.Update(next)
+	if table.Cursor() != 1 {
+		t.Fatalf("expected to wrap around to row 1, got %d", table.Cursor())
+	}
+
+	table.SetCell(3, 1, "cy@x.org")
+	if len(table.Duplicates()) != 0 {
+		t.Fatalf("expected duplicates to be updated, got %v", table.Duplicates())
+	}
+
+	table.HighlightDuplicates()
+	table.SetRows([]Row{{"a", "b"}, {"a", "b"}, {"a", "c"}})
+	if got := table.Duplicates(); len(got) != 2 {
+		t.Fatalf("expected whole rows to be compared, got %v", got)
+	}
+}
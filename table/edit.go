@@ -0,0 +1,282 @@
+package table
+
+import (
+	"github.com/charmbracelet/bubbles/event"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RowAddedMsg is sent when the user has added a row.
+type RowAddedMsg struct {
+	// ID is the ID of the table the row was added to.
+	ID int
+
+	// Index is the index of the row in the table's rows.
+	Index int
+
+	// Row is the added row.
+	Row Row
+}
+
+// cellEdit is a cell being edited.
+type cellEdit struct {
+	// row is the index of the row in rows and col the index of the column.
+	row, col int
+
+	input textinput.Model
+	old   string
+
+	// adding is set if the row is being added. It's removed again if the
+	// edit is canceled.
+	adding bool
+}
+
+// WithEditable lets the user edit cells and add rows with the EditCell and
+// AddRow bindings. Columns can be excluded with Column.ReadOnly.
+func WithEditable(v bool) Option {
+	return func(m *Model) {
+		m.editable = v
+		m.KeyMap.EditCell.SetEnabled(v)
+		m.KeyMap.AddRow.SetEnabled(v)
+	}
+}
+
+// WithRowTemplate sets the row inserted when the user adds a row. By default,
+// an empty row is inserted.
+func WithRowTemplate(r Row) Option {
+	return func(m *Model) {
+		m.template = r
+	}
+}
+
+// Editing returns whether a cell is being edited.
+func (m Model) Editing() bool {
+	return m.editing != nil
+}
+
+// EditCell starts editing the selected cell, or the first editable cell of
+// the selected row if the selected cell isn't editable. Enter saves the new
+// value, sending an event.EditedMsg if it changed, and escape discards it.
+func (m *Model) EditCell() tea.Cmd {
+	if m.cursor < 0 || m.cursor >= len(m.order) || m.rowDisabled(m.cursor) {
+		return nil
+	}
+	col := m.colCursor
+	if !m.cellEditable(col) {
+		col = m.nextEditable(-1, 1)
+	}
+	cmd, _ := m.startEdit(m.order[m.cursor], col, false)
+	return cmd
+}
+
+// AddRow inserts a copy of the row template below the selected row and starts
+// editing its first editable cell. Tab and shift+tab move between the cells of
+// the new row. Enter adds the row, sending a RowAddedMsg, and escape removes
+// it again.
+func (m *Model) AddRow() tea.Cmd {
+	i := m.scrollingRows()
+	if m.cursor >= 0 && m.cursor < len(m.order) {
+		i = m.order[m.cursor] + 1
+	}
+
+	row := make(Row, len(m.cols))
+	copy(row, m.template)
+	m.InsertRow(i, row)
+
+	cmd, ok := m.startEdit(i, m.nextEditable(-1, 1), true)
+	if !ok {
+		m.RemoveRow(i)
+	}
+	return cmd
+}
+
+// InsertRow inserts a row at the given index in the table's rows. The
+// selected row stays selected.
+func (m *Model) InsertRow(i int, r Row) {
+	i = clamp(i, 0, len(m.rows))
+
+	selected := -1
+	if m.cursor >= 0 && m.cursor < len(m.order) {
+		selected = m.order[m.cursor]
+		if selected >= i {
+			selected++
+		}
+	}
+
+	rows := make([]Row, 0, len(m.rows)+1)
+	rows = append(rows, m.rows[:i]...)
+	rows = append(rows, r)
+	m.rows = append(rows, m.rows[i:]...)
+	m.disabled = insertIndex(m.disabled, i)
+	m.marked = insertIndex(m.marked, i)
+
+	m.recalc()
+	m.findDuplicates()
+	m.reorder()
+	m.selectIndex(selected)
+	m.UpdateViewport()
+}
+
+// selectIndex moves the cursor to the row at the given index in rows, if it's
+// shown.
+func (m *Model) selectIndex(i int) {
+	for pos, j := range m.order {
+		if j == i {
+			m.cursor = pos
+			return
+		}
+	}
+}
+
+// insertIndex returns a set of row indices with the indices from the given
+// one on shifted up by one.
+func insertIndex(set map[int]bool, i int) map[int]bool {
+	if len(set) == 0 {
+		return set
+	}
+	shifted := make(map[int]bool, len(set))
+	for j := range set {
+		if j >= i {
+			j++
+		}
+		shifted[j] = true
+	}
+	return shifted
+}
+
+// startEdit starts editing a cell. It returns false if the cell can't be
+// edited.
+func (m *Model) startEdit(row, col int, adding bool) (tea.Cmd, bool) {
+	if !m.cellEditable(col) || row < 0 || row >= len(m.rows) {
+		return nil, false
+	}
+
+	old := ""
+	if col < len(m.rows[row]) {
+		old = m.rows[row][col]
+	}
+	input := textinput.New()
+	input.Prompt = ""
+	input.Width = max(1, m.colWidth(col)-1)
+	input.SetValue(old)
+	input.CursorEnd()
+	cmd := input.Focus()
+
+	m.editing = &cellEdit{row: row, col: col, input: input, old: old, adding: adding}
+	if adding {
+		// Show the new row even if it doesn't match the filters.
+		m.reorder()
+	}
+	m.selectIndex(row)
+	m.colCursor = col
+	m.UpdateViewport()
+	return cmd, true
+}
+
+// handleEdit handles messages while a cell is being edited. It returns
+// whether the message was consumed.
+func (m *Model) handleEdit(msg tea.Msg) (bool, tea.Cmd) {
+	e := m.editing
+	if e == nil {
+		return false, nil
+	}
+
+	if keyMsg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(keyMsg, m.KeyMap.CommitEdit):
+			return true, m.commitEdit()
+		case key.Matches(keyMsg, m.KeyMap.CancelEdit):
+			m.cancelEdit()
+			return true, nil
+		case e.adding && key.Matches(keyMsg, m.KeyMap.NextField):
+			return true, m.moveEdit(1)
+		case e.adding && key.Matches(keyMsg, m.KeyMap.PrevField):
+			return true, m.moveEdit(-1)
+		}
+	}
+
+	var cmd tea.Cmd
+	e.input, cmd = e.input.Update(msg)
+	m.UpdateViewport()
+	return true, cmd
+}
+
+// moveEdit saves the cell of a row being added and moves on to the next
+// editable cell in the given direction, if any.
+func (m *Model) moveEdit(dir int) tea.Cmd {
+	e := m.editing
+	col := m.nextEditable(e.col, dir)
+	if col == e.col {
+		return nil
+	}
+	m.setCell(e.row, e.col, e.input.Value())
+	m.editing = nil
+	cmd, _ := m.startEdit(e.row, col, true)
+	return cmd
+}
+
+// commitEdit saves the edited value and ends editing.
+func (m *Model) commitEdit() tea.Cmd {
+	e := m.editing
+	m.editing = nil
+	value := e.input.Value()
+	m.setCell(e.row, e.col, value)
+
+	if e.adding {
+		m.reselect()
+		row := append(Row(nil), m.rows[e.row]...)
+		return event.Cmd(RowAddedMsg{ID: m.id, Index: e.row, Row: row})
+	}
+	if value == e.old {
+		return nil
+	}
+	return event.Cmd(event.EditedMsg{
+		ID:     m.id,
+		Row:    e.row,
+		Column: e.col,
+		Old:    e.old,
+		Value:  value,
+	})
+}
+
+// cancelEdit ends editing without saving, removing a row being added.
+func (m *Model) cancelEdit() {
+	e := m.editing
+	m.editing = nil
+	if e.adding {
+		m.RemoveRow(e.row)
+		return
+	}
+	m.UpdateViewport()
+}
+
+// setCell sets the value of a cell, growing the row if it's too short.
+func (m *Model) setCell(row, col int, value string) {
+	if len(m.rows[row]) <= col {
+		m.copyRow(row, col+1)
+	}
+	m.SetCell(row, col, value)
+}
+
+// cellEditable returns whether cells in the given column can be edited.
+func (m Model) cellEditable(col int) bool {
+	return m.editable && col >= 0 && col < len(m.cols) && !m.cols[col].Hidden && !m.cols[col].ReadOnly
+}
+
+// nextEditable returns the index of the next editable column after the given
+// one in the given direction, or the given column if there's none.
+func (m Model) nextEditable(col, dir int) int {
+	for i := col + dir; i >= 0 && i < len(m.cols); i += dir {
+		if m.cellEditable(i) {
+			return i
+		}
+	}
+	return col
+}
+
+// editView renders the input of the cell being edited.
+func (m Model) editView(width int) string {
+	return lipgloss.NewStyle().Width(width).MaxWidth(width).Inline(true).Render(m.editing.input.View())
+}
@@ -0,0 +1,126 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/accessibility"
+	"github.com/charmbracelet/bubbles/bubbletest"
+	"github.com/charmbracelet/bubbles/event"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var editFixture = []Option{
+	WithColumns([]Column{{Title: "ID", Width: 4, ReadOnly: true}, {Title: "Name", Width: 8}, {Title: "Age", Width: 4}}),
+	WithRows([]Row{{"1", "ann", "30"}, {"2", "bob", "40"}}),
+	WithFocused(true),
+	WithEditable(true),
+}
+
+func typeText(m Model, s string) Model {
+	for _, r := range s {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return m
+}
+
+func TestAddRowReducedMotion(t *testing.T) {
+	accessibility.SetReducedMotion(true)
+	defer accessibility.SetReducedMotion(false)
+
+	table := testTable(editFixture)
+	table.SetCursor(0)
+	table.AddRow()
+	if !table.Editing() || len(table.rows) != 3 {
+		t.Fatalf("expected a row to be added and edited, got %v", table.rows)
+	}
+
+	table = typeText(table, "x")
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if table.rows[1][1] != "x" || table.rows[2][1] != "bob" {
+		t.Fatalf("expected the new row to be added before bob, got %v", table.rows)
+	}
+}
+
+func TestAddRow(t *testing.T) {
+	table := testTable(editFixture, WithRowTemplate(Row{"new"}))
+
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	if !table.Editing() || len(table.rows) != 3 {
+		t.Fatalf("expected a row to be added and edited, got %d rows", len(table.rows))
+	}
+	if got := table.SelectedRow(); got[0] != "new" {
+		t.Fatalf("expected the new row from the template to be selected, got %v", got)
+	}
+
+	table = typeText(table, "cy")
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyTab})
+	table = typeText(table, "50")
+	table, cmd := table.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if table.Editing() {
+		t.Fatal("expected editing to end")
+	}
+
+	var added *RowAddedMsg
+	for _, msg := range bubbletest.Collect(cmd) {
+		if msg, ok := msg.(RowAddedMsg); ok {
+			added = &msg
+		}
+	}
+	if added == nil {
+		t.Fatal("expected a RowAddedMsg")
+	}
+	if added.Index != 1 || added.Row[1] != "cy" || added.Row[2] != "50" {
+		t.Fatalf("unexpected RowAddedMsg %+v", *added)
+	}
+}
+
+func TestAddRowCanceled(t *testing.T) {
+	table := testTable(editFixture)
+	table.SetCursor(1)
+
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	table = typeText(table, "cy")
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if table.Editing() || len(table.rows) != 2 {
+		t.Fatalf("expected the new row to be discarded, got %d rows", len(table.rows))
+	}
+	if table.Cursor() != 1 {
+		t.Fatalf("expected the cursor to go back, got %d", table.Cursor())
+	}
+}
+
+func TestAddRowFiltered(t *testing.T) {
+	table := testTable(editFixture)
+	table.SetColumnFilter(1, "ann")
+
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	if got := table.SelectedRow(); got[1] != "" {
+		t.Fatalf("expected the new row to be shown while it's edited, got %v", got)
+	}
+}
+
+func TestEditCell(t *testing.T) {
+	table := testTable(editFixture)
+
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("e")})
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	table = typeText(table, "e")
+	table, cmd := table.Update(tea.KeyMsg{Type: tea.KeyEnter})
+
+	msgs := bubbletest.Collect(cmd)
+	if len(msgs) != 1 {
+		t.Fatalf("expected an EditedMsg, got %v", msgs)
+	}
+	want := event.EditedMsg{ID: table.ID(), Row: 0, Column: 1, Old: "ann", Value: "ane"}
+	if got, ok := msgs[0].(event.EditedMsg); !ok || got != want {
+		t.Fatalf("expected %+v, got %+v", want, msgs[0])
+	}
+}
+
+func TestEditDisabledByDefault(t *testing.T) {
+	table := testTable(editFixture, WithEditable(false))
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("o")})
+	if table.Editing() || len(table.rows) != 2 {
+		t.Fatal("expected rows not to be added without editing")
+	}
+}
@@ -0,0 +1,121 @@
+package table
+
+import (
+	"strings"
+
+	"github.com/sahilm/fuzzy"
+)
+
+// WithFilter only shows the rows containing the given text.
+func WithFilter(s string) Option {
+	return func(m *Model) {
+		m.filter = s
+	}
+}
+
+// SetFilter only shows the rows containing the given text in any of their
+// cells, ignoring case, or matching it if it starts with "re:", see
+// RegexFilter. Filters starting with ! exclude the rows that match the rest
+// instead, like "!error". An empty filter shows all rows. The cursor moves
+// according to the selection policy; by default, the selected row stays
+// selected if it matches the filter.
+func (m *Model) SetFilter(s string) {
+	m.filter = s
+	m.reselect()
+}
+
+// Filter returns the current filter.
+func (m Model) Filter() string {
+	return m.filter
+}
+
+// MatchRows only shows the rows for which the predicate returns true and
+// returns their indices in the table's rows. It lets parent models filter the
+// table from their own inputs while the table keeps handling the cursor and
+// scrolling. The predicate is combined with the text filter, if any. Frozen
+// rows are never filtered and aren't included. Pass nil to show all rows
+// again.
+func (m *Model) MatchRows(predicate func(Row) bool) []int {
+	m.match = predicate
+	m.reselect()
+
+	indices := make([]int, 0, len(m.order))
+	for i := 0; i < m.scrollingRows(); i++ {
+		if m.matchesFilter(i) {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// VisibleRows returns the rows that pass the filters, in the order they're
+// displayed.
+func (m Model) VisibleRows() []Row {
+	rows := make([]Row, len(m.order))
+	for pos, i := range m.order {
+		rows[pos] = m.rows[i]
+	}
+	return rows
+}
+
+// MatchFuzzy returns a predicate for MatchRows that matches rows whose cell
+// in the given column fuzzy matches the pattern, like the list's filter does.
+func MatchFuzzy(col int, pattern string) func(Row) bool {
+	return func(r Row) bool {
+		if pattern == "" {
+			return true
+		}
+		if col < 0 || col >= len(r) {
+			return false
+		}
+		return len(fuzzy.Find(pattern, []string{r[col]})) > 0
+	}
+}
+
+// matchesFilter returns whether the row at the given index matches the
+// filters.
+func (m Model) matchesFilter(row int) bool {
+	if m.editing != nil && m.editing.adding && m.editing.row == row {
+		return true
+	}
+	if m.match != nil && !m.match(m.rows[row]) {
+		return false
+	}
+	if !m.matchesColumnFilters(row) {
+		return false
+	}
+	filter, exclude := splitNegation(m.filter)
+	if filter == "" {
+		return true
+	}
+	return m.rowMatches(row, filter) != exclude
+}
+
+// rowMatches returns whether any cell of the row at the given index matches
+// the table-wide filter, without its !.
+func (m Model) rowMatches(row int, filter string) bool {
+	if strings.HasPrefix(filter, regexPrefix) {
+		values := make([]string, len(m.rows[row]))
+		for col := range values {
+			values[col] = m.CellValue(row, col)
+		}
+		matched, _ := m.matchesRegex(filter, values...)
+		return matched
+	}
+	filter = strings.ToLower(filter)
+	for col := range m.rows[row] {
+		if strings.Contains(strings.ToLower(m.CellValue(row, col)), filter) {
+			return true
+		}
+	}
+	return false
+}
+
+// splitNegation splits the ! off the start of a filter that excludes rows.
+// Filters starting with != aren't exclusions but comparisons, see Kind.
+func splitNegation(filter string) (rest string, exclude bool) {
+	if strings.HasPrefix(filter, "!") && !strings.HasPrefix(filter, "!=") {
+		return filter[1:], true
+	}
+	return filter, false
+}
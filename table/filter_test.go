@@ -0,0 +1,100 @@
+package table
+
+import "testing"
+
+func TestMatchRows(t *testing.T) {
+	table := testTable(filesFixture)
+	table.SetCursor(2)
+
+	indices := table.MatchRows(func(r Row) bool { return r[1] == "file" })
+	if len(indices) != 2 || indices[0] != 0 || indices[1] != 2 {
+		t.Fatalf("expected indices [0 2], got %v", indices)
+	}
+	if got := table.SelectedRow()[0]; got != "go.mod" {
+		t.Fatalf("expected selection to be kept, got %q", got)
+	}
+
+	rows := table.VisibleRows()
+	if len(rows) != 2 || rows[1][0] != "go.mod" {
+		t.Fatalf("unexpected visible rows %v", rows)
+	}
+
+	table.SetFilter("main")
+	if rows := table.VisibleRows(); len(rows) != 1 || rows[0][0] != "main.go" {
+		t.Fatalf("expected filters to be combined, got %v", rows)
+	}
+
+	table.SetFilter("")
+	table.MatchRows(nil)
+	if rows := table.VisibleRows(); len(rows) != 4 {
+		t.Fatalf("expected all rows, got %v", rows)
+	}
+}
+
+func TestMatchRowsFrozen(t *testing.T) {
+	table := testTable(filesFixture, WithFrozenRows(1))
+
+	indices := table.MatchRows(func(r Row) bool { return r[1] == "dir" })
+	if len(indices) != 1 || indices[0] != 1 {
+		t.Fatalf("expected indices [1] without the frozen row, got %v", indices)
+	}
+}
+
+func TestSelectedRowFilteredOut(t *testing.T) {
+	table := testTable(filesFixture)
+	table.SetFilter("nothing matches")
+	if row := table.SelectedRow(); row != nil {
+		t.Fatalf("expected no selected row, got %v", row)
+	}
+}
+
+func TestMatchFuzzy(t *testing.T) {
+	table := testTable(filesFixture)
+	indices := table.MatchRows(MatchFuzzy(0, "gmd"))
+	if len(indices) != 1 || indices[0] != 2 {
+		t.Fatalf("expected go.mod to match, got %v", indices)
+	}
+}
+
+func TestExclusionFilter(t *testing.T) {
+	table := testTable(filesFixture)
+
+	table.SetFilter("!go")
+	if rows := table.VisibleRows(); len(rows) != 2 || rows[0][0] != "internal" || rows[1][0] != "cmd" {
+		t.Fatalf("expected the rows containing go to be excluded, got %v", rows)
+	}
+
+	table.SetFilter("!re:^(main|cmd)")
+	if rows := table.VisibleRows(); len(rows) != 2 || rows[0][0] != "internal" || rows[1][0] != "go.mod" {
+		t.Fatalf("expected the rows matching the regex to be excluded, got %v", rows)
+	}
+
+	table.SetFilter("!")
+	if rows := table.VisibleRows(); len(rows) != 4 {
+		t.Fatalf("expected a lone ! not to filter, got %v", rows)
+	}
+
+	table.SetFilter("")
+	table.SetColumnFilter(1, "!dir")
+	if rows := table.VisibleRows(); len(rows) != 2 || rows[0][1] != "file" || rows[1][1] != "file" {
+		t.Fatalf("expected the column filter to exclude dirs, got %v", rows)
+	}
+}
+
+func TestExclusionFilterComparison(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "N", Kind: KindNumber}}),
+		WithRows([]Row{{"1"}, {"2"}, {"3"}}),
+	)
+
+	// != is a comparison, not an exclusion of "=2".
+	table.SetColumnFilter(0, "!=2")
+	if rows := table.VisibleRows(); len(rows) != 2 || rows[0][0] != "1" || rows[1][0] != "3" {
+		t.Fatalf("expected != to compare values, got %v", rows)
+	}
+
+	table.SetColumnFilter(0, "!>=2")
+	if rows := table.VisibleRows(); len(rows) != 1 || rows[0][0] != "1" {
+		t.Fatalf("expected the comparison to be excluded, got %v", rows)
+	}
+}
@@ -0,0 +1,175 @@
+package table
+
+import "github.com/charmbracelet/bubbles/i18n"
+
+// Filter presets
+//
+// Filter presets are named sets of filters, such as "Errors" or "Mine", that
+// the user cycles through with the NextFilterPreset binding. The preset in
+// use is shown in a status line under the table. Cycling past the last
+// preset restores the filters the table had before the first one.
+
+// FilterPreset is a named set of filters.
+type FilterPreset struct {
+	Name string
+
+	// Filter is the table-wide filter, see SetFilter, and ColumnFilters the
+	// filters of columns by index, see SetColumnFilter.
+	Filter        string
+	ColumnFilters map[int]string
+}
+
+// WithFilterPresets adds filter presets the user can cycle through.
+func WithFilterPresets(presets ...FilterPreset) Option {
+	return func(m *Model) {
+		for _, p := range presets {
+			m.AddFilterPreset(p)
+		}
+	}
+}
+
+// AddFilterPreset adds a filter preset the user can cycle through, after the
+// others. It replaces the preset with the same name, if there's one.
+func (m *Model) AddFilterPreset(p FilterPreset) {
+	for i, q := range m.filterPresets {
+		if q.Name == p.Name {
+			m.filterPresets[i] = p
+			return
+		}
+	}
+	m.filterPresets = append(m.filterPresets, p)
+}
+
+// RemoveFilterPreset removes the filter preset with the given name. The
+// filters it set stay as they are.
+func (m *Model) RemoveFilterPreset(name string) {
+	for i, p := range m.filterPresets {
+		if p.Name == name {
+			m.filterPresets = append(m.filterPresets[:i:i], m.filterPresets[i+1:]...)
+			return
+		}
+	}
+}
+
+// FilterPresets returns the filter presets.
+func (m Model) FilterPresets() []FilterPreset {
+	return m.filterPresets
+}
+
+// ApplyFilterPreset sets the filters of the preset with the given name,
+// replacing the table's filters. It returns false if there's no such preset.
+func (m *Model) ApplyFilterPreset(name string) bool {
+	for _, p := range m.filterPresets {
+		if p.Name == name {
+			m.setFilters(p)
+			return true
+		}
+	}
+	return false
+}
+
+// ActiveFilterPreset returns the preset whose filters the table has, if any.
+// Changing the filters afterwards makes the preset inactive.
+func (m Model) ActiveFilterPreset() (FilterPreset, bool) {
+	if i, ok := m.activeFilterPreset(); ok {
+		return m.filterPresets[i], true
+	}
+	return FilterPreset{}, false
+}
+
+// NextFilterPreset applies the next filter preset, or restores the filters
+// the table had before the first preset after the last one.
+func (m *Model) NextFilterPreset() {
+	if len(m.filterPresets) == 0 {
+		return
+	}
+
+	i, ok := m.activeFilterPreset()
+	if !ok {
+		before := m.currentFilters()
+		m.presetBefore = &before
+		i = -1
+	}
+
+	if i+1 < len(m.filterPresets) {
+		m.setFilters(m.filterPresets[i+1])
+		return
+	}
+	var before FilterPreset
+	if m.presetBefore != nil {
+		before = *m.presetBefore
+	}
+	m.presetBefore = nil
+	m.setFilters(before)
+}
+
+// activeFilterPreset returns the index of the preset whose filters the table
+// has.
+func (m Model) activeFilterPreset() (int, bool) {
+	current := m.currentFilters()
+	for i, p := range m.filterPresets {
+		if p.Filter != current.Filter {
+			continue
+		}
+		if equalFilters(m.presetColumnFilters(p), current.ColumnFilters) {
+			return i, true
+		}
+	}
+	return 0, false
+}
+
+// currentFilters returns the table's filters as an unnamed preset.
+func (m Model) currentFilters() FilterPreset {
+	filters := make(map[int]string, len(m.columnFilters))
+	for col, f := range m.columnFilters {
+		filters[col] = f
+	}
+	return FilterPreset{Filter: m.filter, ColumnFilters: filters}
+}
+
+// setFilters replaces the table's filters with the ones of a preset.
+func (m *Model) setFilters(p FilterPreset) {
+	m.filter = p.Filter
+	m.columnFilters = m.presetColumnFilters(p)
+	m.reselect()
+}
+
+// presetColumnFilters returns the column filters of a preset that apply to
+// the table's columns.
+func (m Model) presetColumnFilters(p FilterPreset) map[int]string {
+	var filters map[int]string
+	for col, f := range p.ColumnFilters {
+		if f == "" || col < 0 || col >= len(m.cols) {
+			continue
+		}
+		if filters == nil {
+			filters = make(map[int]string)
+		}
+		filters[col] = f
+	}
+	return filters
+}
+
+// equalFilters returns whether two sets of column filters are the same.
+func equalFilters(a, b map[int]string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for col, f := range a {
+		if g, ok := b[col]; !ok || g != f {
+			return false
+		}
+	}
+	return true
+}
+
+// filterPresetView renders the status line with the active filter preset, or
+// returns an empty string if there's none.
+func (m Model) filterPresetView() string {
+	i, ok := m.activeFilterPreset()
+	if !ok {
+		return ""
+	}
+	s := i18n.Format("filter preset: %s (%d/%d)", m.filterPresets[i].Name, i+1, len(m.filterPresets))
+	return m.clip(m.styles.StatusBar.Render(s))
+}
@@ -0,0 +1,73 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/bubbletest"
+)
+
+func TestFilterPresets(t *testing.T) {
+	table := testTable(filesFixture)
+	table.Focus()
+	table.SetFilter("i")
+	table.AddFilterPreset(FilterPreset{Name: "Files", ColumnFilters: map[int]string{1: "file"}})
+	table.AddFilterPreset(FilterPreset{Name: "No Go", Filter: "!go"})
+
+	h := bubbletest.New(t, table)
+	h.Press("F")
+	table = h.Model().(Model)
+	if p, ok := table.ActiveFilterPreset(); !ok || p.Name != "Files" {
+		t.Fatalf("expected the first preset to be active, got %+v", p)
+	}
+	if rows := table.VisibleRows(); len(rows) != 2 || table.Filter() != "" {
+		t.Fatalf("expected the preset to replace the filters, got %v", rows)
+	}
+	if !strings.Contains(h.View(), "filter preset: Files (1/2)") {
+		t.Fatalf("expected the preset in the status line:\n%s", h.View())
+	}
+
+	h.Press("F")
+	table = h.Model().(Model)
+	if p, _ := table.ActiveFilterPreset(); p.Name != "No Go" || table.ColumnFilter(1) != "" {
+		t.Fatalf("expected the second preset to be active, got %+v", p)
+	}
+
+	// Cycling past the last preset restores the filters from before.
+	h.Press("F")
+	table = h.Model().(Model)
+	if _, ok := table.ActiveFilterPreset(); ok || table.Filter() != "i" {
+		t.Fatalf("expected the filters to be restored, got %q", table.Filter())
+	}
+	if strings.Contains(h.View(), "filter preset") {
+		t.Fatalf("expected no preset in the status line:\n%s", h.View())
+	}
+}
+
+func TestFilterPresetChanged(t *testing.T) {
+	table := testTable(filesFixture, WithFilterPresets(
+		FilterPreset{Name: "Dirs", ColumnFilters: map[int]string{1: "dir", 5: "ignored"}},
+	))
+	if !table.ApplyFilterPreset("Dirs") || table.ApplyFilterPreset("Other") {
+		t.Fatal("expected only existing presets to be applied")
+	}
+	if _, ok := table.ActiveFilterPreset(); !ok {
+		t.Fatal("expected the preset to be active")
+	}
+
+	// Changing the filters makes the preset inactive.
+	table.SetColumnFilter(0, "cmd")
+	if _, ok := table.ActiveFilterPreset(); ok {
+		t.Fatal("expected the preset to be inactive")
+	}
+
+	table.AddFilterPreset(FilterPreset{Name: "Dirs", Filter: "x"})
+	table.RemoveFilterPreset("Other")
+	if presets := table.FilterPresets(); len(presets) != 1 || presets[0].Filter != "x" {
+		t.Fatalf("expected the preset to be replaced, got %+v", presets)
+	}
+	table.RemoveFilterPreset("Dirs")
+	if len(table.FilterPresets()) != 0 {
+		t.Fatal("expected the preset to be removed")
+	}
+}
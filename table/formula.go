@@ -0,0 +1,608 @@
+package table
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Formulas
+//
+// When formulas are enabled, cells whose value begins with "=" are evaluated
+// like spreadsheet formulas. Cells are referenced in A1 notation, where the
+// letters are the column and the number is the row, both relative to the
+// table's rows (that is, A1 is the first column of the first row, not the
+// header). References are absolute positions in the table's rows: they aren't
+// rewritten when rows are inserted or removed, so after InsertRow or RemoveRow
+// a formula refers to whichever row is now at that position. Supported are:
+//
+//	=B2*1.2          arithmetic with + - * / and parentheses
+//	=SUM(B2:B10)     SUM, AVG (or AVERAGE), MIN, MAX and COUNT over ranges
+//	=MAX(A1, B1, 10) and over lists of values
+//
+// Errors render as #REF! (reference outside of the table), #VALUE! (a
+// referenced cell isn't a number), #DIV/0!, #NAME? (unknown function),
+// #CYCLE! (circular reference) and #ERROR! (syntax error).
+
+// WithFormulas enables or disables formula evaluation.
+func WithFormulas(v bool) Option {
+	return func(m *Model) {
+		m.formulas = v
+	}
+}
+
+// SetFormulas enables or disables formula evaluation.
+func (m *Model) SetFormulas(v bool) {
+	m.formulas = v
+	m.recalc()
+	m.UpdateViewport()
+}
+
+// Formulas returns whether formula evaluation is enabled.
+func (m Model) Formulas() bool {
+	return m.formulas
+}
+
+// CellValue returns the value of the cell at the given row and column as it's
+// displayed, that is, with formulas evaluated.
+func (m Model) CellValue(row, col int) string {
+	if row < 0 || row >= len(m.rows) || col < 0 || col >= len(m.rows[row]) {
+		return ""
+	}
+	v := m.rows[row][col]
+	if m.sheet != nil && isFormula(v) {
+		return m.sheet.eval(cellRef{row, col}).String()
+	}
+	return v
+}
+
+// SetCell sets the raw value of a cell. If formulas are enabled, all formulas
+// depending on the cell are recalculated. The rows passed to SetRows aren't
+// modified.
+func (m *Model) SetCell(row, col int, value string) {
+	if row < 0 || row >= len(m.rows) || col < 0 || col >= len(m.rows[row]) {
+		return
+	}
+	m.copyRow(row, len(m.rows[row]))
+	m.rows[row][col] = value
+	if m.sheet != nil {
+		m.sheet.update(cellRef{row, col})
+	}
+	m.findDuplicates()
+	m.UpdateViewport()
+}
+
+// copyRow replaces the rows and the row at the given index with copies, so
+// that writing to them doesn't modify the caller's rows. The copied row is
+// grown to at least n cells.
+func (m *Model) copyRow(row, n int) {
+	rows := make([]Row, len(m.rows))
+	copy(rows, m.rows)
+	r := make(Row, max(n, len(rows[row])))
+	copy(r, rows[row])
+	rows[row] = r
+	m.rows = rows
+	if m.sheet != nil {
+		m.sheet.rows = rows
+	}
+}
+
+// recalc rebuilds the formula sheet from scratch.
+func (m *Model) recalc() {
+	if !m.formulas {
+		m.sheet = nil
+		return
+	}
+	m.sheet = newSheet(m.rows)
+}
+
+func (m Model) formulaBarView() string {
+	row, col := m.SelectedCell()
+	var ref, raw string
+	if row >= 0 && row < len(m.rows) && col < len(m.rows[row]) {
+		ref = cellRef{row, col}.String()
+		raw = m.rows[row][col]
+	}
+	return m.clip(m.styles.FormulaBar.Render(ref) + raw)
+}
+
+func isFormula(v string) bool {
+	return len(v) > 1 && v[0] == '='
+}
+
+// cellRef identifies a cell by its row and column index.
+type cellRef struct {
+	row, col int
+}
+
+// String returns the reference in A1 notation.
+func (c cellRef) String() string {
+	return columnName(c.col) + strconv.Itoa(c.row+1)
+}
+
+// columnName returns the spreadsheet-style name of the column at index i:
+// A through Z, then AA, AB and so on.
+func columnName(i int) string {
+	var name []byte
+	for i++; i > 0; i = (i - 1) / 26 {
+		name = append([]byte{byte('A' + (i-1)%26)}, name...)
+	}
+	return string(name)
+}
+
+type formulaError string
+
+func (e formulaError) Error() string {
+	return string(e)
+}
+
+const (
+	errRef    = formulaError("#REF!")
+	errValue  = formulaError("#VALUE!")
+	errDiv0   = formulaError("#DIV/0!")
+	errName   = formulaError("#NAME?")
+	errCycle  = formulaError("#CYCLE!")
+	errSyntax = formulaError("#ERROR!")
+)
+
+type result struct {
+	value float64
+	err   error
+}
+
+func (r result) String() string {
+	if r.err != nil {
+		return r.err.Error()
+	}
+	return strconv.FormatFloat(r.value, 'g', 10, 64)
+}
+
+// sheet evaluates formulas and caches their results. It records which cells
+// each formula reads, so that when a cell changes only the formulas depending
+// on it are recalculated.
+type sheet struct {
+	rows     []Row
+	cols     int
+	results  map[cellRef]result
+	deps     map[cellRef][]cellRef
+	visiting map[cellRef]bool
+}
+
+func newSheet(rows []Row) *sheet {
+	s := &sheet{
+		rows:     rows,
+		results:  make(map[cellRef]result),
+		deps:     make(map[cellRef][]cellRef),
+		visiting: make(map[cellRef]bool),
+	}
+	for _, row := range rows {
+		s.cols = max(s.cols, len(row))
+	}
+	for r, row := range rows {
+		for c, v := range row {
+			if isFormula(v) {
+				s.eval(cellRef{r, c})
+			}
+		}
+	}
+	return s
+}
+
+// update recalculates the given cell, if it's a formula, and every formula
+// that depends on it, directly or indirectly.
+func (s *sheet) update(changed cellRef) {
+	affected := s.dependents(changed)
+	for _, c := range affected {
+		delete(s.results, c)
+		delete(s.deps, c)
+	}
+	for _, c := range affected {
+		if isFormula(s.raw(c)) {
+			s.eval(c)
+		}
+	}
+}
+
+// dependents returns the given cell followed by all cells that depend on it.
+func (s *sheet) dependents(c cellRef) []cellRef {
+	reverse := make(map[cellRef][]cellRef)
+	for f, deps := range s.deps {
+		for _, d := range deps {
+			reverse[d] = append(reverse[d], f)
+		}
+	}
+
+	seen := map[cellRef]bool{c: true}
+	queue := []cellRef{c}
+	for i := 0; i < len(queue); i++ {
+		for _, f := range reverse[queue[i]] {
+			if !seen[f] {
+				seen[f] = true
+				queue = append(queue, f)
+			}
+		}
+	}
+	return queue
+}
+
+func (s *sheet) raw(c cellRef) string {
+	if c.row < 0 || c.row >= len(s.rows) || c.col < 0 || c.col >= len(s.rows[c.row]) {
+		return ""
+	}
+	return s.rows[c.row][c.col]
+}
+
+func (s *sheet) eval(c cellRef) result {
+	if r, ok := s.results[c]; ok {
+		return r
+	}
+	if s.visiting[c] {
+		return result{err: errCycle}
+	}
+
+	s.visiting[c] = true
+	p := parser{sheet: s, src: s.raw(c)[1:]}
+	v, err := p.parse()
+	delete(s.visiting, c)
+
+	r := result{value: v, err: err}
+	s.results[c] = r
+	s.deps[c] = p.refs
+	return r
+}
+
+// number returns the numeric value of a cell. It returns ok == false for
+// empty cells and cells that aren't numbers.
+func (s *sheet) number(c cellRef) (v float64, ok bool, err error) {
+	raw := s.raw(c)
+	if isFormula(raw) {
+		r := s.eval(c)
+		return r.value, r.err == nil, r.err
+	}
+	v, perr := strconv.ParseFloat(strings.TrimSpace(raw), 64)
+	return v, perr == nil, nil
+}
+
+func (s *sheet) inBounds(c cellRef) bool {
+	return c.row >= 0 && c.row < len(s.rows) && c.col >= 0 && c.col < len(s.rows[c.row])
+}
+
+// parser is a recursive descent parser that evaluates a formula as it goes.
+type parser struct {
+	sheet *sheet
+	src   string
+	pos   int
+	refs  []cellRef
+}
+
+func (p *parser) parse() (float64, error) {
+	v, err := p.expr()
+	if err != nil {
+		return 0, err
+	}
+	p.skipSpace()
+	if p.pos < len(p.src) {
+		return 0, errSyntax
+	}
+	return v, nil
+}
+
+func (p *parser) expr() (float64, error) {
+	v, err := p.term()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		switch p.peek() {
+		case '+':
+			p.pos++
+			r, err := p.term()
+			if err != nil {
+				return 0, err
+			}
+			v += r
+		case '-':
+			p.pos++
+			r, err := p.term()
+			if err != nil {
+				return 0, err
+			}
+			v -= r
+		default:
+			return v, nil
+		}
+	}
+}
+
+func (p *parser) term() (float64, error) {
+	v, err := p.unary()
+	if err != nil {
+		return 0, err
+	}
+	for {
+		switch p.peek() {
+		case '*':
+			p.pos++
+			r, err := p.unary()
+			if err != nil {
+				return 0, err
+			}
+			v *= r
+		case '/':
+			p.pos++
+			r, err := p.unary()
+			if err != nil {
+				return 0, err
+			}
+			if r == 0 {
+				return 0, errDiv0
+			}
+			v /= r
+		default:
+			return v, nil
+		}
+	}
+}
+
+func (p *parser) unary() (float64, error) {
+	switch p.peek() {
+	case '-':
+		p.pos++
+		v, err := p.unary()
+		return -v, err
+	case '+':
+		p.pos++
+		return p.unary()
+	}
+	return p.primary()
+}
+
+func (p *parser) primary() (float64, error) {
+	ch := p.peek()
+	switch {
+	case ch == '(':
+		p.pos++
+		v, err := p.expr()
+		if err != nil {
+			return 0, err
+		}
+		if p.peek() != ')' {
+			return 0, errSyntax
+		}
+		p.pos++
+		return v, nil
+
+	case ch == '.' || isDigit(ch):
+		start := p.pos
+		for p.pos < len(p.src) && (isDigit(p.src[p.pos]) || p.src[p.pos] == '.') {
+			p.pos++
+		}
+		v, err := strconv.ParseFloat(p.src[start:p.pos], 64)
+		if err != nil {
+			return 0, errSyntax
+		}
+		return v, nil
+
+	case isLetter(ch):
+		name := p.ident()
+		if p.peek() == '(' {
+			p.pos++
+			return p.call(strings.ToUpper(name))
+		}
+		c, ok, err := p.refAfter(name)
+		if err != nil {
+			return 0, err
+		}
+		if !ok {
+			return 0, errName
+		}
+		return p.ref(c)
+	}
+
+	return 0, errSyntax
+}
+
+// ref returns the value of a single referenced cell for use in arithmetic.
+// Empty cells count as zero.
+func (p *parser) ref(c cellRef) (float64, error) {
+	p.refs = append(p.refs, c)
+	if !p.sheet.inBounds(c) {
+		return 0, errRef
+	}
+	v, ok, err := p.sheet.number(c)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		if strings.TrimSpace(p.sheet.raw(c)) == "" {
+			return 0, nil
+		}
+		return 0, errValue
+	}
+	return v, nil
+}
+
+// call evaluates a function call. The opening parenthesis has already been
+// consumed.
+func (p *parser) call(name string) (float64, error) {
+	var values []float64
+	for {
+		if p.peek() == ')' && len(values) == 0 {
+			p.pos++
+			break
+		}
+
+		vs, err := p.arg()
+		if err != nil {
+			return 0, err
+		}
+		values = append(values, vs...)
+
+		switch p.peek() {
+		case ',':
+			p.pos++
+			continue
+		case ')':
+			p.pos++
+		default:
+			return 0, errSyntax
+		}
+		break
+	}
+
+	return aggregate(name, values)
+}
+
+// arg parses a function argument, which is either a range of cells or an
+// expression. Empty and non-numeric cells in ranges are skipped, as in most
+// spreadsheets, and so are the parts of ranges outside of the table.
+func (p *parser) arg() ([]float64, error) {
+	start := p.pos
+	from, to, ok, err := p.rangeRef()
+	if err != nil {
+		return nil, err
+	}
+	if ok {
+		var values []float64
+		lastRow := min(max(from.row, to.row), len(p.sheet.rows)-1)
+		lastCol := min(max(from.col, to.col), p.sheet.cols-1)
+		for r := min(from.row, to.row); r <= lastRow; r++ {
+			for c := min(from.col, to.col); c <= lastCol; c++ {
+				ref := cellRef{r, c}
+				p.refs = append(p.refs, ref)
+				if !p.sheet.inBounds(ref) {
+					continue
+				}
+				v, ok, err := p.sheet.number(ref)
+				if err != nil {
+					return nil, err
+				}
+				if ok {
+					values = append(values, v)
+				}
+			}
+		}
+		return values, nil
+	}
+
+	p.pos = start
+	v, err := p.expr()
+	if err != nil {
+		return nil, err
+	}
+	return []float64{v}, nil
+}
+
+// rangeRef parses a range such as B2:B10.
+func (p *parser) rangeRef() (from, to cellRef, ok bool, err error) {
+	if !isLetter(p.peek()) {
+		return from, to, false, nil
+	}
+	if from, ok, err = p.refAfter(p.ident()); err != nil || !ok || p.peek() != ':' {
+		return from, to, false, err
+	}
+	p.pos++
+	if !isLetter(p.peek()) {
+		return from, to, false, nil
+	}
+	to, ok, err = p.refAfter(p.ident())
+	return from, to, ok, err
+}
+
+// maxInt is the largest int, which bounds the column numbers of references.
+const maxInt = int(^uint(0) >> 1)
+
+// refAfter parses the row number following the column name of a cell
+// reference. It returns ok == false if there's no row number, and errRef for
+// rows and columns too large to be in any table.
+func (p *parser) refAfter(col string) (c cellRef, ok bool, err error) {
+	start := p.pos
+	for p.pos < len(p.src) && isDigit(p.src[p.pos]) {
+		p.pos++
+	}
+	if start == p.pos {
+		return c, false, nil
+	}
+	row, err := strconv.Atoi(p.src[start:p.pos])
+	if err != nil {
+		return c, false, errRef
+	}
+	if row < 1 {
+		return c, false, nil
+	}
+
+	n := 0
+	for _, r := range strings.ToUpper(col) {
+		if n > (maxInt-26)/26 {
+			return c, false, errRef
+		}
+		n = n*26 + int(r-'A') + 1
+	}
+	return cellRef{row: row - 1, col: n - 1}, true, nil
+}
+
+func (p *parser) ident() string {
+	p.skipSpace()
+	start := p.pos
+	for p.pos < len(p.src) && isLetter(p.src[p.pos]) {
+		p.pos++
+	}
+	return p.src[start:p.pos]
+}
+
+// peek skips whitespace and returns the next byte without consuming it.
+func (p *parser) peek() byte {
+	p.skipSpace()
+	if p.pos >= len(p.src) {
+		return 0
+	}
+	return p.src[p.pos]
+}
+
+func (p *parser) skipSpace() {
+	for p.pos < len(p.src) && unicode.IsSpace(rune(p.src[p.pos])) {
+		p.pos++
+	}
+}
+
+func aggregate(name string, values []float64) (float64, error) {
+	switch name {
+	case "SUM":
+		var sum float64
+		for _, v := range values {
+			sum += v
+		}
+		return sum, nil
+	case "AVG", "AVERAGE":
+		if len(values) == 0 {
+			return 0, errDiv0
+		}
+		var sum float64
+		for _, v := range values {
+			sum += v
+		}
+		return sum / float64(len(values)), nil
+	case "MIN", "MAX":
+		if len(values) == 0 {
+			return 0, nil
+		}
+		v := values[0]
+		for _, x := range values[1:] {
+			if (name == "MIN" && x < v) || (name == "MAX" && x > v) {
+				v = x
+			}
+		}
+		return v, nil
+	case "COUNT":
+		return float64(len(values)), nil
+	}
+	return 0, errName
+}
+
+func isDigit(b byte) bool {
+	return b >= '0' && b <= '9'
+}
+
+func isLetter(b byte) bool {
+	return (b >= 'A' && b <= 'Z') || (b >= 'a' && b <= 'z')
+}
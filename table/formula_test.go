@@ -0,0 +1,138 @@
+package table
+
+import "testing"
+
+func TestFormulas(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "Item"}, {Title: "Price"}, {Title: "Total"}}),
+		WithRows([]Row{
+			{"apple", "2", "=B1*3"},
+			{"pear", "4", "=B2*3"},
+			{"sum", "=SUM(B1:B2)", "=AVG(C1:C2)"},
+			{"bad", "=B1/0", "=A1+1"},
+			{"ref", "=Z99", "=FOO(B1)"},
+			{"cycle", "=C6", "=B6"},
+			{"nested", "=-(B1+B2)*2", "=MAX(B1, B2, 10) - MIN(B1:B2)"},
+		}),
+		WithFormulas(true),
+	)
+
+	tests := []struct {
+		row, col int
+		want     string
+	}{
+		{0, 2, "6"},
+		{1, 2, "12"},
+		{2, 1, "6"},
+		{2, 2, "9"},
+		{3, 1, "#DIV/0!"},
+		{3, 2, "#VALUE!"},
+		{4, 1, "#REF!"},
+		{4, 2, "#NAME?"},
+		{5, 1, "#CYCLE!"},
+		{6, 1, "-12"},
+		{6, 2, "8"},
+		{0, 0, "apple"},
+	}
+	for _, tc := range tests {
+		if got := table.CellValue(tc.row, tc.col); got != tc.want {
+			t.Errorf("%s: expected %q, got %q", cellRef{tc.row, tc.col}, tc.want, got)
+		}
+	}
+}
+
+func TestFormulaLargeReferences(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "A"}, {Title: "B"}}),
+		WithRows([]Row{
+			{"1", "=SUM(A1:A2000000000)"},
+			{"2", "=SUM(A1:ZZZZZZ1)"},
+			{"3", "=AAAAAAAAAAAAAAAAAAAA1"},
+			{"4", "=SUM(A1:AAAAAAAAAAAAAAAAAAAA1)"},
+			{"5", "=A99999999999999999999"},
+		}),
+		WithFormulas(true),
+	)
+
+	tests := []struct {
+		row, col int
+		want     string
+	}{
+		{0, 1, "15"},
+		{1, 1, "16"},
+		{2, 1, "#REF!"},
+		{3, 1, "#REF!"},
+		{4, 1, "#REF!"},
+	}
+	for _, tc := range tests {
+		if got := table.CellValue(tc.row, tc.col); got != tc.want {
+			t.Errorf("%s: expected %q, got %q", cellRef{tc.row, tc.col}, tc.want, got)
+		}
+	}
+}
+
+func TestSetCellRecalculates(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "A"}, {Title: "B"}}),
+		WithRows([]Row{
+			{"1", "=A1*2"},
+			{"=B1+1", "=A2+A1"},
+		}),
+		WithFormulas(true),
+	)
+
+	if got := table.CellValue(1, 1); got != "4" {
+		t.Fatalf("expected 4, got %q", got)
+	}
+
+	table.SetCell(0, 0, "10")
+	if got := table.CellValue(0, 1); got != "20" {
+		t.Fatalf("expected 20, got %q", got)
+	}
+	if got := table.CellValue(1, 1); got != "31" {
+		t.Fatalf("expected 31, got %q", got)
+	}
+}
+
+func TestSetCellCopiesRows(t *testing.T) {
+	rows := []Row{{"1", "=A1*2"}, {"2", "=A2*2"}}
+	table := New(
+		WithColumns([]Column{{Title: "A"}, {Title: "B"}}),
+		WithRows(rows),
+		WithFormulas(true),
+	)
+
+	table.SetCell(0, 0, "10")
+	if rows[0][0] != "1" {
+		t.Fatalf("expected the caller's rows to be unchanged, got %q", rows[0][0])
+	}
+	if got := table.CellValue(0, 1); got != "20" {
+		t.Fatalf("expected 20, got %q", got)
+	}
+
+	table.setCell(1, 2, "x")
+	if len(rows[1]) != 2 {
+		t.Fatalf("expected the caller's row to keep its length, got %d", len(rows[1]))
+	}
+	if got := table.rows[1][2]; got != "x" {
+		t.Fatalf("expected x, got %q", got)
+	}
+}
+
+func TestFormulasDisabled(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "A"}}),
+		WithRows([]Row{{"=1+1"}}),
+	)
+	if got := table.CellValue(0, 0); got != "=1+1" {
+		t.Fatalf("expected raw value, got %q", got)
+	}
+}
+
+func TestColumnName(t *testing.T) {
+	for i, want := range map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"} {
+		if got := columnName(i); got != want {
+			t.Errorf("column %d: expected %s, got %s", i, want, got)
+		}
+	}
+}
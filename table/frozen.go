@@ -0,0 +1,58 @@
+package table
+
+import "github.com/charmbracelet/lipgloss"
+
+// WithFrozenRows freezes the last n rows at the bottom of the table, for
+// example a block of totals. See SetFrozenRows.
+func WithFrozenRows(n int) Option {
+	return func(m *Model) {
+		m.frozen = max(0, n)
+	}
+}
+
+// SetFrozenRows freezes the last n rows of the table at the bottom, below the
+// scrolling rows. Frozen rows always stay in view in the order they're in,
+// can't be selected, and are left out of sorting, filtering and column
+// statistics. They take their lines from the table's height, except when the
+// body is rendered into an embedded viewport, where they're shown below it.
+func (m *Model) SetFrozenRows(n int) {
+	m.frozen = max(0, n)
+	m.findDuplicates()
+	m.reorder()
+	m.cursor = clamp(m.cursor, 0, len(m.order)-1)
+	m.skipDisabled(1)
+	m.UpdateViewport()
+}
+
+// FrozenRows returns the number of rows frozen at the bottom of the table.
+func (m Model) FrozenRows() int {
+	return m.frozen
+}
+
+// scrollingRows returns the number of rows that aren't frozen.
+func (m Model) scrollingRows() int {
+	return max(0, len(m.rows)-m.frozen)
+}
+
+// bodyHeight returns the number of lines for the scrolling rows.
+func (m Model) bodyHeight() int {
+	if m.embedded {
+		return m.viewport.Height
+	}
+	return max(0, m.viewport.Height-(len(m.rows)-m.scrollingRows()))
+}
+
+// frozenView renders the frozen rows, or returns an empty string if there are
+// none.
+func (m Model) frozenView() string {
+	start := m.scrollingRows()
+	if start == len(m.rows) {
+		return ""
+	}
+
+	rows := make([]string, 0, len(m.rows)-start)
+	for i := start; i < len(m.rows); i++ {
+		rows = append(rows, m.indicatorPadding()+m.styles.Frozen.Render(m.renderCells(i, false)))
+	}
+	return m.clip(lipgloss.JoinVertical(lipgloss.Left, rows...))
+}
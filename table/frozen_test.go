@@ -0,0 +1,44 @@
+package table
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestFrozenRows(t *testing.T) {
+	rows := make([]Row, 20)
+	for i := range rows {
+		rows[i] = Row{fmt.Sprintf("item %02d", i), fmt.Sprint(i)}
+	}
+	rows = append(rows, Row{"total", "=SUM(B1:B20)"})
+
+	table := New(
+		WithColumns([]Column{{Title: "Name", Width: 8}, {Title: "Value", Width: 6}}),
+		WithRows(rows),
+		WithFormulas(true),
+		WithFrozenRows(1),
+		WithHeight(5),
+		WithFocused(true),
+	)
+	table.SortBy(1, Descending)
+	table.GotoBottom()
+
+	if got := table.SelectedRow()[0]; got != "item 00" {
+		t.Fatalf("expected the frozen row not to be selectable, got %q", got)
+	}
+
+	lines := strings.Split(table.View(), "\n")
+	// The formula bar, the header, 4 scrolling rows and the frozen row.
+	if len(lines) != 7 {
+		t.Fatalf("expected the frozen row to take a line of the height, got %d lines:\n%s",
+			len(lines), strings.Join(lines, "\n"))
+	}
+	last := lines[len(lines)-1]
+	if !strings.Contains(last, "total") || !strings.Contains(last, "190") {
+		t.Fatalf("expected the totals at the bottom, got %q", last)
+	}
+	if !strings.Contains(lines[len(lines)-2], "item 00") {
+		t.Fatalf("expected the last scrolling row above the totals, got %q", lines[len(lines)-2])
+	}
+}
@@ -0,0 +1,106 @@
+package table
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+	"github.com/rivo/uniseg"
+)
+
+// Cell contents are measured and truncated by grapheme clusters, the
+// user-perceived characters, rather than by runes. This keeps emoji built
+// from several code points (ZWJ sequences, flags, skin tones, keycaps) and
+// letters with combining marks in one piece, and measures them the way
+// terminals draw them.
+
+const (
+	zeroWidthJoiner    = '\u200d'
+	variationSelector  = '\ufe0f'
+	regionalIndicatorA = '\U0001F1E6'
+	regionalIndicatorZ = '\U0001F1FF'
+)
+
+// clusterWidth returns the number of cells a grapheme cluster occupies.
+func clusterWidth(runes []rune) int {
+	if len(runes) == 0 {
+		return 0
+	}
+
+	// Flags are pairs of regional indicators.
+	if r := runes[0]; r >= regionalIndicatorA && r <= regionalIndicatorZ {
+		if len(runes) > 1 {
+			return 2
+		}
+		return 1
+	}
+
+	width := 0
+	for _, r := range runes {
+		if width = runewidth.RuneWidth(r); width > 0 {
+			break
+		}
+	}
+
+	// Emoji sequences and emoji presentation selectors are drawn as a
+	// single wide character.
+	if width > 0 && len(runes) > 1 {
+		for _, r := range runes[1:] {
+			if r == zeroWidthJoiner || r == variationSelector {
+				return 2
+			}
+		}
+	}
+	return width
+}
+
+// stringWidth returns the number of cells a string occupies.
+func stringWidth(s string) int {
+	width := 0
+	g := uniseg.NewGraphemes(s)
+	for g.Next() {
+		width += clusterWidth(g.Runes())
+	}
+	return width
+}
+
+// truncate cuts a string to the given width, ending it with tail if it had
+// to be cut. It never splits a grapheme cluster.
+func truncate(s string, width int, tail string) string {
+	if stringWidth(s) <= width {
+		return s
+	}
+	width -= stringWidth(tail)
+	if width < 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	w := 0
+	g := uniseg.NewGraphemes(s)
+	for g.Next() {
+		cw := clusterWidth(g.Runes())
+		if w+cw > width {
+			break
+		}
+		w += cw
+		b.WriteString(g.Str())
+	}
+	return b.String() + tail
+}
+
+// fit truncates a string to the given width and pads it with spaces to fill
+// the width exactly.
+func fit(s string, width int, align lipgloss.Position) string {
+	s = truncate(s, width, "…")
+	gap := max(0, width-stringWidth(s))
+
+	switch align {
+	case lipgloss.Right:
+		return strings.Repeat(" ", gap) + s
+	case lipgloss.Center:
+		left := gap / 2
+		return strings.Repeat(" ", left) + s + strings.Repeat(" ", gap-left)
+	}
+	return s + strings.Repeat(" ", gap)
+}
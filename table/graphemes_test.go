@@ -0,0 +1,95 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/rivo/uniseg"
+)
+
+// graphemeCorpus holds strings that are easy to split or mismeasure, along
+// with their width in cells.
+var graphemeCorpus = []struct {
+	name  string
+	s     string
+	width int
+}{
+	{"ascii", "hello", 5},
+	{"combining acute", "e\u0301e\u0301", 2},
+	{"stacked combining marks", "Z\u0324\u0354\u0367\u0311a\u0308\u0347", 2},
+	{"hangul", "한국어", 6},
+	{"hangul jamo", "\u1100\u1161\u11a8", 2},
+	{"cjk", "漢字", 4},
+	{"thai", "\u0e01\u0e48\u0e32", 2},
+	{"devanagari", "नि", 1},
+	{"emoji", "😀", 2},
+	{"emoji with skin tone", "👍🏽", 2},
+	{"zwj family", "\U0001F468\u200d\U0001F469\u200d\U0001F467\u200d\U0001F466", 2},
+	{"zwj profession", "\U0001F469\U0001F3FD\u200d\U0001F680", 2},
+	{"flags", "🇯🇵🇺🇸", 4},
+	{"emoji presentation", "\u2764\ufe0f", 2},
+	{"keycap", "1\ufe0f\u20e3", 2},
+	{"mixed", "a\U0001F468\u200d\U0001F469\u200d\U0001F467b\U0001F1E9\U0001F1EAc", 7},
+}
+
+func TestStringWidth(t *testing.T) {
+	for _, tc := range graphemeCorpus {
+		if w := stringWidth(tc.s); w != tc.width {
+			t.Errorf("%s: expected width %d, got %d", tc.name, tc.width, w)
+		}
+	}
+}
+
+func TestTruncateKeepsClusters(t *testing.T) {
+	for _, tc := range graphemeCorpus {
+		for width := 0; width <= tc.width+1; width++ {
+			got := truncate(tc.s, width, "…")
+			if w := stringWidth(got); w > width {
+				t.Errorf("%s: truncated to %d cells, got %q with width %d", tc.name, width, got, w)
+			}
+
+			// The result must be made of whole clusters of the input.
+			prefix := strings.TrimSuffix(got, "…")
+			if !strings.HasPrefix(tc.s, prefix) {
+				t.Errorf("%s: %q is not a prefix of %q", tc.name, prefix, tc.s)
+			}
+			if n := uniseg.GraphemeClusterCount(prefix); prefix != "" &&
+				uniseg.GraphemeClusterCount(tc.s[len(prefix):])+n != uniseg.GraphemeClusterCount(tc.s) {
+				t.Errorf("%s: truncating to %d cells split a cluster: %q", tc.name, width, got)
+			}
+		}
+	}
+}
+
+func TestFit(t *testing.T) {
+	for _, tc := range graphemeCorpus {
+		for _, align := range []lipgloss.Position{lipgloss.Left, lipgloss.Center, lipgloss.Right} {
+			for _, width := range []int{1, 3, 8} {
+				if w := stringWidth(fit(tc.s, width, align)); w != width {
+					t.Errorf("%s: expected fit to fill %d cells, got %d", tc.name, width, w)
+				}
+			}
+		}
+	}
+}
+
+func TestRowsStayAligned(t *testing.T) {
+	rows := make([]Row, 0, len(graphemeCorpus))
+	for _, tc := range graphemeCorpus {
+		rows = append(rows, Row{tc.s, "|"})
+	}
+	table := New(
+		WithColumns([]Column{{Title: "Text", Width: 5}, {Title: "End", Width: 1}}),
+		WithRows(rows),
+		WithStyles(Styles{}),
+		WithHeight(len(rows)),
+	)
+
+	for i := range rows {
+		line := table.renderRow(i)
+		if w := stringWidth(line); w != 6 {
+			t.Errorf("%s: expected row to be 6 cells wide, got %d: %q", graphemeCorpus[i].name, w, line)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package table
+
+import "github.com/charmbracelet/bubbles/key"
+
+// ShortHelp returns the bindings that apply to the table's current mode, so
+// the table itself can be passed to the help bubble:
+//
+//	helpView := m.help.View(m.table)
+//
+// While an action waits for confirmation only the prompt's bindings are
+// shown, while a cell is edited the bindings for saving and canceling, while
+// the cell popup is open the bindings for scrolling it, and while the quick
+// filter prompt is open the bindings for toggling regex mode, applying and
+// canceling; in cell selection mode, the bindings for moving between cells.
+// The binding for clearing column filters is only shown while there are any,
+// and the one for cycling through filter presets if there are any.
+func (m Model) ShortHelp() []key.Binding {
+	switch {
+	case m.pending != nil && !m.pending.repeat:
+		return m.confirm.KeyMap.ShortHelp()
+	case m.editing != nil && m.editing.adding:
+		return []key.Binding{m.KeyMap.NextField, m.KeyMap.PrevField, m.KeyMap.CommitEdit, m.KeyMap.CancelEdit}
+	case m.editing != nil:
+		return []key.Binding{m.KeyMap.CommitEdit, m.KeyMap.CancelEdit}
+	case m.popup.open:
+		vp := m.popup.viewport.KeyMap
+		return []key.Binding{vp.Up, vp.Down, vp.PageUp, vp.PageDown, m.KeyMap.ClosePopup}
+	case m.filtering:
+		return []key.Binding{m.KeyMap.ToggleRegex, m.KeyMap.AcceptFilter, m.KeyMap.CancelFilter}
+	case m.cellSelect:
+		return []key.Binding{
+			m.KeyMap.LineUp, m.KeyMap.LineDown,
+			m.KeyMap.CellLeft, m.KeyMap.CellRight,
+			m.KeyMap.Activate,
+		}
+	}
+	return m.KeyMap.ShortHelp()
+}
+
+// FullHelp returns the bindings that apply to the table's current mode,
+// grouped into columns. See ShortHelp.
+func (m Model) FullHelp() [][]key.Binding {
+	switch {
+	case m.pending != nil && !m.pending.repeat:
+		return m.confirm.KeyMap.FullHelp()
+	case m.editing != nil, m.popup.open, m.filtering:
+		return [][]key.Binding{m.ShortHelp()}
+	}
+
+	groups := m.KeyMap.FullHelp()
+	if m.cellSelect {
+		groups = append(groups, []key.Binding{m.KeyMap.CellLeft, m.KeyMap.CellRight})
+		groups = append(groups, []key.Binding{
+			m.KeyMap.SelectUp, m.KeyMap.SelectDown,
+			m.KeyMap.SelectLeft, m.KeyMap.SelectRight,
+		})
+	}
+	if m.split && !m.embedded {
+		groups = append(groups, []key.Binding{m.KeyMap.SwapPane})
+	}
+	if m.dupCols != nil {
+		groups = append(groups, []key.Binding{m.KeyMap.NextDuplicate})
+	}
+	if m.pivot != nil {
+		groups = append(groups, []key.Binding{m.KeyMap.TogglePivot})
+	}
+	if m.multiSelect {
+		groups = append(groups, []key.Binding{m.KeyMap.ToggleMark})
+	}
+	if m.refresh.enabled {
+		groups = append(groups, []key.Binding{m.KeyMap.Refresh, m.KeyMap.ToggleAutoRefresh})
+	}
+	if m.editable {
+		groups = append(groups, []key.Binding{m.KeyMap.EditCell, m.KeyMap.AddRow})
+	}
+	if len(m.columnFilters) > 0 {
+		groups = append(groups, []key.Binding{m.KeyMap.ClearFilters})
+	}
+	if len(m.filterPresets) > 0 {
+		groups = append(groups, []key.Binding{m.KeyMap.NextFilterPreset})
+	}
+	if m.macros.enabled {
+		groups = append(groups, []key.Binding{m.KeyMap.RecordMacro, m.KeyMap.ReplayMacro})
+	}
+	return groups
+}
@@ -0,0 +1,112 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// hasBinding returns whether the bindings contain one with the same help as
+// the given one.
+func hasBinding(bindings []key.Binding, b key.Binding) bool {
+	for _, k := range bindings {
+		if k.Help() == b.Help() {
+			return true
+		}
+	}
+	return false
+}
+
+// fullHelp returns the bindings of the full help of a table.
+func fullHelp(m Model) []key.Binding {
+	var bindings []key.Binding
+	for _, group := range m.FullHelp() {
+		bindings = append(bindings, group...)
+	}
+	return bindings
+}
+
+func TestContextSensitiveHelp(t *testing.T) {
+	table := New(WithColumns([]Column{{Title: "Name", Width: 6}}), WithRows([]Row{{"a"}}))
+
+	if hasBinding(table.ShortHelp(), table.KeyMap.CellRight) {
+		t.Fatal("expected no cell bindings in row mode")
+	}
+	table.SetCellSelect(true)
+	if !hasBinding(table.ShortHelp(), table.KeyMap.CellRight) {
+		t.Fatal("expected cell bindings in cell selection mode")
+	}
+
+	table.SetConfirm(ActionActivate, Confirmation{})
+	table.Focus()
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if hasBinding(table.ShortHelp(), table.KeyMap.LineUp) {
+		t.Fatal("expected only prompt bindings while confirming")
+	}
+}
+
+func TestHelpModes(t *testing.T) {
+	table := testTable(editFixture, WithColumnFiltering(true), WithCellPopup(true))
+
+	table.EditCell()
+	if help := table.ShortHelp(); len(help) != 2 || !hasBinding(help, table.KeyMap.CommitEdit) {
+		t.Fatalf("expected the edit bindings while editing, got %v", help)
+	}
+	if help := table.FullHelp(); len(help) != 1 || len(help[0]) != 2 {
+		t.Fatalf("expected the full help to show the edit bindings only, got %v", help)
+	}
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	table.AddRow()
+	if !hasBinding(table.ShortHelp(), table.KeyMap.NextField) {
+		t.Fatalf("expected the field bindings while adding a row, got %v", table.ShortHelp())
+	}
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	table.OpenCellPopup()
+	if !hasBinding(table.ShortHelp(), table.KeyMap.ClosePopup) {
+		t.Fatalf("expected the popup bindings while it's open, got %v", table.ShortHelp())
+	}
+	table.CloseCellPopup()
+
+	table.OpenColumnFilter(1)
+	help := table.ShortHelp()
+	if !hasBinding(help, table.KeyMap.ToggleRegex) || !hasBinding(help, table.KeyMap.AcceptFilter) {
+		t.Fatalf("expected the filter prompt bindings while it's open, got %v", help)
+	}
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyEsc})
+
+	if help := table.ShortHelp(); !hasBinding(help, table.KeyMap.LineUp) {
+		t.Fatalf("expected the default bindings, got %v", help)
+	}
+}
+
+func TestFullHelpFeatures(t *testing.T) {
+	table := testTable(filesFixture)
+	for _, b := range []key.Binding{
+		table.KeyMap.ClearFilters, table.KeyMap.NextFilterPreset, table.KeyMap.RecordMacro,
+		table.KeyMap.Refresh, table.KeyMap.EditCell, table.KeyMap.SwapPane,
+	} {
+		if hasBinding(fullHelp(table), b) {
+			t.Errorf("expected no %q binding without its feature", b.Help().Desc)
+		}
+	}
+
+	table = testTable(filesFixture,
+		WithFilterPresets(FilterPreset{Name: "files", Filter: "file"}),
+		WithMacros(true),
+		WithRefresh(Refresh{}),
+		WithEditable(true),
+		WithSplit(true),
+	)
+	table.SetColumnFilter(1, "dir")
+	for _, b := range []key.Binding{
+		table.KeyMap.ClearFilters, table.KeyMap.NextFilterPreset, table.KeyMap.RecordMacro,
+		table.KeyMap.Refresh, table.KeyMap.EditCell, table.KeyMap.SwapPane,
+	} {
+		if !hasBinding(fullHelp(table), b) {
+			t.Errorf("expected a %q binding with its feature", b.Help().Desc)
+		}
+	}
+}
@@ -0,0 +1,139 @@
+package table
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Indicator configures a column of markers in front of the rows that shows
+// which row is selected and which rows are marked.
+type Indicator struct {
+	// Selected marks the selected row, and SelectedCell the selected row in
+	// cell selection mode.
+	Selected     string
+	SelectedCell string
+
+	// Marked marks the rows marked for multi-selection. The selected row
+	// shows the Selected marker even if it's marked.
+	Marked string
+
+	// Only shows the selection with the marker alone, instead of also
+	// styling the selected row with the Selected style.
+	Only bool
+}
+
+// DefaultIndicator returns an indicator with arrow markers for the selection
+// and asterisks for marked rows.
+func DefaultIndicator() Indicator {
+	return Indicator{
+		Selected:     "❯ ",
+		SelectedCell: "› ",
+		Marked:       "* ",
+	}
+}
+
+// WithIndicator shows a column of selection markers in front of the rows.
+func WithIndicator(ind Indicator) Option {
+	return func(m *Model) {
+		m.indicator = &ind
+	}
+}
+
+// SetIndicator shows a column of selection markers in front of the rows.
+// Pass nil to remove it.
+func (m *Model) SetIndicator(ind *Indicator) {
+	m.indicator = ind
+	m.UpdateViewport()
+}
+
+// WithMultiSelect lets the user mark several rows with the ToggleMark
+// binding.
+func WithMultiSelect(v bool) Option {
+	return func(m *Model) {
+		m.multiSelect = v
+		m.KeyMap.ToggleMark.SetEnabled(v)
+	}
+}
+
+// SetMarked marks or unmarks the row at the given index in the table's rows.
+func (m *Model) SetMarked(i int, marked bool) {
+	if i < 0 || i >= len(m.rows) {
+		return
+	}
+	if m.marked == nil {
+		m.marked = make(map[int]bool)
+	}
+	if marked {
+		m.marked[i] = true
+	} else {
+		delete(m.marked, i)
+	}
+	m.UpdateViewport()
+}
+
+// Marked returns whether the row at the given index in the table's rows is
+// marked.
+func (m Model) Marked(i int) bool {
+	return m.marked[i]
+}
+
+// MarkedRows returns the indices of the marked rows in the table's rows, in
+// the order they're displayed.
+func (m Model) MarkedRows() []int {
+	var indices []int
+	for _, i := range m.order {
+		if m.marked[i] {
+			indices = append(indices, i)
+		}
+	}
+	return indices
+}
+
+// toggleMark handles the ToggleMark binding, marking or unmarking the selected
+// row and moving on to the next one.
+func (m *Model) toggleMark() {
+	if m.cursor < 0 || m.cursor >= len(m.order) || m.rowDisabled(m.cursor) {
+		return
+	}
+	i := m.order[m.cursor]
+	m.SetMarked(i, !m.marked[i])
+	m.MoveDown(1)
+}
+
+// indicatorWidth returns the width of the indicator column, or 0 if there's
+// none.
+func (m Model) indicatorWidth() int {
+	if m.indicator == nil {
+		return 0
+	}
+	return max(stringWidth(m.indicator.Selected),
+		max(stringWidth(m.indicator.SelectedCell), stringWidth(m.indicator.Marked)))
+}
+
+// indicatorView renders the marker of the row at the given position, padded
+// to the width of the indicator column.
+func (m Model) indicatorView(pos int) string {
+	width := m.indicatorWidth()
+	if width == 0 {
+		return ""
+	}
+
+	var marker string
+	var style lipgloss.Style
+	switch {
+	case pos == m.cursor && m.cellSelect:
+		marker, style = m.indicator.SelectedCell, m.styles.CellIndicator
+	case pos == m.cursor:
+		marker, style = m.indicator.Selected, m.styles.Indicator
+	case m.marked[m.order[pos]]:
+		marker, style = m.indicator.Marked, m.styles.MarkIndicator
+	}
+	return style.Render(marker) + strings.Repeat(" ", width-stringWidth(marker))
+}
+
+// indicatorPadding returns blank space as wide as the indicator column, to
+// line up the header with the rows.
+func (m Model) indicatorPadding() string {
+	return strings.Repeat(" ", m.indicatorWidth())
+}
@@ -0,0 +1,53 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var indicatorFixture = []Option{
+	WithColumns([]Column{{Title: "Name", Width: 6}}),
+	WithRows([]Row{{"one"}, {"two"}, {"three"}}),
+	WithFocused(true),
+	WithStyles(Styles{}),
+}
+
+func TestIndicator(t *testing.T) {
+	table := testTable(indicatorFixture, WithIndicator(Indicator{Selected: "> ", Marked: "* ", Only: true}))
+	table.SetMarked(2, true)
+
+	lines := strings.Split(table.View(), "\n")
+	want := []string{"  Name", "> one", "  two", "* three"}
+	for i, w := range want {
+		if !strings.HasPrefix(lines[i], w) {
+			t.Fatalf("line %d: expected prefix %q, got %q", i, w, lines[i])
+		}
+	}
+}
+
+func TestMultiSelect(t *testing.T) {
+	table := testTable(indicatorFixture, WithMultiSelect(true))
+	mark := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")}
+
+	table, _ = table.Update(mark)
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyDown})
+	table, _ = table.Update(mark)
+	if got := table.MarkedRows(); len(got) != 2 || got[0] != 0 || got[1] != 2 {
+		t.Fatalf("expected rows 0 and 2 to be marked, got %v", got)
+	}
+
+	table.RemoveRow(0)
+	if got := table.MarkedRows(); len(got) != 1 || got[0] != 1 {
+		t.Fatalf("expected marks to follow removed rows, got %v", got)
+	}
+}
+
+func TestMultiSelectDisabledByDefault(t *testing.T) {
+	table := testTable(indicatorFixture)
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	if len(table.MarkedRows()) != 0 {
+		t.Fatal("expected no marks without multi-selection")
+	}
+}
@@ -0,0 +1,317 @@
+package table
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Kind is the type of data in a column. It gives the column a default
+// alignment, formatter and comparator, and lets column filters compare values,
+// for example ">1MiB" on a Bytes column.
+type Kind int
+
+// Available kinds.
+const (
+	// KindText is for plain text, which is left-aligned, shown as is and
+	// sorted as strings.
+	KindText Kind = iota
+
+	// KindNumber is for numbers. They're right-aligned and shown with
+	// thousands separators.
+	KindNumber
+
+	// KindBytes is for sizes in bytes, either plain numbers of bytes or
+	// numbers with a unit like "1.5 KiB" or "2MB". They're right-aligned and
+	// shown in binary units.
+	KindBytes
+
+	// KindDuration is for durations, either in the format of
+	// time.ParseDuration or as plain numbers of seconds. They're
+	// right-aligned.
+	KindDuration
+
+	// KindTimestamp is for dates and times in any of the DateLayouts.
+	// They're shown in the TimestampLayout.
+	KindTimestamp
+
+	// KindBool is for booleans like "true", "yes" or "1". They're centered
+	// and shown as check marks.
+	KindBool
+)
+
+// TimestampLayout is the layout timestamps in KindTimestamp columns are shown
+// in.
+var TimestampLayout = "2006-01-02 15:04"
+
+// Format formats a value of this kind for display. Values that can't be
+// parsed are returned as is.
+func (k Kind) Format(s string) string {
+	switch k {
+	case KindNumber:
+		if _, err := parseNumber(s); err == nil {
+			return groupThousands(strings.ReplaceAll(strings.TrimSpace(s), ",", ""))
+		}
+	case KindBytes:
+		if n, ok := parseBytes(s); ok {
+			return formatBytes(n)
+		}
+	case KindDuration:
+		if d, ok := parseDuration(s); ok {
+			return d.String()
+		}
+	case KindTimestamp:
+		if t, ok := parseDate(s); ok {
+			return t.Format(TimestampLayout)
+		}
+	case KindBool:
+		if b, ok := parseBool(s); ok {
+			if b {
+				return "✓"
+			}
+			return "✗"
+		}
+	}
+	return s
+}
+
+// value returns a value of this kind as a number that can be compared.
+func (k Kind) value(s string) (float64, bool) {
+	switch k {
+	case KindNumber:
+		f, err := parseNumber(s)
+		return f, err == nil
+	case KindBytes:
+		return parseBytes(s)
+	case KindDuration:
+		d, ok := parseDuration(s)
+		return float64(d), ok
+	case KindTimestamp:
+		t, ok := parseDate(s)
+		return float64(t.UnixNano()), ok
+	case KindBool:
+		b, ok := parseBool(s)
+		if b {
+			return 1, ok
+		}
+		return 0, ok
+	}
+	return 0, false
+}
+
+// compare returns the comparator for values of this kind, or nil for text.
+// Values that can't be parsed sort after the others.
+func (k Kind) compare() Comparator {
+	switch k {
+	case KindText:
+		return nil
+	case KindTimestamp:
+		return CompareDate
+	}
+	return func(a, b string) int {
+		va, okA := k.value(a)
+		vb, okB := k.value(b)
+		switch {
+		case !okA && !okB:
+			return strings.Compare(a, b)
+		case !okA:
+			return 1
+		case !okB:
+			return -1
+		case va < vb:
+			return -1
+		case va > vb:
+			return 1
+		}
+		return 0
+	}
+}
+
+// align returns the alignment of values of this kind, if it has one.
+func (k Kind) align() (lipgloss.Position, bool) {
+	switch k {
+	case KindNumber, KindBytes, KindDuration:
+		return lipgloss.Right, true
+	case KindBool:
+		return lipgloss.Center, true
+	}
+	return lipgloss.Left, false
+}
+
+// align returns the alignment of a value in the column. The alignment of the
+// column's kind applies unless the column has a right-to-left or automatic
+// direction.
+func (c Column) align(s string) lipgloss.Position {
+	if c.Direction == LeftToRight {
+		if pos, ok := c.Kind.align(); ok {
+			return pos
+		}
+	}
+	return c.Direction.align(s)
+}
+
+// format formats a cell value of the column for display.
+func (c Column) format(s string) string {
+	if c.Format != nil {
+		return c.Format(s)
+	}
+	return c.Kind.Format(s)
+}
+
+// comparator returns the comparator used to sort the column.
+func (c Column) comparator() Comparator {
+	if c.Compare != nil {
+		return c.Compare
+	}
+	if cmp := c.Kind.compare(); cmp != nil {
+		return cmp
+	}
+	return strings.Compare
+}
+
+// matches returns whether a cell value of the column matches a column filter.
+// Filters on columns that aren't text can compare values with one of the
+// operators =, !=, <, <=, > and >=, like ">=1h" on a Duration column, and
+// filters on Bool columns match values that mean the same, like "yes" and
+// "true". Any other filter matches values containing it, ignoring case.
+func (c Column) matches(s, filter string) bool {
+	if c.Kind != KindText {
+		op, operand := splitOperator(filter)
+		want, ok := c.Kind.value(operand)
+		if ok && (op != "" || c.Kind == KindBool) {
+			got, ok := c.Kind.value(s)
+			if !ok {
+				return false
+			}
+			switch op {
+			case "!=":
+				return got != want
+			case "<":
+				return got < want
+			case "<=":
+				return got <= want
+			case ">":
+				return got > want
+			case ">=":
+				return got >= want
+			}
+			return got == want
+		}
+	}
+
+	filter = strings.ToLower(filter)
+	return strings.Contains(strings.ToLower(s), filter) ||
+		strings.Contains(strings.ToLower(c.format(s)), filter)
+}
+
+// splitOperator splits a comparison operator off the start of a filter.
+func splitOperator(filter string) (op, operand string) {
+	filter = strings.TrimSpace(filter)
+	for _, op := range []string{">=", "<=", "!=", ">", "<", "="} {
+		if strings.HasPrefix(filter, op) {
+			return op, strings.TrimSpace(filter[len(op):])
+		}
+	}
+	return "", filter
+}
+
+// groupThousands inserts commas between groups of three digits in the
+// integer part of a number.
+func groupThousands(s string) string {
+	sign := ""
+	if strings.HasPrefix(s, "-") || strings.HasPrefix(s, "+") {
+		sign, s = s[:1], s[1:]
+	}
+	integer, fraction := s, ""
+	if i := strings.IndexAny(s, ".eE"); i >= 0 {
+		integer, fraction = s[:i], s[i:]
+	}
+
+	var b strings.Builder
+	for i, r := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			b.WriteByte(',')
+		}
+		b.WriteRune(r)
+	}
+	return sign + b.String() + fraction
+}
+
+// Byte units, by their multiple.
+var byteUnits = map[string]float64{
+	"":    1,
+	"b":   1,
+	"k":   1 << 10,
+	"kb":  1e3,
+	"kib": 1 << 10,
+	"m":   1 << 20,
+	"mb":  1e6,
+	"mib": 1 << 20,
+	"g":   1 << 30,
+	"gb":  1e9,
+	"gib": 1 << 30,
+	"t":   1 << 40,
+	"tb":  1e12,
+	"tib": 1 << 40,
+}
+
+// parseBytes parses a size such as "512", "1.5 KiB" or "2MB" into a number of
+// bytes. Decimal units are multiples of 1000 and binary units and single
+// letters multiples of 1024.
+func parseBytes(s string) (float64, bool) {
+	s = strings.TrimSpace(s)
+	i := strings.IndexFunc(s, unicode.IsLetter)
+	if i < 0 {
+		i = len(s)
+	}
+	n, err := parseNumber(s[:i])
+	if err != nil {
+		return 0, false
+	}
+	unit, ok := byteUnits[strings.ToLower(s[i:])]
+	if !ok {
+		return 0, false
+	}
+	return n * unit, true
+}
+
+// formatBytes formats a number of bytes in binary units.
+func formatBytes(n float64) string {
+	units := []string{"B", "KiB", "MiB", "GiB", "TiB", "PiB"}
+	i := 0
+	for math.Abs(n) >= 1024 && i < len(units)-1 {
+		n /= 1024
+		i++
+	}
+	if i == 0 {
+		return strconv.FormatFloat(n, 'f', -1, 64) + " B"
+	}
+	return strconv.FormatFloat(n, 'f', 1, 64) + " " + units[i]
+}
+
+// parseDuration parses a duration such as "1h30m", or a number of seconds.
+func parseDuration(s string) (time.Duration, bool) {
+	s = strings.TrimSpace(s)
+	if d, err := time.ParseDuration(s); err == nil {
+		return d, true
+	}
+	if f, err := parseNumber(s); err == nil {
+		return time.Duration(f * float64(time.Second)), true
+	}
+	return 0, false
+}
+
+// parseBool parses booleans such as "true", "yes", "on" or "1".
+func parseBool(s string) (bool, bool) {
+	switch strings.ToLower(strings.TrimSpace(s)) {
+	case "true", "t", "yes", "y", "on", "1", "✓":
+		return true, true
+	case "false", "f", "no", "n", "off", "0", "✗":
+		return false, true
+	}
+	return false, false
+}
@@ -0,0 +1,77 @@
+package table
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestKindFormat(t *testing.T) {
+	tests := []struct {
+		kind Kind
+		in   string
+		want string
+	}{
+		{KindText, "1234", "1234"},
+		{KindNumber, "1234567.25", "1,234,567.25"},
+		{KindNumber, "-1000", "-1,000"},
+		{KindNumber, "n/a", "n/a"},
+		{KindBytes, "512", "512 B"},
+		{KindBytes, "1536", "1.5 KiB"},
+		{KindBytes, "2MB", "1.9 MiB"},
+		{KindDuration, "90", "1m30s"},
+		{KindDuration, "1h30m", "1h30m0s"},
+		{KindTimestamp, "2022-03-04T05:06:07Z", "2022-03-04 05:06"},
+		{KindBool, "yes", "✓"},
+		{KindBool, "0", "✗"},
+	}
+	for _, tt := range tests {
+		if got := tt.kind.Format(tt.in); got != tt.want {
+			t.Errorf("Format(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestKindSortAndAlign(t *testing.T) {
+	table := New(
+		WithColumns([]Column{
+			{Title: "Name", Width: 6},
+			{Title: "Size", Width: 9, Kind: KindBytes},
+		}),
+		WithRows([]Row{{"a", "2 KiB"}, {"b", "100"}, {"c", "1MB"}}),
+	)
+	table.SortBy(1, Ascending)
+
+	var names []string
+	for _, r := range table.VisibleRows() {
+		names = append(names, r[0])
+	}
+	if got := strings.Join(names, ","); got != "b,a,c" {
+		t.Fatalf("expected sizes to be sorted by value, got %s", got)
+	}
+
+	if !strings.Contains(table.View(), "    100 B") {
+		t.Fatalf("expected sizes to be formatted and right-aligned:\n%s", table.View())
+	}
+}
+
+func TestKindColumnFilters(t *testing.T) {
+	table := New(
+		WithColumns([]Column{
+			{Title: "Name", Width: 6},
+			{Title: "Took", Width: 6, Kind: KindDuration},
+			{Title: "Done", Width: 4, Kind: KindBool},
+		}),
+		WithRows([]Row{{"a", "30s", "true"}, {"b", "2m", "false"}, {"c", "1h", "yes"}}),
+	)
+
+	table.SetColumnFilter(1, ">= 1m")
+	if rows := table.VisibleRows(); len(rows) != 2 || rows[0][0] != "b" {
+		t.Fatalf("expected durations of at least a minute, got %v", rows)
+	}
+
+	table.SetColumnFilter(1, "")
+	table.SetColumnFilter(2, "y")
+	if rows := table.VisibleRows(); len(rows) != 2 || rows[1][0] != "c" {
+		t.Fatalf("expected done rows, got %v", rows)
+	}
+}
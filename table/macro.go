@@ -0,0 +1,172 @@
+package table
+
+import (
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Macros
+//
+// When macros are enabled, key sequences can be recorded and replayed like in
+// vim: press q followed by a register (any single character) to start
+// recording, perform the operations and press q again to stop. Press @
+// followed by the register to replay the recorded keys, or @@ to replay the
+// last macro again. Macros are scoped to the table; they only record and
+// replay the keys the table receives.
+
+// maxMacroDepth limits how deeply macros can replay other macros, which
+// stops macros that replay themselves.
+const maxMacroDepth = 16
+
+type macroState int
+
+const (
+	macroIdle macroState = iota
+	macroAwaitRecord
+	macroAwaitReplay
+)
+
+// macros holds the recorded macros and the state of recording.
+type macros struct {
+	enabled bool
+	state   macroState
+
+	// replaying is the nesting depth of macros currently being replayed.
+	replaying int
+
+	// recording is the register being recorded into, or empty.
+	recording string
+	buf       []tea.KeyMsg
+
+	registers map[string][]tea.KeyMsg
+	last      string
+}
+
+// WithMacros enables or disables recording and replaying macros, along with
+// the RecordMacro and ReplayMacro bindings.
+func WithMacros(v bool) Option {
+	return func(m *Model) {
+		m.SetMacros(v)
+	}
+}
+
+// SetMacros enables or disables recording and replaying macros, along with
+// the RecordMacro and ReplayMacro bindings.
+func (m *Model) SetMacros(v bool) {
+	m.macros.enabled = v
+	m.KeyMap.RecordMacro.SetEnabled(v)
+	m.KeyMap.ReplayMacro.SetEnabled(v)
+	if !v {
+		m.macros.state = macroIdle
+		m.macros.recording = ""
+		m.macros.buf = nil
+	}
+}
+
+// Recording returns the register that a macro is currently being recorded
+// into and whether a macro is being recorded at all.
+func (m Model) Recording() (register string, ok bool) {
+	return m.macros.recording, m.macros.recording != ""
+}
+
+// Macro returns the keys recorded into the given register.
+func (m Model) Macro(register string) []tea.KeyMsg {
+	return m.macros.registers[register]
+}
+
+// SetMacro sets the keys stored in the given register, for example to restore
+// macros saved from a previous session.
+func (m *Model) SetMacro(register string, keys []tea.KeyMsg) {
+	if m.macros.registers == nil {
+		m.macros.registers = make(map[string][]tea.KeyMsg)
+	}
+	m.macros.registers[register] = keys
+}
+
+// ReplayMacro replays the keys recorded into the given register and returns
+// the commands they produced.
+func (m *Model) ReplayMacro(register string) tea.Cmd {
+	keys, ok := m.macros.registers[register]
+	if !ok || m.macros.replaying >= maxMacroDepth {
+		return nil
+	}
+	m.macros.last = register
+
+	m.macros.replaying++
+	defer func() { m.macros.replaying-- }()
+
+	cmds := make([]tea.Cmd, 0, len(keys))
+	for _, k := range keys {
+		cmds = append(cmds, m.HandleKey(k))
+	}
+	return tea.Batch(cmds...)
+}
+
+// handleMacroKey handles the keys used to record and replay macros. It
+// returns whether the key was consumed.
+func (m *Model) handleMacroKey(msg tea.KeyMsg) (bool, tea.Cmd) {
+	if !m.macros.enabled {
+		return false, nil
+	}
+
+	switch m.macros.state {
+	case macroAwaitRecord:
+		m.macros.state = macroIdle
+		if register, ok := registerName(msg); ok {
+			m.macros.recording = register
+			m.macros.buf = nil
+		}
+		return true, nil
+
+	case macroAwaitReplay:
+		m.macros.state = macroIdle
+		register, ok := registerName(msg)
+		if !ok {
+			return true, nil
+		}
+		if key.Matches(msg, m.KeyMap.ReplayMacro) {
+			register = m.macros.last
+		}
+		return true, m.ReplayMacro(register)
+	}
+
+	switch {
+	case key.Matches(msg, m.KeyMap.RecordMacro) && m.macros.replaying == 0:
+		if m.macros.recording != "" {
+			// Leave out the key that stops recording.
+			m.SetMacro(m.macros.recording, m.macros.buf[:len(m.macros.buf)-1])
+			m.macros.recording = ""
+			m.macros.buf = nil
+			return true, nil
+		}
+		m.macros.state = macroAwaitRecord
+		return true, nil
+
+	case key.Matches(msg, m.KeyMap.ReplayMacro):
+		m.macros.state = macroAwaitReplay
+		return true, nil
+	}
+
+	return false, nil
+}
+
+// recordMacroKey records a key the table receives while a macro is being
+// recorded, before the key is handled, so that keys typed into prompts and
+// cell edits are recorded too. Replaying a macro from within a macro is
+// recorded like any other key, so that macros can be composed.
+func (m *Model) recordMacroKey(msg tea.Msg) {
+	k, ok := msg.(tea.KeyMsg)
+	if !ok || !m.macros.enabled || m.macros.recording == "" || m.macros.replaying > 0 {
+		return
+	}
+	m.macros.buf = append(m.macros.buf, k)
+}
+
+// registerName returns the register a key selects. Registers are named after
+// single characters.
+func registerName(msg tea.KeyMsg) (string, bool) {
+	if msg.Type != tea.KeyRunes || len(msg.Runes) != 1 || msg.Alt {
+		return "", false
+	}
+	return string(msg.Runes), true
+}
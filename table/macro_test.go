@@ -0,0 +1,104 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/bubbletest"
+)
+
+var macroFixture = []Option{
+	WithColumns([]Column{{Title: "Name", Width: 10}}),
+	WithRows(numberedRows(20)),
+	WithFocused(true),
+	WithMacros(true),
+}
+
+func TestMacroRecordAndReplay(t *testing.T) {
+	m := testTable(macroFixture)
+	for _, k := range bubbletest.Runes("qajjq") {
+		m, _ = m.Update(k)
+	}
+	if m.Cursor() != 2 {
+		t.Fatalf("expected cursor at 2 after recording, got %d", m.Cursor())
+	}
+	if _, ok := m.Recording(); ok {
+		t.Fatal("expected recording to have stopped")
+	}
+	if got := len(m.Macro("a")); got != 2 {
+		t.Fatalf("expected 2 recorded keys, got %d", got)
+	}
+
+	for _, k := range bubbletest.Runes("@a@@") {
+		m, _ = m.Update(k)
+	}
+	if m.Cursor() != 6 {
+		t.Fatalf("expected cursor at 6 after replaying twice, got %d", m.Cursor())
+	}
+}
+
+func TestMacroReplaysNestedMacro(t *testing.T) {
+	m := testTable(macroFixture)
+	for _, k := range bubbletest.Runes("qajqqb@ajq") {
+		m, _ = m.Update(k)
+	}
+	if m.Cursor() != 3 {
+		t.Fatalf("expected cursor at 3 after recording, got %d", m.Cursor())
+	}
+	for _, k := range bubbletest.Runes("@b") {
+		m, _ = m.Update(k)
+	}
+	if m.Cursor() != 5 {
+		t.Fatalf("expected cursor at 5, got %d", m.Cursor())
+	}
+}
+
+func TestMacroRecursionIsBounded(t *testing.T) {
+	m := testTable(macroFixture)
+	m.SetMacro("a", bubbletest.Runes("j@a"))
+	for _, k := range bubbletest.Runes("@a") {
+		m, _ = m.Update(k)
+	}
+	if m.Cursor() != maxMacroDepth {
+		t.Fatalf("expected cursor at %d, got %d", maxMacroDepth, m.Cursor())
+	}
+}
+
+func TestMacrosDisabled(t *testing.T) {
+	m := testTable(macroFixture)
+	m.SetMacros(false)
+	for _, k := range bubbletest.Runes("qajq") {
+		m, _ = m.Update(k)
+	}
+	if len(m.Macro("a")) != 0 {
+		t.Fatal("expected no macro to be recorded")
+	}
+	if m.KeyMap.RecordMacro.Enabled() || m.KeyMap.ReplayMacro.Enabled() {
+		t.Fatal("expected the macro bindings to be disabled")
+	}
+}
+
+func TestMacroRecordsEdits(t *testing.T) {
+	m := testTable(editFixture, WithMacros(true), WithRows([]Row{{"1", "ann", "30"}, {"2", "bob", "40"}, {"3", "cy", "50"}}))
+	m.SetCursor(0)
+
+	msgs := append(bubbletest.Runes("qae"), bubbletest.Key("ctrl+u"), bubbletest.Key("Z"), bubbletest.Key("enter"), bubbletest.Key("j"), bubbletest.Key("q"))
+	for _, k := range msgs {
+		m, _ = m.Update(k)
+	}
+	if got := len(m.Macro("a")); got != 5 {
+		t.Fatalf("expected the keys typed into the edit to be recorded, got %v", m.Macro("a"))
+	}
+
+	for _, k := range bubbletest.Runes("@a") {
+		m, _ = m.Update(k)
+	}
+	if m.Editing() {
+		t.Fatal("expected the replayed edit to be committed")
+	}
+	if got := m.rows[1][1]; got != "Z" {
+		t.Fatalf("expected the replayed edit to set the cell, got %q", got)
+	}
+	if m.Cursor() != 2 {
+		t.Fatalf("expected the cursor to move after the edit, got %d", m.Cursor())
+	}
+}
@@ -0,0 +1,118 @@
+package table
+
+import (
+	"github.com/charmbracelet/bubbles/mouse"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// mouseWheelDelta is the number of rows the mouse wheel moves the cursor.
+const mouseWheelDelta = 3
+
+// WithMouse enables selecting rows by clicking them, cells too when cell
+// selection is enabled, and moving the cursor with the mouse wheel. x and y
+// are the position of the table in the terminal window. The mouse must be
+// enabled in Bubble Tea for this to work.
+func WithMouse(x, y int) Option {
+	return func(m *Model) {
+		m.mouse = true
+		m.SetPosition(x, y)
+	}
+}
+
+// SetPosition sets the position of the table in the terminal window, which
+// mouse events are mapped to its headers and rows with.
+func (m *Model) SetPosition(x, y int) {
+	m.xPosition, m.yPosition = x, y
+}
+
+// headerRegions returns the regions of the column headers, with the indices
+// of the columns as IDs.
+func (m Model) headerRegions() mouse.Regions {
+	var (
+		regions mouse.Regions
+		x       = m.indicatorWidth()
+		y       = m.titleRow()
+	)
+	for i, c := range m.cols {
+		if c.Hidden {
+			continue
+		}
+		w := m.colWidth(i) + horizontalFrameSize(m.styles.Header)
+		regions.Add(i, x, y, w, 1)
+		x += w
+	}
+	return regions
+}
+
+// bodyTop returns the line of the table view the body starts on.
+func (m Model) bodyTop() int {
+	y := 0
+	if r := m.refreshView(); r != "" {
+		y += lipgloss.Height(r)
+	}
+	if m.formulas {
+		y++
+	}
+	if !m.headerHidden {
+		y += lipgloss.Height(m.headersView())
+	}
+	if f := m.columnFilterView(); f != "" {
+		y += lipgloss.Height(f)
+	}
+	return y
+}
+
+// rowRegions returns the regions of the visible rows, with their positions
+// as IDs. Rows of split panes have no regions.
+func (m Model) rowRegions() mouse.Regions {
+	var regions mouse.Regions
+	if m.split && !m.embedded {
+		return regions
+	}
+
+	offset, height := m.offset, m.bodyHeight()
+	if m.embedded {
+		offset = m.viewport.YOffset
+	}
+	width := lipgloss.Width(m.headersView())
+	for i, y := offset, m.bodyTop(); i < len(m.order) && height > 0; i++ {
+		h := 1
+		if i == m.cursor && m.wrapSelected {
+			h = min(height, lipgloss.Height(m.renderRow(i)))
+		}
+		regions.Add(i, 0, y, width, h)
+		y += h
+		height -= h
+	}
+	return regions
+}
+
+// handleMouse selects the row or cell under a click and moves the cursor
+// with the mouse wheel.
+func (m *Model) handleMouse(msg tea.MouseMsg) tea.Cmd {
+	if msg.Type == tea.MouseLeft {
+		ev, ok := m.rowRegions().Hit(msg, m.xPosition, m.yPosition)
+		if !ok || m.rowDisabled(ev.ID) {
+			return nil
+		}
+		if m.cellSelect {
+			if col, ok := m.HeaderAt(ev.X); ok && m.ColumnSelectable(col) {
+				m.colCursor = col
+			}
+		}
+		m.SetCursor(ev.ID)
+		return nil
+	}
+
+	if m.embedded {
+		return m.updateEmbedded(msg)
+	}
+	switch n := mouse.Wheel(msg, mouseWheelDelta); {
+	case n < 0:
+		m.MoveUp(-n)
+	case n > 0:
+		m.MoveDown(n)
+	}
+	return nil
+}
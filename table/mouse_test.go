@@ -0,0 +1,83 @@
+package table
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/bubbletest"
+	"github.com/charmbracelet/bubbles/event"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestMouseClick(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "Name", Width: 10}, {Title: "Kind", Width: 10}}),
+		WithRows([]Row{
+			{"main.go", "file"},
+			{"internal", "dir"},
+			{"go.mod", "file"},
+			{"cmd", "dir"},
+		}),
+		WithHeight(5),
+		WithMouse(4, 2),
+		WithCellSelect(true),
+	)
+	table.Focus()
+	h := bubbletest.New(t, table)
+
+	// The header is on the first line and the rows below it.
+	top := 2 + table.bodyTop()
+	h.Click(20, top+2)
+	table = h.Model().(Model)
+	if row, col := table.SelectedCell(); row != 2 || col != 1 {
+		t.Fatalf("expected the clicked cell to be selected, got (%d, %d)", row, col)
+	}
+	if msgs := h.Msgs(); len(msgs) != 1 {
+		t.Fatalf("expected a selection change, got %v", msgs)
+	} else if msg, ok := msgs[0].(event.SelectionChangedMsg); !ok || msg.Index != 2 {
+		t.Fatalf("unexpected message %#v", msgs[0])
+	}
+
+	// Clicks outside the rows are ignored.
+	h.Click(0, top)
+	if table = h.Model().(Model); table.Cursor() != 2 {
+		t.Fatalf("expected the cursor to stay, got %d", table.Cursor())
+	}
+
+	h.Scroll(20, top+2, -1)
+	if table = h.Model().(Model); table.Cursor() != 0 {
+		t.Fatalf("expected the wheel to move the cursor up, got %d", table.Cursor())
+	}
+}
+
+func TestMouseDisabled(t *testing.T) {
+	table := testTable(filesFixture)
+	table.Focus()
+
+	table, _ = table.Update(tea.MouseMsg{X: 2, Y: table.bodyTop() + 2, Type: tea.MouseLeft})
+	if table.Cursor() != 0 {
+		t.Fatalf("expected clicks to be ignored without WithMouse, got %d", table.Cursor())
+	}
+}
+
+func TestMouseClickPastIndicatorRow(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "Name", Width: 10}}),
+		WithRows([]Row{{"main.go"}, {"go.mod"}}),
+		WithHeight(5),
+		WithMouse(0, 0),
+		WithIndicator(DefaultIndicator()),
+	)
+	table.Focus()
+	h := bubbletest.New(t, table)
+
+	// The row is as wide as the header, which includes the indicator.
+	width := table.indicatorWidth() + table.cols[0].Width + table.styles.Cell.GetHorizontalFrameSize()
+	h.Click(width, table.bodyTop()+1)
+	if table = h.Model().(Model); table.Cursor() != 0 {
+		t.Fatalf("expected a click past the row to be ignored, got %d", table.Cursor())
+	}
+	h.Click(width-1, table.bodyTop()+1)
+	if table = h.Model().(Model); table.Cursor() != 1 {
+		t.Fatalf("expected a click on the row to select it, got %d", table.Cursor())
+	}
+}
@@ -0,0 +1,234 @@
+package table
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Aggregate is how the values of a group of rows are summarized.
+type Aggregate int
+
+// Available aggregates.
+const (
+	// AggregateCount counts the rows in each group.
+	AggregateCount Aggregate = iota
+
+	// AggregateSum adds up the values in each group.
+	AggregateSum
+
+	// AggregateAvg averages the values in each group.
+	AggregateAvg
+)
+
+// String returns the name of the aggregate.
+func (a Aggregate) String() string {
+	switch a {
+	case AggregateSum:
+		return "sum"
+	case AggregateAvg:
+		return "avg"
+	}
+	return "count"
+}
+
+// Pivot summarizes a table by grouping its rows by the values in one column
+// and aggregating the values of another column in each group.
+type Pivot struct {
+	// GroupBy is the index of the column whose values the rows are grouped
+	// by.
+	GroupBy int
+
+	// Column is the index of the column that's aggregated. It's unused when
+	// counting.
+	Column int
+
+	// Aggregate is how the values in each group are summarized. Values that
+	// aren't numbers are left out of sums and averages.
+	Aggregate Aggregate
+}
+
+// Apply groups the given rows and returns the columns and rows of the summary:
+// one row per group, in the order the groups first appear, with the group's
+// value and the aggregate.
+func (p Pivot) Apply(cols []Column, rows []Row) ([]Column, []Row) {
+	if p.GroupBy < 0 || p.GroupBy >= len(cols) {
+		return nil, nil
+	}
+	group := cols[p.GroupBy]
+	group.Hidden = false
+
+	value := Column{Title: p.Aggregate.String(), Width: 8, Kind: KindNumber}
+	var kind Kind
+	if p.Aggregate != AggregateCount && p.Column >= 0 && p.Column < len(cols) {
+		c := cols[p.Column]
+		value.Title += "(" + c.Title + ")"
+		value.Width = max(c.Width, value.Width)
+		kind = c.Kind
+		if kind == KindBytes || kind == KindDuration {
+			value.Kind = kind
+		}
+	}
+	value.Width = max(value.Width, stringWidth(value.Title))
+
+	type summary struct {
+		count int
+		sum   float64
+		n     int
+	}
+	var keys []string
+	groups := make(map[string]*summary)
+	for _, row := range rows {
+		var key string
+		if p.GroupBy < len(row) {
+			key = row[p.GroupBy]
+		}
+		s, ok := groups[key]
+		if !ok {
+			s = &summary{}
+			groups[key] = s
+			keys = append(keys, key)
+		}
+		s.count++
+		if p.Aggregate != AggregateCount && p.Column >= 0 && p.Column < len(row) {
+			if n, ok := numberValue(kind, row[p.Column]); ok {
+				s.sum += n
+				s.n++
+			}
+		}
+	}
+
+	out := make([]Row, len(keys))
+	for i, key := range keys {
+		s := groups[key]
+		var v string
+		switch p.Aggregate {
+		case AggregateCount:
+			v = strconv.Itoa(s.count)
+		case AggregateSum:
+			v = formatAggregate(value.Kind, s.sum)
+		case AggregateAvg:
+			if s.n > 0 {
+				v = formatAggregate(value.Kind, s.sum/float64(s.n))
+			}
+		}
+		out[i] = Row{key, v}
+	}
+	return []Column{group, value}, out
+}
+
+// formatAggregate formats an aggregated value so that it can be parsed as a
+// value of the given kind.
+func formatAggregate(kind Kind, n float64) string {
+	if kind == KindDuration {
+		return time.Duration(n).Round(time.Millisecond).String()
+	}
+	s := strconv.FormatFloat(n, 'f', 2, 64)
+	return strings.TrimRight(strings.TrimRight(s, "0"), ".")
+}
+
+// pivotState holds the raw table while the summary is shown.
+type pivotState struct {
+	cols          []Column
+	rows          []Row
+	cursor        int
+	colCursor     int
+	offset        int
+	frozen        int
+	sort          SortState
+	filter        string
+	match         func(Row) bool
+	columnFilters map[int]string
+	disabled      map[int]bool
+	marked        map[int]bool
+}
+
+// WithPivot configures the summary shown when the user presses the
+// TogglePivot binding.
+func WithPivot(p Pivot) Option {
+	return func(m *Model) {
+		m.pivot = &p
+		m.KeyMap.TogglePivot.SetEnabled(true)
+	}
+}
+
+// SetPivot configures the summary shown when the table is pivoted. If the
+// table is pivoted already, the summary is updated.
+func (m *Model) SetPivot(p Pivot) {
+	m.pivot = &p
+	m.KeyMap.TogglePivot.SetEnabled(true)
+	if m.pivoted != nil {
+		m.repivot()
+	}
+}
+
+// Pivoted returns whether the table shows the summary instead of the raw
+// rows.
+func (m Model) Pivoted() bool {
+	return m.pivoted != nil
+}
+
+// SetPivoted switches between the summary and the raw rows. The summary is
+// computed from the rows that pass the filters, in their current order. The
+// raw view is restored as it was, including its cursor, sorting, filters and
+// frozen rows.
+func (m *Model) SetPivoted(v bool) {
+	switch {
+	case v && m.pivoted == nil && m.pivot != nil:
+		rows := make([]Row, len(m.order))
+		for pos, i := range m.order {
+			rows[pos] = m.rowValues(i)
+		}
+		m.pivoted = &pivotState{
+			cols:          m.cols,
+			rows:          m.rows,
+			cursor:        m.cursor,
+			colCursor:     m.colCursor,
+			offset:        m.offset,
+			frozen:        m.frozen,
+			sort:          m.sort,
+			filter:        m.filter,
+			match:         m.match,
+			columnFilters: m.columnFilters,
+			disabled:      m.disabled,
+			marked:        m.marked,
+		}
+		m.sort, m.filter, m.match = SortState{}, "", nil
+		m.columnFilters, m.disabled, m.marked = nil, nil, nil
+		m.cursor, m.colCursor, m.offset, m.frozen = 0, 0, 0, 0
+		m.cols, m.rows = m.pivot.Apply(m.cols, rows)
+		m.recalc()
+		m.findDuplicates()
+		m.reorder()
+		m.UpdateViewport()
+
+	case !v && m.pivoted != nil:
+		p := m.pivoted
+		m.pivoted = nil
+		m.cols, m.rows, m.frozen = p.cols, p.rows, p.frozen
+		m.sort, m.filter, m.match = p.sort, p.filter, p.match
+		m.columnFilters, m.disabled, m.marked = p.columnFilters, p.disabled, p.marked
+		m.recalc()
+		m.findDuplicates()
+		m.reorder()
+		m.cursor = clamp(p.cursor, 0, len(m.order)-1)
+		m.colCursor, m.offset = p.colCursor, p.offset
+		m.UpdateViewport()
+	}
+}
+
+// repivot recomputes the summary after the raw rows or the pivot changed.
+func (m *Model) repivot() {
+	m.SetPivoted(false)
+	m.SetPivoted(true)
+}
+
+// rowValues returns the values of the row at the given index, with formulas
+// evaluated.
+func (m Model) rowValues(i int) Row {
+	row := make(Row, len(m.rows[i]))
+	for col := range row {
+		row[col] = m.CellValue(i, col)
+	}
+	return row
+}
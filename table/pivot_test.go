@@ -0,0 +1,111 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func pivotRows() []Row {
+	return []Row{
+		{"web", "1KiB"},
+		{"db", "4KiB"},
+		{"web", "3KiB"},
+		{"cache", "n/a"},
+	}
+}
+
+func TestPivotApply(t *testing.T) {
+	cols := []Column{{Title: "Service", Width: 8}, {Title: "Size", Width: 8, Kind: KindBytes}}
+	tests := []struct {
+		agg  Aggregate
+		want string
+	}{
+		{AggregateCount, "web=2 db=1 cache=1"},
+		{AggregateSum, "web=4096 db=4096 cache=0"},
+		{AggregateAvg, "web=2048 db=4096 cache="},
+	}
+	for _, tt := range tests {
+		gotCols, rows := Pivot{GroupBy: 0, Column: 1, Aggregate: tt.agg}.Apply(cols, pivotRows())
+		var got []string
+		for _, r := range rows {
+			got = append(got, r[0]+"="+r[1])
+		}
+		if strings.Join(got, " ") != tt.want {
+			t.Errorf("%s: expected %q, got %q", tt.agg, tt.want, strings.Join(got, " "))
+		}
+		if len(gotCols) != 2 || gotCols[0].Title != "Service" {
+			t.Errorf("%s: unexpected columns %v", tt.agg, gotCols)
+		}
+	}
+}
+
+func TestTogglePivot(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "Service", Width: 8}, {Title: "Size", Width: 8, Kind: KindBytes}}),
+		WithRows(pivotRows()),
+		WithPivot(Pivot{GroupBy: 0, Column: 1, Aggregate: AggregateSum}),
+		WithFocused(true),
+	)
+	table.SetCursor(2)
+	table.SetColumnFilter(0, "web")
+
+	toggle := tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("P")}
+	table, _ = table.Update(toggle)
+	if !table.Pivoted() {
+		t.Fatal("expected the table to be pivoted")
+	}
+	view := table.View()
+	if !strings.Contains(view, "sum(Size)") || !strings.Contains(view, "4.0 KiB") || strings.Contains(view, "db") {
+		t.Fatalf("expected a summary of the filtered rows:\n%s", view)
+	}
+
+	table, _ = table.Update(toggle)
+	if table.Pivoted() || table.ColumnFilter(0) != "web" || table.SelectedRow()[1] != "3KiB" {
+		t.Fatalf("expected the raw view to be restored, got %v", table.SelectedRow())
+	}
+}
+
+func TestPivotFrozenRows(t *testing.T) {
+	rows := append(pivotRows(), Row{"total", "8KiB"})
+	table := New(
+		WithColumns([]Column{{Title: "Service", Width: 8}, {Title: "Size", Width: 8, Kind: KindBytes}}),
+		WithRows(rows),
+		WithFrozenRows(1),
+		WithPivot(Pivot{GroupBy: 0, Column: 1, Aggregate: AggregateCount}),
+		WithHeight(10),
+	)
+
+	table.SetPivoted(true)
+	if table.FrozenRows() != 0 || len(table.VisibleRows()) != 3 {
+		t.Fatalf("expected the summary rows not to be frozen, got %d frozen of %v", table.FrozenRows(), table.VisibleRows())
+	}
+	if view := table.View(); strings.Contains(view, "total") {
+		t.Fatalf("expected the frozen rows to be left out of the summary:\n%s", view)
+	}
+
+	table.SetPivoted(false)
+	if table.FrozenRows() != 1 || !strings.Contains(table.View(), "total") {
+		t.Fatalf("expected the frozen rows to be restored, got %d", table.FrozenRows())
+	}
+}
+
+func TestPivotDeleteRow(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "Service", Width: 8}, {Title: "Size", Width: 8, Kind: KindBytes}}),
+		WithRows(pivotRows()),
+		WithPivot(Pivot{GroupBy: 0, Column: 1, Aggregate: AggregateCount}),
+	)
+
+	table.SetPivoted(true)
+	table.Dispatch(ActionDeleteRow)
+	if len(table.VisibleRows()) != 3 {
+		t.Fatalf("expected no summary row to be deleted, got %v", table.VisibleRows())
+	}
+
+	table.SetPivoted(false)
+	if len(table.VisibleRows()) != len(pivotRows()) {
+		t.Fatalf("expected no raw row to be deleted, got %v", table.VisibleRows())
+	}
+}
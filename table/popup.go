@@ -0,0 +1,198 @@
+package table
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/mattn/go-runewidth"
+	"github.com/muesli/reflow/ansi"
+	"github.com/muesli/reflow/wordwrap"
+	"github.com/muesli/reflow/wrap"
+)
+
+// Default size of the cell popup's content.
+const (
+	defaultPopupWidth  = 40
+	defaultPopupHeight = 8
+)
+
+// popup shows the full content of a cell on top of the table.
+type popup struct {
+	open     bool
+	viewport viewport.Model
+	width    int
+	height   int
+}
+
+// WithCellPopup lets the user open a popup that shows the full value of the
+// selected cell with the ShowCell binding.
+func WithCellPopup(v bool) Option {
+	return func(m *Model) {
+		m.KeyMap.ShowCell.SetEnabled(v)
+	}
+}
+
+// WithCellPopupSize sets the maximum width and height of the content of the
+// popup that shows the full value of the selected cell. Longer values can be
+// scrolled.
+func WithCellPopupSize(width, height int) Option {
+	return func(m *Model) {
+		m.popup.width = width
+		m.popup.height = height
+	}
+}
+
+// CellPopupOpen returns whether the popup showing the selected cell's value is
+// open.
+func (m Model) CellPopupOpen() bool {
+	return m.popup.open
+}
+
+// OpenCellPopup shows the full value of the selected cell in a popup next to
+// it, word-wrapped to the popup's width. While it's open, the popup receives
+// the key presses to scroll through long values.
+func (m *Model) OpenCellPopup() {
+	if m.cursor < 0 || m.cursor >= len(m.order) || m.colCursor < 0 || m.colCursor >= len(m.cols) {
+		return
+	}
+
+	maxWidth, maxHeight := m.popup.width, m.popup.height
+	if maxWidth <= 0 {
+		maxWidth = defaultPopupWidth
+	}
+	if maxHeight <= 0 {
+		maxHeight = defaultPopupHeight
+	}
+
+	value := m.CellValue(m.order[m.cursor], m.colCursor)
+	width := min(maxWidth, max(m.colWidth(m.colCursor), stringWidth(value)))
+	content := wrap.String(wordwrap.String(value, width), width)
+
+	m.popup.viewport = viewport.New(width, min(maxHeight, lipgloss.Height(content)))
+	m.popup.viewport.SetContent(content)
+	m.popup.open = true
+}
+
+// CloseCellPopup closes the popup showing the selected cell's value.
+func (m *Model) CloseCellPopup() {
+	m.popup.open = false
+}
+
+// handlePopup handles messages while the cell popup is open. It returns
+// whether the message was consumed.
+func (m *Model) handlePopup(msg tea.Msg) (bool, tea.Cmd) {
+	if !m.popup.open {
+		return false, nil
+	}
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return false, nil
+	}
+	if key.Matches(keyMsg, m.KeyMap.ClosePopup, m.KeyMap.ShowCell) {
+		m.CloseCellPopup()
+		return true, nil
+	}
+
+	var cmd tea.Cmd
+	m.popup.viewport, cmd = m.popup.viewport.Update(msg)
+	return true, cmd
+}
+
+// popupView draws the cell popup over the rendered table, below the selected
+// cell, or above it if there's no room below.
+func (m Model) popupView(view string) string {
+	box := m.styles.Popup.Render(m.popup.viewport.View())
+
+	// Find the line of the selected row.
+	y := m.cursor - m.offset
+	if m.split && m.bottomActive {
+		top, _ := m.paneHeights()
+		y += top + 1
+	}
+	if m.refresh.enabled {
+		y++
+	}
+	if m.formulas {
+		y++
+	}
+	if !m.headerHidden {
+		y += lipgloss.Height(m.headersView())
+	}
+	if m.columnFilterView() != "" {
+		y++
+	}
+
+	x := m.indicatorWidth()
+	for i := 0; i < m.colCursor; i++ {
+		if !m.cols[i].Hidden {
+			x += m.colWidth(i) + horizontalFrameSize(m.styles.Cell)
+		}
+	}
+
+	lines := strings.Split(view, "\n")
+	height := lipgloss.Height(box)
+	if y+1+height > len(lines) && y-height >= 0 {
+		y -= height
+	} else {
+		y++
+	}
+	if m.viewport.Width > 0 {
+		x = max(0, min(x, m.viewport.Width-lipgloss.Width(box)))
+	}
+
+	return overlay(lines, box, x, y)
+}
+
+// overlay draws box on top of the given lines, with its top left corner at x
+// and y. Styles of the lines are kept on both sides of the box.
+func overlay(lines []string, box string, x, y int) string {
+	for i, boxLine := range strings.Split(box, "\n") {
+		for y+i >= len(lines) {
+			lines = append(lines, "")
+		}
+		line := lines[y+i]
+
+		var left string
+		if x > 0 {
+			left = lipgloss.NewStyle().MaxWidth(x).Render(line)
+		}
+		if w := ansi.PrintableRuneWidth(left); w < x {
+			left += strings.Repeat(" ", x-w)
+		}
+		right := skip(line, x+ansi.PrintableRuneWidth(boxLine))
+		lines[y+i] = left + "\x1b[0m" + boxLine + right
+	}
+	return strings.Join(lines, "\n")
+}
+
+// skip removes the first n cells of a line. Escape sequences are kept so the
+// rest of the line keeps its style. A wide character cut in half is replaced
+// with a space.
+func skip(s string, n int) string {
+	var b strings.Builder
+	width := 0
+	inSequence := false
+	for i, r := range s {
+		switch {
+		case r == ansi.Marker:
+			inSequence = true
+			b.WriteRune(r)
+		case inSequence:
+			b.WriteRune(r)
+			if ansi.IsTerminator(r) {
+				inSequence = false
+			}
+		case width >= n:
+			return b.String() + s[i:]
+		default:
+			width += runewidth.RuneWidth(r)
+			if width > n {
+				b.WriteString(strings.Repeat(" ", width-n))
+			}
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,101 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestCellPopup(t *testing.T) {
+	long := "The quick brown fox jumps over the lazy dog, then keeps running " +
+		"for a good while until it reaches the river and stops to drink."
+	table := New(
+		WithColumns([]Column{{Title: "ID", Width: 4}, {Title: "Note", Width: 8}}),
+		WithRows([]Row{{"1", "short"}, {"2", long}, {"3", "short"}}),
+		WithHeight(10),
+		WithFocused(true),
+		WithCellSelect(true),
+		WithCellPopup(true),
+		WithCellPopupSize(20, 3),
+	)
+	table.MoveDown(1)
+	table.MoveRight(1)
+
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+	if !table.CellPopupOpen() {
+		t.Fatal("expected the popup to open")
+	}
+
+	view := table.View()
+	if !strings.Contains(view, "The quick brown fox") {
+		t.Fatalf("expected the value in the popup:\n%s", view)
+	}
+	if strings.Contains(view, "river") {
+		t.Fatalf("expected the popup to be scrollable, not show everything:\n%s", view)
+	}
+	lines := strings.Split(view, "\n")
+	if !strings.HasPrefix(stripANSI(lines[3]), " 3    ╭") {
+		t.Fatalf("expected the popup below the selected row, at the cell:\n%s", view)
+	}
+	if w := lipgloss.Width(lines[4]); w > 30 {
+		t.Fatalf("expected the popup to be at most 30 cells from the left, got %d:\n%s", w, view)
+	}
+
+	for i := 0; i < 5; i++ {
+		table, _ = table.Update(tea.KeyMsg{Type: tea.KeyDown})
+	}
+	if table.Cursor() != 1 {
+		t.Fatal("expected the popup to receive the key presses")
+	}
+	if view := table.View(); !strings.Contains(view, "river") {
+		t.Fatalf("expected the popup to scroll:\n%s", view)
+	}
+
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyEsc})
+	if table.CellPopupOpen() {
+		t.Fatal("expected esc to close the popup")
+	}
+}
+
+func TestCellPopupDisabled(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "ID", Width: 4}}),
+		WithRows([]Row{{"1"}}),
+		WithFocused(true),
+	)
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("v")})
+	if table.CellPopupOpen() {
+		t.Fatal("expected the popup binding to be disabled by default")
+	}
+}
+
+func TestOverlayKeepsStyles(t *testing.T) {
+	red := lipgloss.NewStyle().Foreground(lipgloss.Color("#FF0000"))
+	base := []string{red.Render("abcdefgh")}
+	got := overlay(base, "XY", 2, 0)
+	if w := lipgloss.Width(got); w != 8 {
+		t.Fatalf("expected width 8, got %d: %q", w, got)
+	}
+	plain := stripANSI(got)
+	if plain != "abXYefgh" {
+		t.Fatalf("expected abXYefgh, got %q", plain)
+	}
+}
+
+func stripANSI(s string) string {
+	var b strings.Builder
+	inSequence := false
+	for _, r := range s {
+		switch {
+		case r == '\x1b':
+			inSequence = true
+		case inSequence:
+			inSequence = !(r >= 0x40 && r <= 0x5a || r >= 0x61 && r <= 0x7a)
+		default:
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,113 @@
+package table
+
+import "github.com/charmbracelet/lipgloss"
+
+// Theme is a ready-made set of table styles. For styles derived from a
+// color palette, see the theme package.
+type Theme int
+
+// Available themes.
+const (
+	// ThemeDefault is the look of DefaultStyles.
+	ThemeDefault Theme = iota
+
+	// ThemeMinimal has no colors and marks the selected row with reverse
+	// video only.
+	ThemeMinimal
+
+	// ThemeBordered underlines the header and column group labels with a
+	// rule and highlights the selected row with a background color.
+	ThemeBordered
+
+	// ThemeCompact drops the cell padding to fit as much data as possible.
+	ThemeCompact
+
+	// ThemeHighContrast uses bright, bold colors for accessibility.
+	ThemeHighContrast
+
+	// ThemeK9s mimics the look of the k9s Kubernetes dashboard: a cyan
+	// header on a dark background and a highlighted selection bar.
+	ThemeK9s
+)
+
+// Styles returns the styles of the theme.
+func (t Theme) Styles() Styles {
+	s := DefaultStyles()
+
+	switch t {
+	case ThemeMinimal:
+		s.Header = lipgloss.NewStyle().Bold(true).Padding(0, 1)
+		s.GroupHeader = s.Header.Copy().Align(lipgloss.Center)
+		s.Selected = lipgloss.NewStyle().Reverse(true)
+		s.Disabled = lipgloss.NewStyle().Faint(true)
+
+	case ThemeBordered:
+		s.Header = s.Header.Copy().
+			BorderStyle(lipgloss.NormalBorder()).
+			BorderForeground(lipgloss.Color("240")).
+			BorderBottom(true)
+		s.GroupHeader = s.GroupHeader.Copy().
+			BorderStyle(lipgloss.NormalBorder()).
+			BorderForeground(lipgloss.Color("240")).
+			BorderBottom(true)
+		s.Selected = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("229")).
+			Background(lipgloss.Color("57"))
+
+	case ThemeCompact:
+		s.Header = lipgloss.NewStyle().Bold(true).Underline(true).PaddingRight(1)
+		s.GroupHeader = lipgloss.NewStyle().Bold(true).PaddingRight(1).Align(lipgloss.Center)
+		s.Cell = lipgloss.NewStyle().PaddingRight(1)
+
+	case ThemeHighContrast:
+		s.Header = s.Header.Copy().
+			Foreground(lipgloss.Color("15")).
+			Background(lipgloss.Color("0")).
+			Underline(true)
+		s.GroupHeader = s.GroupHeader.Copy().
+			Foreground(lipgloss.Color("15")).
+			Background(lipgloss.Color("0"))
+		s.Cell = s.Cell.Copy().Foreground(lipgloss.Color("15"))
+		s.Selected = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("0")).
+			Background(lipgloss.Color("11"))
+		s.SelectedCell = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("0")).
+			Background(lipgloss.Color("14"))
+		s.Disabled = lipgloss.NewStyle().Foreground(lipgloss.Color("7")).Strikethrough(true)
+
+	case ThemeK9s:
+		s.Header = lipgloss.NewStyle().
+			Bold(true).
+			Foreground(lipgloss.Color("#00ffff")).
+			Padding(0, 1)
+		s.GroupHeader = s.Header.Copy().
+			Foreground(lipgloss.Color("#ff8c00")).
+			Align(lipgloss.Center)
+		s.Cell = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#1e90ff")).
+			Padding(0, 1)
+		s.Selected = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#000000")).
+			Background(lipgloss.Color("#00ffff"))
+		s.SelectedCell = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#000000")).
+			Background(lipgloss.Color("#ff8c00"))
+		s.Disabled = lipgloss.NewStyle().Foreground(lipgloss.Color("#708090"))
+		s.FormulaBar = lipgloss.NewStyle().
+			Foreground(lipgloss.Color("#ff8c00")).
+			Padding(0, 1)
+	}
+
+	return s
+}
+
+// WithTheme sets the table styles to one of the ready-made themes:
+//
+//	table := New(WithTheme(ThemeBordered))
+func WithTheme(t Theme) Option {
+	return WithStyles(t.Styles())
+}
@@ -0,0 +1,44 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/bubbletest"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestThemeDefault(t *testing.T) {
+	got, want := ThemeDefault.Styles(), DefaultStyles()
+	if got.Cell.Render("x") != want.Cell.Render("x") || got.Header.Render("x") != want.Header.Render("x") {
+		t.Fatal("expected the default theme to have the default styles")
+	}
+}
+
+func TestThemes(t *testing.T) {
+	themed := func(theme Theme) Model {
+		return testTable(filesFixture, WithHeight(4), WithTheme(theme))
+	}
+
+	// The compact theme only pads the cells on the right.
+	compact, padded := themed(ThemeCompact), themed(ThemeDefault)
+	if got := bubbletest.Plain(compact.renderRow(0)); got != "main.go    file" {
+		t.Fatalf("expected compact cells, got %q", got)
+	}
+	if got := bubbletest.Plain(padded.renderRow(0)); got != " main.go     file" {
+		t.Fatalf("expected padded cells, got %q", got)
+	}
+
+	// The bordered theme rules off the header.
+	header := bubbletest.Plain(themed(ThemeBordered).headersView())
+	if lines := strings.Split(header, "\n"); len(lines) != 2 || !strings.HasPrefix(lines[1], "──") {
+		t.Fatalf("expected a rule under the header, got:\n%s", header)
+	}
+	if h := lipgloss.Height(themed(ThemeDefault).headersView()); h != 1 {
+		t.Fatalf("expected the default header to be one line, got %d", h)
+	}
+
+	if !themed(ThemeMinimal).styles.Selected.GetReverse() {
+		t.Fatal("expected the minimal theme to mark the selection with reverse video")
+	}
+}
@@ -0,0 +1,167 @@
+package table
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/lucasb-eyer/go-colorful"
+)
+
+// RowMeta holds information about a row that isn't displayed in its cells.
+type RowMeta struct {
+	// Time is when the row was created or last changed. It's used to color
+	// rows by recency.
+	Time time.Time
+}
+
+// RowMetaFunc returns the metadata of the row at the given index.
+type RowMetaFunc func(index int, row Row) RowMeta
+
+// Recency configures coloring rows by their age, which is handy for event
+// feeds: new rows are bright and fade out as they get older.
+type Recency struct {
+	// Decay is how long it takes a row to fade from the New to the Old
+	// color.
+	Decay time.Duration
+
+	// New is the foreground color of brand-new rows and Old the color of
+	// rows older than Decay, both as hex colors.
+	New string
+	Old string
+
+	// Interval is how often the colors are refreshed. It defaults to a
+	// twentieth of Decay, but at most once a second.
+	Interval time.Duration
+}
+
+// DefaultRecency returns a recency configuration that fades rows from pink
+// to gray over a minute.
+func DefaultRecency() Recency {
+	return Recency{
+		Decay: time.Minute,
+		New:   "#FF5FD2",
+		Old:   "#585858",
+	}
+}
+
+// RecencyTickMsg refreshes the colors of a table colored by recency.
+type RecencyTickMsg struct {
+	// ID is the ID of the table the message is for.
+	ID int
+
+	// Time is the time of the tick.
+	Time time.Time
+
+	tag int
+}
+
+// WithRowMeta sets the function that provides the metadata of rows.
+func WithRowMeta(f RowMetaFunc) Option {
+	return func(m *Model) {
+		m.rowMeta = f
+	}
+}
+
+// WithRecency colors rows by their age, as reported by the RowMetaFunc. Start
+// refreshing the colors by returning RecencyTick from your Init function.
+func WithRecency(r Recency) Option {
+	return func(m *Model) {
+		m.recency = &r
+	}
+}
+
+// SetRowMeta sets the function that provides the metadata of rows.
+func (m *Model) SetRowMeta(f RowMetaFunc) {
+	m.rowMeta = f
+	m.UpdateViewport()
+}
+
+// SetRecency colors rows by their age. Pass nil to turn coloring off.
+func (m *Model) SetRecency(r *Recency) {
+	m.recency = r
+	m.UpdateViewport()
+}
+
+// RecencyTick returns a command that refreshes the colors of a table colored
+// by recency at the configured interval. The table keeps refreshing as long
+// as its Update function receives the RecencyTickMsg messages.
+func (m Model) RecencyTick() tea.Cmd {
+	if m.recency == nil {
+		return nil
+	}
+	id, tag := m.id, m.recencyTag
+	return tea.Tick(m.recencyInterval(), func(t time.Time) tea.Msg {
+		return RecencyTickMsg{ID: id, Time: t, tag: tag}
+	})
+}
+
+// handleRecencyTick refreshes the colors and schedules the next tick.
+func (m *Model) handleRecencyTick(msg RecencyTickMsg) tea.Cmd {
+	// Reject ticks for other tables, and stale ticks that would refresh too
+	// often.
+	if msg.ID != m.id || msg.tag != m.recencyTag || m.recency == nil {
+		return nil
+	}
+	m.now = msg.Time
+	m.recencyTag++
+	m.UpdateViewport()
+	return m.RecencyTick()
+}
+
+func (m Model) recencyInterval() time.Duration {
+	if m.recency.Interval > 0 {
+		return m.recency.Interval
+	}
+	return max64(time.Second, m.recency.Decay/20)
+}
+
+// recencyStyle returns the style of the row at the given index when coloring
+// by recency. ok is false if the row isn't colored.
+func (m Model) recencyStyle(i int) (style lipgloss.Style, ok bool) {
+	if m.recency == nil || m.rowMeta == nil {
+		return style, false
+	}
+	meta := m.rowMeta(i, m.rows[i])
+	if meta.Time.IsZero() {
+		return style, false
+	}
+
+	now := m.now
+	if now.IsZero() {
+		now = time.Now()
+	}
+
+	return lipgloss.NewStyle().Foreground(lipgloss.Color(m.recency.color(now.Sub(meta.Time)))), true
+}
+
+// color returns the color of a row of the given age.
+func (r Recency) color(age time.Duration) string {
+	newColor, err := colorful.Hex(r.New)
+	if err != nil {
+		return r.New
+	}
+	oldColor, err := colorful.Hex(r.Old)
+	if err != nil {
+		return r.Old
+	}
+
+	t := 1.0
+	if r.Decay > 0 {
+		t = float64(age) / float64(r.Decay)
+	}
+	if t < 0 {
+		t = 0
+	}
+	if t > 1 {
+		t = 1
+	}
+	return newColor.BlendLab(oldColor, t).Clamped().Hex()
+}
+
+func max64(a, b time.Duration) time.Duration {
+	if a > b {
+		return a
+	}
+	return b
+}
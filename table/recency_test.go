@@ -0,0 +1,55 @@
+package table
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRecencyColor(t *testing.T) {
+	r := Recency{Decay: time.Minute, New: "#ff0000", Old: "#0000ff"}
+
+	if c := r.color(0); c != "#ff0000" {
+		t.Fatalf("expected new color for new rows, got %s", c)
+	}
+	if c := r.color(2 * time.Minute); c != "#0000ff" {
+		t.Fatalf("expected old color for old rows, got %s", c)
+	}
+	if c := r.color(30 * time.Second); c == "#ff0000" || c == "#0000ff" {
+		t.Fatalf("expected blended color for rows in between, got %s", c)
+	}
+}
+
+func TestRecencyTick(t *testing.T) {
+	start := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	m := New(
+		WithColumns([]Column{{Title: "Event", Width: 10}}),
+		WithRows([]Row{{"new"}, {"old"}}),
+		WithRowMeta(func(i int, _ Row) RowMeta {
+			return RowMeta{Time: start.Add(-time.Duration(i) * time.Hour)}
+		}),
+		WithRecency(DefaultRecency()),
+	)
+
+	if m.RecencyTick() == nil {
+		t.Fatal("expected a tick command")
+	}
+
+	m, cmd := m.Update(RecencyTickMsg{ID: m.ID(), Time: start})
+	if cmd == nil {
+		t.Fatal("expected the next tick to be scheduled")
+	}
+	if !m.now.Equal(start) {
+		t.Fatal("expected tick to update the time")
+	}
+
+	// Stale ticks are ignored.
+	if _, cmd := m.Update(RecencyTickMsg{ID: m.ID(), Time: start}); cmd != nil {
+		t.Fatal("expected stale tick to be ignored")
+	}
+
+	newStyle, _ := m.recencyStyle(0)
+	oldStyle, _ := m.recencyStyle(1)
+	if newStyle.GetForeground() == oldStyle.GetForeground() {
+		t.Fatal("expected new and old rows to be colored differently")
+	}
+}
@@ -0,0 +1,232 @@
+package table
+
+import (
+	"time"
+
+	"github.com/charmbracelet/bubbles/i18n"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// RefreshFunc fetches fresh rows for the table. It's called in a command, so
+// it may block.
+type RefreshFunc func() ([]Row, error)
+
+// Refresh configures refreshing the table's rows, which is common in
+// monitoring tools. A line above the header shows when the rows were last
+// refreshed and, while auto-refresh is on, the time left until the next
+// refresh. The Refresh binding refreshes the rows right away and the
+// ToggleAutoRefresh binding switches between refreshing at a fixed interval
+// and only on demand.
+type Refresh struct {
+	// Fetch fetches the rows.
+	Fetch RefreshFunc
+
+	// Interval is how often the rows are refreshed while auto-refresh is
+	// on. If it's zero, the rows are only refreshed on demand.
+	Interval time.Duration
+
+	// TimeFormat is the layout the time of the last refresh is shown in. It
+	// defaults to "15:04:05".
+	TimeFormat string
+}
+
+// RefreshedMsg is sent when the table's rows have been refreshed. The table
+// replaces its rows when it receives the message, unless fetching failed.
+type RefreshedMsg struct {
+	// ID is the ID of the table that was refreshed.
+	ID int
+
+	// Rows are the fetched rows.
+	Rows []Row
+
+	// Err is the error that occurred while fetching the rows, if any.
+	Err error
+
+	// Time is when the rows were fetched.
+	Time time.Time
+}
+
+// RefreshTickMsg updates the countdown of a table with auto-refresh on, and
+// refreshes the rows when it's run out.
+type RefreshTickMsg struct {
+	// ID is the ID of the table the message is for.
+	ID int
+
+	// Time is the time of the tick.
+	Time time.Time
+
+	tag int
+}
+
+// refreshState is the state of refreshing the rows.
+type refreshState struct {
+	Refresh
+
+	// Whether refreshing is configured.
+	enabled bool
+
+	// Whether auto-refresh is on and a refresh is running.
+	auto    bool
+	loading bool
+
+	// Time of the last refresh, of the next automatic one and of the last
+	// tick.
+	last, next, now time.Time
+
+	// Error of the last refresh, if it failed.
+	err error
+
+	// Tag of the current tick, to reject stale ones.
+	tag int
+}
+
+// WithRefresh configures refreshing the table's rows, with auto-refresh on if
+// the interval is set. Load the rows and start the countdown by returning
+// Refresh from your Init function.
+func WithRefresh(r Refresh) Option {
+	return func(m *Model) {
+		m.refresh = refreshState{Refresh: r, enabled: true, auto: r.Interval > 0}
+		m.KeyMap.Refresh.SetEnabled(true)
+		m.KeyMap.ToggleAutoRefresh.SetEnabled(r.Interval > 0)
+	}
+}
+
+// Refresh returns a command that refreshes the table's rows now. With
+// auto-refresh on, it also starts the countdown, which starts over whenever
+// the rows are refreshed.
+func (m *Model) Refresh() tea.Cmd {
+	if !m.refresh.enabled {
+		return nil
+	}
+	m.refresh.tag++
+	return tea.Batch(m.fetch(), m.refreshTick())
+}
+
+// fetch returns a command that fetches the rows, unless they're being fetched
+// already.
+func (m *Model) fetch() tea.Cmd {
+	r := &m.refresh
+	if !r.enabled || r.Fetch == nil || r.loading {
+		return nil
+	}
+	r.loading = true
+	id, fetch := m.id, r.Fetch
+	return func() tea.Msg {
+		rows, err := fetch()
+		return RefreshedMsg{ID: id, Rows: rows, Err: err, Time: time.Now()}
+	}
+}
+
+// AutoRefresh returns whether auto-refresh is on.
+func (m Model) AutoRefresh() bool {
+	return m.refresh.enabled && m.refresh.auto
+}
+
+// SetAutoRefresh turns auto-refresh on or off. It has no effect unless a
+// refresh interval is configured. The returned command starts the countdown.
+func (m *Model) SetAutoRefresh(v bool) tea.Cmd {
+	r := &m.refresh
+	if !r.enabled || r.Interval <= 0 || r.auto == v {
+		return nil
+	}
+	r.auto = v
+	r.tag++
+	if !v {
+		return nil
+	}
+	r.now = time.Now()
+	r.next = r.now.Add(r.Interval)
+	return m.refreshTick()
+}
+
+// LastRefresh returns when the rows were last refreshed, or the zero time if
+// they haven't been yet.
+func (m Model) LastRefresh() time.Time {
+	if !m.refresh.enabled {
+		return time.Time{}
+	}
+	return m.refresh.last
+}
+
+// refreshTick returns a command that sends the next tick of the countdown.
+func (m Model) refreshTick() tea.Cmd {
+	if !m.refresh.enabled || !m.refresh.auto {
+		return nil
+	}
+	id, tag := m.id, m.refresh.tag
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return RefreshTickMsg{ID: id, Time: t, tag: tag}
+	})
+}
+
+// handleRefreshTick updates the countdown, refreshes the rows if it has run
+// out and schedules the next tick.
+func (m *Model) handleRefreshTick(msg RefreshTickMsg) tea.Cmd {
+	r := &m.refresh
+	if !r.enabled || msg.ID != m.id || msg.tag != r.tag || !r.auto {
+		return nil
+	}
+	r.now = msg.Time
+	r.tag++
+
+	var cmd tea.Cmd
+	if !r.now.Before(r.next) {
+		cmd = m.fetch()
+	}
+	return tea.Batch(cmd, m.refreshTick())
+}
+
+// handleRefreshed replaces the rows with the fetched ones and restarts the
+// countdown.
+func (m *Model) handleRefreshed(msg RefreshedMsg) {
+	r := &m.refresh
+	if !r.enabled || msg.ID != m.id {
+		return
+	}
+	r.loading = false
+	r.err = msg.Err
+	r.last, r.now = msg.Time, msg.Time
+	r.next = msg.Time.Add(r.Interval)
+	if msg.Err == nil {
+		m.SetRows(msg.Rows)
+	}
+}
+
+// refreshView renders the refresh indicator, right-aligned, or returns an
+// empty string if refreshing isn't configured.
+func (m Model) refreshView() string {
+	r := m.refresh
+	if !r.enabled {
+		return ""
+	}
+
+	format := r.TimeFormat
+	if format == "" {
+		format = "15:04:05"
+	}
+
+	var s string
+	switch {
+	case r.loading:
+		s = i18n.Text("refreshing…")
+	case r.err != nil:
+		s = i18n.Format("refresh failed: %v", r.err)
+	case r.last.IsZero():
+		s = i18n.Text("not refreshed yet")
+	default:
+		s = i18n.Format("refreshed %s", r.last.Format(format))
+	}
+	if r.auto && !r.loading {
+		left := r.next.Sub(r.now).Round(time.Second)
+		s += " · " + i18n.Format("next in %s", max64(0, left))
+	} else if r.Interval > 0 && !r.auto {
+		s += " · " + i18n.Text("auto-refresh off")
+	}
+
+	s = m.styles.Refresh.Render(s)
+	if m.viewport.Width > 0 {
+		s = lipgloss.PlaceHorizontal(m.viewport.Width, lipgloss.Right, s)
+	}
+	return m.clip(s)
+}
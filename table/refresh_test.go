@@ -0,0 +1,87 @@
+package table
+
+import (
+	"errors"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestRefresh(t *testing.T) {
+	fetched := []Row{{"fresh"}}
+	table := New(
+		WithColumns([]Column{{Title: "Name", Width: 10}}),
+		WithRows([]Row{{"stale"}}),
+		WithRefresh(Refresh{Fetch: func() ([]Row, error) { return fetched, nil }}),
+	)
+	if !strings.Contains(table.View(), "not refreshed yet") {
+		t.Fatalf("expected the indicator to show no refresh, got:\n%s", table.View())
+	}
+
+	cmd := table.fetch()
+	if table.fetch() != nil {
+		t.Fatal("expected no second fetch while refreshing")
+	}
+	table, _ = table.Update(cmd())
+	if got := table.SelectedRow(); got[0] != "fresh" {
+		t.Fatalf("expected the fetched rows, got %v", got)
+	}
+	want := "refreshed " + table.LastRefresh().Format("15:04:05")
+	if !strings.Contains(table.View(), want) {
+		t.Fatalf("expected %q in the view, got:\n%s", want, table.View())
+	}
+}
+
+func TestRefreshCopies(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "Name", Width: 10}}),
+		WithRefresh(Refresh{Fetch: func() ([]Row, error) { return nil, nil }}),
+	)
+
+	other := table
+	other.fetch()
+	if !strings.Contains(table.View(), "not refreshed yet") {
+		t.Fatalf("expected a copy's refresh not to affect the table, got:\n%s", table.View())
+	}
+	if table.fetch() == nil {
+		t.Fatal("expected the table to fetch while its copy is refreshing")
+	}
+}
+
+func TestAutoRefresh(t *testing.T) {
+	var calls int
+	table := New(
+		WithColumns([]Column{{Title: "Name", Width: 10}}),
+		WithRefresh(Refresh{
+			Fetch: func() ([]Row, error) {
+				calls++
+				return nil, errors.New("offline")
+			},
+			Interval: 10 * time.Second,
+		}),
+	)
+	start := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	table, _ = table.Update(RefreshedMsg{ID: table.ID(), Time: start})
+
+	table, cmd := table.Update(RefreshTickMsg{ID: table.ID(), Time: start.Add(3 * time.Second), tag: table.refresh.tag})
+	if !strings.Contains(table.View(), "next in 7s") {
+		t.Fatalf("expected the countdown in the view, got:\n%s", table.View())
+	}
+	if calls != 0 || cmd == nil {
+		t.Fatal("expected only the next tick to be scheduled")
+	}
+
+	table, _ = table.Update(RefreshTickMsg{ID: table.ID(), Time: start.Add(10 * time.Second), tag: table.refresh.tag})
+	if !table.refresh.loading {
+		t.Fatal("expected a refresh when the countdown ran out")
+	}
+	table.handleRefreshed(RefreshedMsg{ID: table.ID(), Err: errors.New("offline"), Time: start})
+	if !strings.Contains(table.View(), "refresh failed: offline") {
+		t.Fatalf("expected the error in the view, got:\n%s", table.View())
+	}
+
+	table.SetAutoRefresh(false)
+	if table.AutoRefresh() || !strings.Contains(table.View(), "auto-refresh off") {
+		t.Fatalf("expected auto-refresh to be off, got:\n%s", table.View())
+	}
+}
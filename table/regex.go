@@ -0,0 +1,134 @@
+package table
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// Regex filters
+//
+// Filters starting with "re:", both the table-wide filter and column filters,
+// are regular expressions instead of text to look for, like "re:^v\d+$", or
+// "!re:^v\d+$" to exclude the rows that match. They ignore case like other
+// filters, unless they turn it off with (?-i). In the quick filter prompt,
+// the ToggleRegex binding adds or removes the prefix.
+//
+// A regex filter that doesn't compile doesn't filter: the rows stay as they
+// are, and the compile error is shown in a status line under the table until
+// the filter is fixed. FilterErr returns the error.
+
+// regexPrefix is the prefix of regex filters.
+const regexPrefix = "re:"
+
+// RegexFilter returns the filter that matches the given regular expression,
+// for SetFilter and SetColumnFilter.
+func RegexFilter(pattern string) string {
+	return regexPrefix + pattern
+}
+
+// compileFilter compiles a filter if it's a regex filter.
+func compileFilter(filter string) (re *regexp.Regexp, ok bool, err error) {
+	if !strings.HasPrefix(filter, regexPrefix) {
+		return nil, false, nil
+	}
+	pattern := strings.TrimPrefix(filter, regexPrefix)
+
+	// Compile the pattern as is first, so that errors quote the pattern the
+	// user typed.
+	if _, err := regexp.Compile(pattern); err != nil {
+		return nil, true, err
+	}
+	return regexp.MustCompile("(?i)" + pattern), true, nil
+}
+
+// compileFilters compiles the regex filters before the rows are filtered,
+// leaving out the ones that don't compile.
+func (m *Model) compileFilters() {
+	m.regexps = nil
+	filters := []string{m.filter}
+	for _, f := range m.columnFilters {
+		filters = append(filters, f)
+	}
+	for _, f := range filters {
+		f, _ = splitNegation(f)
+		if re, ok, err := compileFilter(f); ok && err == nil {
+			if m.regexps == nil {
+				m.regexps = make(map[string]*regexp.Regexp)
+			}
+			m.regexps[f] = re
+		}
+	}
+}
+
+// matchesRegex returns whether a value matches a filter if it's a regex
+// filter, and whether it is one. Regex filters that don't compile match all
+// values.
+func (m Model) matchesRegex(filter string, values ...string) (matched, ok bool) {
+	if !strings.HasPrefix(filter, regexPrefix) {
+		return false, false
+	}
+	re := m.regexps[filter]
+	if re == nil {
+		return true, true
+	}
+	for _, v := range values {
+		if re.MatchString(v) {
+			return true, true
+		}
+	}
+	return false, true
+}
+
+// FilterErr returns the compile error of a regex filter: the one being typed
+// in the quick filter prompt, the table-wide filter or a column filter, in
+// that order. It returns nil if all regex filters compile.
+func (m Model) FilterErr() error {
+	filters := []string{m.filter}
+	if m.filtering {
+		filters = append([]string{m.filterInput.Value()}, filters...)
+	}
+
+	cols := make([]int, 0, len(m.columnFilters))
+	for col := range m.columnFilters {
+		cols = append(cols, col)
+	}
+	sort.Ints(cols)
+	for _, col := range cols {
+		filters = append(filters, m.columnFilters[col])
+	}
+
+	for _, f := range filters {
+		f, _ = splitNegation(f)
+		if _, _, err := compileFilter(f); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// toggleRegex adds the regex prefix to the value of the quick filter prompt,
+// after its ! if it has one, or removes it.
+func (m *Model) toggleRegex() {
+	v, exclude := splitNegation(m.filterInput.Value())
+	if strings.HasPrefix(v, regexPrefix) {
+		v = strings.TrimPrefix(v, regexPrefix)
+	} else {
+		v = regexPrefix + v
+	}
+	if exclude {
+		v = "!" + v
+	}
+	m.filterInput.SetValue(v)
+	m.filterInput.CursorEnd()
+}
+
+// filterErrView renders the status line with the compile error of a regex
+// filter, or returns an empty string if there's none.
+func (m Model) filterErrView() string {
+	err := m.FilterErr()
+	if err == nil {
+		return ""
+	}
+	return m.clip(m.styles.FilterError.Render(err.Error()))
+}
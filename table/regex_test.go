@@ -0,0 +1,78 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/bubbletest"
+)
+
+func TestRegexFilter(t *testing.T) {
+	table := testTable(filesFixture)
+
+	table.SetFilter(RegexFilter(`\.(GO|mod)$`))
+	if rows := table.VisibleRows(); len(rows) != 2 || rows[0][0] != "main.go" || rows[1][0] != "go.mod" {
+		t.Fatalf("expected the rows matching the regex, ignoring case, got %v", rows)
+	}
+	if err := table.FilterErr(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	table.SetFilter("")
+	table.SetColumnFilter(1, RegexFilter("^d"))
+	if rows := table.VisibleRows(); len(rows) != 2 || rows[0][0] != "internal" {
+		t.Fatalf("expected the column filter to match the regex, got %v", rows)
+	}
+}
+
+func TestRegexFilterError(t *testing.T) {
+	table := testTable(filesFixture)
+	table.SetFilter(RegexFilter("(go"))
+
+	if rows := table.VisibleRows(); len(rows) != 4 {
+		t.Fatalf("expected an invalid regex not to filter, got %v", rows)
+	}
+	err := table.FilterErr()
+	if err == nil || !strings.Contains(err.Error(), "missing closing )") {
+		t.Fatalf("expected a compile error, got %v", err)
+	}
+	if !strings.Contains(table.View(), "missing closing )") {
+		t.Fatalf("expected the error in the status line:\n%s", table.View())
+	}
+}
+
+func TestRegexFilterPrompt(t *testing.T) {
+	table := testTable(filesFixture, WithColumnFiltering(true))
+	table.Focus()
+
+	h := bubbletest.New(t, table)
+	h.Press("ctrl+f")
+	h.Type("^(m|c")
+	if view := h.View(); !strings.Contains(view, "Name: ^(m|c") || strings.Contains(view, "missing") {
+		t.Fatalf("expected the text to be looked for as is:\n%s", view)
+	}
+
+	h.Press("ctrl+r")
+	table = h.Model().(Model)
+	if got := table.ColumnFilter(0); got != "re:^(m|c" {
+		t.Fatalf("expected regex mode to be toggled on, got %q", got)
+	}
+	if !strings.Contains(h.View(), "missing closing )") {
+		t.Fatalf("expected the compile error while typing:\n%s", h.View())
+	}
+
+	h.Type(")")
+	h.Press("enter")
+	table = h.Model().(Model)
+	if rows := table.VisibleRows(); len(rows) != 2 || rows[0][0] != "main.go" || rows[1][0] != "cmd" {
+		t.Fatalf("expected the regex to filter, got %v", rows)
+	}
+	if table.FilterErr() != nil || strings.Contains(h.View(), "missing") {
+		t.Fatalf("expected the error to be gone:\n%s", h.View())
+	}
+
+	h.Press("ctrl+f", "ctrl+r")
+	if got := h.Model().(Model).ColumnFilter(0); got != "^(m|c)" {
+		t.Fatalf("expected regex mode to be toggled off, got %q", got)
+	}
+}
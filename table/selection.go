@@ -0,0 +1,68 @@
+package table
+
+// SelectionPolicy is what happens to the cursor when the rows are filtered or
+// sorted.
+type SelectionPolicy int
+
+// Available selection policies.
+const (
+	// KeepSelection keeps the selected row selected, wherever it ends up. If
+	// it's filtered out, the cursor stays at the same position. This is the
+	// default, and suits browsing.
+	KeepSelection SelectionPolicy = iota
+
+	// ClampSelection keeps the cursor at the same position, selecting
+	// whichever row ends up there. This suits editing apps that work
+	// through the rows from top to bottom.
+	ClampSelection
+
+	// ResetSelection moves the cursor to the first row.
+	ResetSelection
+)
+
+// WithSelectionPolicy sets what happens to the cursor when the rows are
+// filtered or sorted.
+func WithSelectionPolicy(p SelectionPolicy) Option {
+	return func(m *Model) {
+		m.selectionPolicy = p
+	}
+}
+
+// SetSelectionPolicy sets what happens to the cursor when the rows are
+// filtered or sorted.
+func (m *Model) SetSelectionPolicy(p SelectionPolicy) {
+	m.selectionPolicy = p
+}
+
+// SelectionPolicy returns what happens to the cursor when the rows are
+// filtered or sorted.
+func (m Model) SelectionPolicy() SelectionPolicy {
+	return m.selectionPolicy
+}
+
+// reselect rebuilds the display order after the filters or the sort order
+// have changed, and moves the cursor according to the selection policy.
+func (m *Model) reselect() {
+	selected := -1
+	if m.cursor >= 0 && m.cursor < len(m.order) {
+		selected = m.order[m.cursor]
+	}
+	m.reorder()
+
+	switch m.selectionPolicy {
+	case ResetSelection:
+		m.cursor = 0
+	case ClampSelection:
+		m.cursor = clamp(m.cursor, 0, len(m.order)-1)
+	default:
+		m.cursor = clamp(m.cursor, 0, len(m.order)-1)
+		for pos, i := range m.order {
+			if i == selected {
+				m.cursor = pos
+				break
+			}
+		}
+	}
+	m.skipDisabled(1)
+	m.UpdateViewport()
+}
@@ -0,0 +1,34 @@
+package table
+
+import "testing"
+
+func TestSelectionPolicies(t *testing.T) {
+	tests := []struct {
+		policy   SelectionPolicy
+		sorted   string
+		filtered string
+	}{
+		{KeepSelection, "a", "c"},
+		{ClampSelection, "c", "d"},
+		{ResetSelection, "a", "a"},
+	}
+	for _, tt := range tests {
+		table := New(
+			WithColumns([]Column{{Title: "Name", Width: 4}}),
+			WithRows([]Row{{"d"}, {"b"}, {"a"}, {"c"}}),
+			WithSelectionPolicy(tt.policy),
+		)
+		table.SetCursor(2)
+
+		table.SortBy(0, Ascending)
+		if got := table.SelectedRow()[0]; got != tt.sorted {
+			t.Errorf("policy %d: expected %q after sorting, got %q", tt.policy, tt.sorted, got)
+		}
+
+		table.SetCursor(2)
+		table.MatchRows(func(r Row) bool { return r[0] != "b" })
+		if got := table.SelectedRow()[0]; got != tt.filtered {
+			t.Errorf("policy %d: expected %q after filtering, got %q", tt.policy, tt.filtered, got)
+		}
+	}
+}
@@ -0,0 +1,319 @@
+package table
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// Comparator compares two cell values. It returns a negative number if a
+// sorts before b, a positive number if a sorts after b and zero if they're
+// equal.
+type Comparator func(a, b string) int
+
+// SortOrder is the direction a column is sorted in.
+type SortOrder int
+
+// Available sort orders.
+const (
+	Unsorted SortOrder = iota
+	Ascending
+	Descending
+)
+
+// SortState describes how the table is sorted. The zero value means the
+// table isn't sorted and rows are shown in the order they were given.
+type SortState struct {
+	Column int
+	Order  SortOrder
+}
+
+// WithSort sorts the table by the given column.
+func WithSort(col int, order SortOrder) Option {
+	return func(m *Model) {
+		m.sort = SortState{Column: col, Order: order}
+	}
+}
+
+// SortBy sorts the table by the given column, using the column's comparator.
+// Pass Unsorted to restore the original order of the rows. The cursor moves
+// according to the selection policy; by default, the selected row stays
+// selected.
+func (m *Model) SortBy(col int, order SortOrder) {
+	m.sort = SortState{Column: col, Order: order}
+	m.reselect()
+}
+
+// Sort returns how the table is sorted.
+func (m Model) Sort() SortState {
+	return m.sort
+}
+
+// reorder rebuilds the display order of the rows, leaving out the rows that
+// don't match the filter and the frozen rows. It clears the selected range of
+// cells, whose rows may have moved.
+func (m *Model) reorder() {
+	m.anchor = nil
+	m.compileFilters()
+	n := m.scrollingRows()
+	m.order = make([]int, 0, n)
+	for i := 0; i < n; i++ {
+		if m.matchesFilter(i) {
+			m.order = append(m.order, i)
+		}
+	}
+
+	col := m.sort.Column
+	if m.sort.Order == Unsorted || col < 0 || col >= len(m.cols) {
+		return
+	}
+
+	cmp := m.cols[col].comparator()
+	sort.SliceStable(m.order, func(i, j int) bool {
+		c := cmp(m.CellValue(m.order[i], col), m.CellValue(m.order[j], col))
+		if m.sort.Order == Descending {
+			return c > 0
+		}
+		return c < 0
+	})
+}
+
+// CompareNatural compares strings in natural order, treating runs of digits
+// as numbers: "file2" sorts before "file10".
+func CompareNatural(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	i, j := 0, 0
+	for i < len(ra) && j < len(rb) {
+		if unicode.IsDigit(ra[i]) && unicode.IsDigit(rb[j]) {
+			si := i
+			for i < len(ra) && unicode.IsDigit(ra[i]) {
+				i++
+			}
+			sj := j
+			for j < len(rb) && unicode.IsDigit(rb[j]) {
+				j++
+			}
+			if c := compareDigits(string(ra[si:i]), string(rb[sj:j])); c != 0 {
+				return c
+			}
+			continue
+		}
+		if ra[i] != rb[j] {
+			if ra[i] < rb[j] {
+				return -1
+			}
+			return 1
+		}
+		i++
+		j++
+	}
+
+	switch {
+	case len(ra)-i < len(rb)-j:
+		return -1
+	case len(ra)-i > len(rb)-j:
+		return 1
+	}
+	// Equal in natural order, e.g. "a01" and "a1". Fall back to a plain
+	// comparison so the order is deterministic.
+	return strings.Compare(a, b)
+}
+
+// compareDigits compares two strings of digits by their numeric value,
+// without limits on their length.
+func compareDigits(a, b string) int {
+	a = strings.TrimLeft(a, "0")
+	b = strings.TrimLeft(b, "0")
+	if len(a) != len(b) {
+		if len(a) < len(b) {
+			return -1
+		}
+		return 1
+	}
+	return strings.Compare(a, b)
+}
+
+// CompareFold compares strings case-insensitively.
+func CompareFold(a, b string) int {
+	if c := strings.Compare(strings.ToLower(a), strings.ToLower(b)); c != 0 {
+		return c
+	}
+	return strings.Compare(a, b)
+}
+
+// CompareNumeric compares numbers. Thousands separators and surrounding
+// whitespace are ignored. Values that aren't numbers sort after numbers.
+func CompareNumeric(a, b string) int {
+	fa, errA := parseNumber(a)
+	fb, errB := parseNumber(b)
+	switch {
+	case errA != nil && errB != nil:
+		return strings.Compare(a, b)
+	case errA != nil:
+		return 1
+	case errB != nil:
+		return -1
+	case fa < fb:
+		return -1
+	case fa > fb:
+		return 1
+	}
+	return 0
+}
+
+func parseNumber(s string) (float64, error) {
+	s = strings.ReplaceAll(strings.TrimSpace(s), ",", "")
+	return strconv.ParseFloat(s, 64)
+}
+
+// DateLayouts are the layouts CompareDate tries, in order, when parsing
+// dates.
+var DateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02 15:04:05",
+	"2006-01-02 15:04",
+	"2006-01-02",
+	"2006/01/02",
+	"01/02/2006",
+	"02 Jan 2006",
+	"Jan 2, 2006",
+	"January 2, 2006",
+	time.RFC1123,
+	time.RFC822,
+	time.Kitchen,
+}
+
+// CompareDate compares dates and times in any of the DateLayouts. Values that
+// can't be parsed sort after dates.
+func CompareDate(a, b string) int {
+	ta, okA := parseDate(a)
+	tb, okB := parseDate(b)
+	switch {
+	case !okA && !okB:
+		return strings.Compare(a, b)
+	case !okA:
+		return 1
+	case !okB:
+		return -1
+	case ta.Before(tb):
+		return -1
+	case ta.After(tb):
+		return 1
+	}
+	return 0
+}
+
+func parseDate(s string) (time.Time, bool) {
+	s = strings.TrimSpace(s)
+	for _, layout := range DateLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// CompareSemver compares semantic versions such as "v1.2.3" or
+// "2.0.0-rc.1", following the precedence rules of semver.org. A leading "v"
+// and build metadata are ignored. Values that aren't versions sort after
+// versions.
+func CompareSemver(a, b string) int {
+	va, okA := parseSemver(a)
+	vb, okB := parseSemver(b)
+	switch {
+	case !okA && !okB:
+		return strings.Compare(a, b)
+	case !okA:
+		return 1
+	case !okB:
+		return -1
+	}
+
+	for i := 0; i < 3; i++ {
+		if c := compareDigits(va.core[i], vb.core[i]); c != 0 {
+			return c
+		}
+	}
+
+	// A version without pre-release identifiers has higher precedence.
+	switch {
+	case len(va.pre) == 0 && len(vb.pre) == 0:
+		return 0
+	case len(va.pre) == 0:
+		return 1
+	case len(vb.pre) == 0:
+		return -1
+	}
+
+	for i := 0; i < len(va.pre) && i < len(vb.pre); i++ {
+		pa, pb := va.pre[i], vb.pre[i]
+		na, nb := isNumeric(pa), isNumeric(pb)
+		var c int
+		switch {
+		case na && nb:
+			c = compareDigits(pa, pb)
+		case na:
+			c = -1
+		case nb:
+			c = 1
+		default:
+			c = strings.Compare(pa, pb)
+		}
+		if c != 0 {
+			return c
+		}
+	}
+	switch {
+	case len(va.pre) < len(vb.pre):
+		return -1
+	case len(va.pre) > len(vb.pre):
+		return 1
+	}
+	return 0
+}
+
+type semver struct {
+	core [3]string
+	pre  []string
+}
+
+func parseSemver(s string) (semver, bool) {
+	var v semver
+	s = strings.TrimPrefix(strings.TrimSpace(s), "v")
+	if i := strings.IndexByte(s, '+'); i >= 0 {
+		s = s[:i]
+	}
+	if i := strings.IndexByte(s, '-'); i >= 0 {
+		v.pre = strings.Split(s[i+1:], ".")
+		s = s[:i]
+	}
+
+	parts := strings.Split(s, ".")
+	if len(parts) > 3 {
+		return v, false
+	}
+	for i := range v.core {
+		v.core[i] = "0"
+		if i < len(parts) {
+			if !isNumeric(parts[i]) {
+				return v, false
+			}
+			v.core[i] = parts[i]
+		}
+	}
+	return v, true
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,69 @@
+package table
+
+import (
+	"sort"
+	"testing"
+)
+
+func TestComparators(t *testing.T) {
+	tests := []struct {
+		name   string
+		cmp    Comparator
+		values []string
+	}{
+		{"natural", CompareNatural, []string{"file1", "file2", "file10", "file10b", "file100", "file0100x"}},
+		{"fold", CompareFold, []string{"apple", "Banana", "cherry"}},
+		{"numeric", CompareNumeric, []string{"-3", "2.5", "10", "1,000", "n/a"}},
+		{"date", CompareDate, []string{"Jan 2, 2006", "2006-01-03", "2007-01-02T10:00:00Z", "never"}},
+		{"semver", CompareSemver, []string{"1.0.0-alpha", "1.0.0-alpha.1", "1.0.0-alpha.beta", "1.0.0-beta.2", "1.0.0-beta.11", "1.0.0", "v1.2", "1.10.0+build", "latest"}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := make([]string, len(tc.values))
+			for i := range got {
+				got[i] = tc.values[len(tc.values)-1-i]
+			}
+			sort.SliceStable(got, func(i, j int) bool {
+				return tc.cmp(got[i], got[j]) < 0
+			})
+			for i := range got {
+				if got[i] != tc.values[i] {
+					t.Fatalf("expected %q, got %q", tc.values, got)
+				}
+			}
+		})
+	}
+}
+
+func TestSortBy(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "Name"}, {Title: "Size", Compare: CompareNumeric}}),
+		WithRows([]Row{{"a", "10"}, {"b", "9"}, {"c", "100"}}),
+	)
+
+	table.SortBy(1, Ascending)
+	if got := table.SelectedRow()[0]; got != "a" {
+		t.Fatalf("expected selection to follow the selected row, got %q", got)
+	}
+	if table.Cursor() != 1 {
+		t.Fatalf("expected cursor at 1, got %d", table.Cursor())
+	}
+
+	table.GotoTop()
+	if got := table.SelectedRow()[0]; got != "b" {
+		t.Fatalf("expected smallest row first, got %q", got)
+	}
+
+	table.SortBy(1, Descending)
+	table.GotoTop()
+	if got := table.SelectedRow()[0]; got != "c" {
+		t.Fatalf("expected largest row first, got %q", got)
+	}
+
+	table.SortBy(0, Unsorted)
+	table.GotoTop()
+	if got := table.SelectedRow()[0]; got != "a" {
+		t.Fatalf("expected original order, got %q", got)
+	}
+}
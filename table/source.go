@@ -0,0 +1,184 @@
+package table
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// ParseFunc parses the contents of a file into rows.
+type ParseFunc func(r io.Reader) ([]Row, error)
+
+// Watcher waits for changes to a file. It's usually a thin wrapper around a
+// file system watcher such as fsnotify:
+//
+//	type fsWatcher struct{ *fsnotify.Watcher }
+//
+//	func (w fsWatcher) Wait() error {
+//	    select {
+//	    case <-w.Events:
+//	        return nil
+//	    case err := <-w.Errors:
+//	        return err
+//	    }
+//	}
+type Watcher interface {
+	// Wait blocks until the file changes. It returns an error if the file
+	// can't be watched anymore, which stops watching.
+	Wait() error
+}
+
+// FileLoadedMsg is sent when a file has been loaded into a table with
+// LoadFile or Watch. The table replaces its rows when it receives the message,
+// unless loading failed.
+type FileLoadedMsg struct {
+	// ID is the ID of the table the file was loaded for.
+	ID int
+
+	// Path is the path of the file.
+	Path string
+
+	// Rows are the rows parsed from the file.
+	Rows []Row
+
+	// Err is the error that occurred while loading the file, if any.
+	Err error
+
+	// watch is the command that waits for the next change of a watched
+	// file.
+	watch tea.Cmd
+}
+
+// LoadFile returns a command that loads the file at the given path into the
+// table, parsing it with the given function.
+func (m Model) LoadFile(path string, parse ParseFunc) tea.Cmd {
+	id := m.id
+	return func() tea.Msg {
+		rows, err := loadFile(path, parse)
+		return FileLoadedMsg{ID: id, Path: path, Rows: rows, Err: err}
+	}
+}
+
+// Watch returns a command that loads the file at the given path into the
+// table and loads it again whenever the watcher reports a change, giving a
+// live view of the file:
+//
+//	func (m model) Init() tea.Cmd {
+//	    return m.table.Watch("jobs.csv", watcher, table.ParseCSV(true))
+//	}
+//
+// The table keeps watching as long as its Update function receives the
+// FileLoadedMsg messages. Watching stops when the watcher returns an error,
+// which is reported in a FileLoadedMsg.
+func (m Model) Watch(path string, w Watcher, parse ParseFunc) tea.Cmd {
+	id := m.id
+	var watch tea.Cmd
+	watch = func() tea.Msg {
+		if err := w.Wait(); err != nil {
+			return FileLoadedMsg{ID: id, Path: path, Err: err}
+		}
+		rows, err := loadFile(path, parse)
+		return FileLoadedMsg{ID: id, Path: path, Rows: rows, Err: err, watch: watch}
+	}
+	return func() tea.Msg {
+		rows, err := loadFile(path, parse)
+		return FileLoadedMsg{ID: id, Path: path, Rows: rows, Err: err, watch: watch}
+	}
+}
+
+// handleFileLoaded replaces the rows with the loaded ones and keeps watching
+// the file.
+func (m *Model) handleFileLoaded(msg FileLoadedMsg) tea.Cmd {
+	if msg.ID != m.id {
+		return nil
+	}
+	if msg.Err == nil {
+		m.SetRows(msg.Rows)
+	}
+	return msg.watch
+}
+
+func loadFile(path string, parse ParseFunc) ([]Row, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close() //nolint:errcheck
+	return parse(f)
+}
+
+// ParseCSV returns a function that parses comma-separated values. If header
+// is set, the first record is skipped. Records may have varying numbers of
+// fields.
+func ParseCSV(header bool) ParseFunc {
+	return func(r io.Reader) ([]Row, error) {
+		cr := csv.NewReader(r)
+		cr.FieldsPerRecord = -1
+		records, err := cr.ReadAll()
+		if err != nil {
+			return nil, err
+		}
+		if header && len(records) > 0 {
+			records = records[1:]
+		}
+		rows := make([]Row, len(records))
+		for i, record := range records {
+			rows[i] = record
+		}
+		return rows, nil
+	}
+}
+
+// ParseJSON returns a function that parses a JSON array of rows. Rows can be
+// arrays of values, or objects whose values at the given keys become the
+// cells. Values that aren't strings are formatted as JSON, except for null,
+// which becomes an empty cell.
+func ParseJSON(keys ...string) ParseFunc {
+	return func(r io.Reader) ([]Row, error) {
+		var items []json.RawMessage
+		if err := json.NewDecoder(r).Decode(&items); err != nil {
+			return nil, err
+		}
+
+		rows := make([]Row, len(items))
+		for i, item := range items {
+			var values []json.RawMessage
+			if err := json.Unmarshal(item, &values); err != nil {
+				var object map[string]json.RawMessage
+				if err := json.Unmarshal(item, &object); err != nil {
+					return nil, fmt.Errorf("row %d: expected an array or an object", i)
+				}
+				if len(keys) == 0 {
+					return nil, errors.New("keys are required to parse objects")
+				}
+				values = make([]json.RawMessage, len(keys))
+				for j, key := range keys {
+					values[j] = object[key]
+				}
+			}
+
+			rows[i] = make(Row, len(values))
+			for j, v := range values {
+				rows[i][j] = jsonCell(v)
+			}
+		}
+		return rows, nil
+	}
+}
+
+// jsonCell returns the cell value of a JSON value.
+func jsonCell(v json.RawMessage) string {
+	var s string
+	if err := json.Unmarshal(v, &s); err == nil {
+		return s
+	}
+	if len(v) == 0 || string(v) == "null" {
+		return ""
+	}
+	return string(v)
+}
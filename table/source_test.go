@@ -0,0 +1,94 @@
+package table
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+type chanWatcher chan error
+
+func (w chanWatcher) Wait() error {
+	return <-w
+}
+
+func TestWatch(t *testing.T) {
+	dir, err := ioutil.TempDir("", "table")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir) //nolint:errcheck
+	path := filepath.Join(dir, "jobs.csv")
+	if err := ioutil.WriteFile(path, []byte("name,state\nbuild,ok\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	table := New(WithColumns([]Column{{Title: "Name", Width: 8}, {Title: "State", Width: 8}}))
+	w := make(chanWatcher, 1)
+	cmd := table.Watch(path, w, ParseCSV(true))
+
+	table, cmd = table.Update(cmd())
+	if rows := table.VisibleRows(); len(rows) != 1 || rows[0][0] != "build" {
+		t.Fatalf("expected the file to be loaded, got %v", rows)
+	}
+
+	if err := ioutil.WriteFile(path, []byte("name,state\nbuild,ok\ntest,failed\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	w <- nil
+	table, cmd = table.Update(cmd())
+	if rows := table.VisibleRows(); len(rows) != 2 || rows[1][1] != "failed" {
+		t.Fatalf("expected the file to be reloaded, got %v", rows)
+	}
+
+	w <- errors.New("watcher closed")
+	msg := cmd().(FileLoadedMsg)
+	table, cmd = table.Update(msg)
+	if msg.Err == nil || cmd != nil {
+		t.Fatal("expected watching to stop with an error")
+	}
+	if len(table.VisibleRows()) != 2 {
+		t.Fatal("expected the rows to be kept")
+	}
+}
+
+func TestParseJSON(t *testing.T) {
+	input := `[["a", 1, null], {"name": "b", "size": 2.5, "ok": true}]`
+	rows, err := ParseJSON("name", "size", "ok")(strings.NewReader(input))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := [][]string{{"a", "1", ""}, {"b", "2.5", "true"}}
+	for i := range want {
+		if strings.Join(rows[i], "|") != strings.Join(want[i], "|") {
+			t.Fatalf("row %d: expected %v, got %v", i, want[i], rows[i])
+		}
+	}
+
+	if _, err := ParseJSON()(strings.NewReader(`[{"name": "a"}]`)); err == nil {
+		t.Fatal("expected an error for objects without keys")
+	}
+}
+
+func TestParseCSVRaggedRows(t *testing.T) {
+	rows, err := ParseCSV(false)(strings.NewReader("a,b\nc\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	table := New(WithColumns([]Column{{Title: "Name", Width: 8}}), WithRows(rows), WithHeight(5))
+	if view := table.View(); !strings.Contains(view, "a") || strings.Contains(view, "b") {
+		t.Fatalf("expected the fields beyond the columns to be left out, got %q", view)
+	}
+
+	table = New(WithColumns([]Column{{Title: "Name", Width: 8}, {Title: "State", Width: 8}}), WithRows(rows), WithHeight(5))
+	lines := strings.Split(table.View(), "\n")
+	if lipgloss.Width(lines[1]) != lipgloss.Width(lines[2]) {
+		t.Fatalf("expected short rows to be padded to the columns, got %q", lines)
+	}
+}
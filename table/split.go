@@ -0,0 +1,140 @@
+package table
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Split view
+//
+// In split mode the rows are shown twice, in a top and a bottom pane, each
+// with its own cursor and scroll position. Only one pane is active at a time:
+// it receives the key presses and its cursor is the table's cursor. The
+// SwapPane binding makes the other pane active, so users can compare rows that
+// are far apart without losing their place. Split mode has no effect when the
+// body is rendered into an embedded viewport, see WithViewport.
+
+// pane is the cursor and scroll position of the inactive pane in split mode.
+type pane struct {
+	cursor int
+	offset int
+}
+
+// WithSplit turns on split mode.
+func WithSplit(v bool) Option {
+	return func(m *Model) {
+		m.split = v
+	}
+}
+
+// Split returns whether the table is in split mode.
+func (m Model) Split() bool {
+	return m.split
+}
+
+// SetSplit turns split mode on or off. When it's turned on, both panes start
+// at the current cursor. When it's turned off, the active pane's cursor is
+// kept.
+func (m *Model) SetSplit(v bool) {
+	if v && !m.split {
+		m.other = pane{cursor: m.cursor, offset: m.offset}
+	}
+	m.split = v
+	m.UpdateViewport()
+}
+
+// ActivePane returns 0 if the top pane is active and 1 if the bottom pane is.
+func (m Model) ActivePane() int {
+	if m.bottomActive {
+		return 1
+	}
+	return 0
+}
+
+// SwapPane makes the other pane active in split mode.
+func (m *Model) SwapPane() {
+	if !m.split || m.embedded {
+		return
+	}
+	m.cursor, m.other.cursor = m.other.cursor, m.cursor
+	m.offset, m.other.offset = m.other.offset, m.offset
+	m.bottomActive = !m.bottomActive
+	m.cursor = clamp(m.cursor, 0, len(m.order)-1)
+	m.UpdateViewport()
+}
+
+// paneHeights returns the number of rows in the top and bottom panes, leaving
+// a line for the separator.
+func (m Model) paneHeights() (top, bottom int) {
+	h := max(0, m.bodyHeight()-1)
+	top = h / 2
+	return top, h - top
+}
+
+// pageSize returns the number of rows shown in the active pane.
+func (m Model) pageSize() int {
+	if !m.split || m.embedded {
+		return m.bodyHeight()
+	}
+	top, bottom := m.paneHeights()
+	if m.bottomActive {
+		return bottom
+	}
+	return top
+}
+
+// renderInactivePane renders the rows of the inactive pane and updates its
+// scroll position.
+func (m *Model) renderInactivePane() []string {
+	top, bottom := m.paneHeights()
+	height := bottom
+	if m.bottomActive {
+		height = top
+	}
+
+	m.cursor, m.other.cursor = m.other.cursor, m.cursor
+	m.offset, m.other.offset = m.other.offset, m.offset
+	m.cursor = clamp(m.cursor, 0, len(m.order)-1)
+	rows := m.renderPane(height)
+
+	m.cursor, m.other.cursor = m.other.cursor, m.cursor
+	m.offset, m.other.offset = m.other.offset, m.offset
+	return rows
+}
+
+// splitView joins the rendered rows of the active and inactive panes, with a
+// line between them.
+func (m Model) splitView(active, inactive []string) string {
+	top, bottom := m.paneHeights()
+	if m.bottomActive {
+		active, inactive = inactive, active
+	}
+
+	width := m.indicatorWidth()
+	for i, col := range m.cols {
+		if !col.Hidden {
+			width += m.colWidth(i) + horizontalFrameSize(m.styles.Cell)
+		}
+	}
+	separator := m.clip(m.styles.SplitSeparator.Render(strings.Repeat("─", width)))
+
+	lines := make([]string, 0, top+bottom+1)
+	lines = append(lines, padLines(active, top)...)
+	lines = append(lines, separator)
+	lines = append(lines, padLines(inactive, bottom)...)
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
+
+// padLines pads rendered rows with empty lines up to the given height. Rows
+// spanning more than one line count as such.
+func padLines(rows []string, height int) []string {
+	n := 0
+	for _, row := range rows {
+		n += lipgloss.Height(row)
+	}
+	for ; n < height; n++ {
+		rows = append(rows, "")
+	}
+	return rows
+}
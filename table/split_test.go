@@ -0,0 +1,55 @@
+package table
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var splitFixture = []Option{
+	WithColumns([]Column{{Title: "Name", Width: 10}}),
+	WithRows(numberedRows(100)),
+	WithHeight(9),
+	WithFocused(true),
+	WithSplit(true),
+}
+
+func TestSplitPanes(t *testing.T) {
+	table := testTable(splitFixture)
+	table.GotoBottom()
+
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyCtrlW})
+	if table.ActivePane() != 1 || table.Cursor() != 0 {
+		t.Fatalf("expected the bottom pane at row 0 to be active, got pane %d at %d", table.ActivePane(), table.Cursor())
+	}
+	table.MoveDown(2)
+
+	view := table.View()
+	top := strings.Index(view, "row 99")
+	bottom := strings.Index(view, "row 2 ")
+	if top < 0 || bottom < 0 || top > bottom {
+		t.Fatalf("expected the last row on top and row 2 below:\n%s", view)
+	}
+	if lines := strings.Count(view, "\n") + 1; lines != 10 {
+		t.Fatalf("expected 10 lines, got %d:\n%s", lines, view)
+	}
+
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyCtrlW})
+	if table.ActivePane() != 0 || table.Cursor() != 99 {
+		t.Fatalf("expected the top pane to keep its place, got pane %d at %d", table.ActivePane(), table.Cursor())
+	}
+}
+
+func TestSplitPageSize(t *testing.T) {
+	table := testTable(splitFixture)
+	table, _ = table.Update(tea.KeyMsg{Type: tea.KeyPgDown})
+	if table.Cursor() != 4 {
+		t.Fatalf("expected to move by the pane's height, got %d", table.Cursor())
+	}
+
+	table.SetSplit(false)
+	if table.Cursor() != 4 {
+		t.Fatalf("expected the cursor to be kept, got %d", table.Cursor())
+	}
+}
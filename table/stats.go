@@ -0,0 +1,30 @@
+package table
+
+import "time"
+
+// Stats describes the cost of rendering the table's rows. Apps can use them to
+// surface slow frames, for example in a debug status bar.
+type Stats struct {
+	// RenderedRows is the number of rows that were rendered.
+	RenderedRows int
+
+	// TotalRows is the number of rows that pass the filters.
+	TotalRows int
+
+	// Duration is how long rendering the rows took.
+	Duration time.Duration
+}
+
+// WithRenderStats sets a function that's called with render statistics every
+// time the table renders its rows.
+func WithRenderStats(f func(Stats)) Option {
+	return func(m *Model) {
+		m.onRenderStats = f
+	}
+}
+
+// OnRenderStats sets a function that's called with render statistics every
+// time the table renders its rows. Pass nil to stop collecting statistics.
+func (m *Model) OnRenderStats(f func(Stats)) {
+	m.onRenderStats = f
+}
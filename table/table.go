@@ -1,26 +1,161 @@
 package table
 
 import (
+	"io"
+	"regexp"
 	"strings"
+	"time"
 
+	"github.com/charmbracelet/bubbles/confirm"
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/route"
+	"github.com/charmbracelet/bubbles/textinput"
 	"github.com/charmbracelet/bubbles/viewport"
+	"github.com/charmbracelet/bubbles/virtual"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
-	"github.com/mattn/go-runewidth"
 )
 
 // Model defines a state for the table widget.
 type Model struct {
 	KeyMap KeyMap
 
-	cols   []Column
-	rows   []Row
+	id   int
+	cols []Column
+	rows []Row
+
+	// widths holds the widths of the columns, with relative widths resolved
+	// against the width of the table.
+	widths []int
 	cursor int
+
+	// order holds the indices of the rows in the order they're displayed.
+	// The cursor is a position in order, not in rows.
+	order  []int
+	sort   SortState
+	filter string
+	match  func(Row) bool
+
+	// The compiled regex filters, by filter.
+	regexps map[string]*regexp.Regexp
+
+	// Column filters and the quick filter prompt, and whether the user can
+	// open it.
+	columnFilters   map[int]string
+	columnFiltering bool
+	filtering       bool
+	filterCol       int
+	filterBefore    string
+	filterInput     textinput.Model
+
+	// Filter presets, and the filters from before cycling through them.
+	filterPresets []FilterPreset
+	presetBefore  *FilterPreset
+
 	focus  bool
 	styles Styles
 
+	// Split mode, where other is the inactive pane.
+	split        bool
+	other        pane
+	bottomActive bool
+
+	popup     popup
+	showStats bool
+
+	// The configured pivot, and the raw table while it's pivoted.
+	pivot   *Pivot
+	pivoted *pivotState
+
+	// Columns compared to find duplicate rows, and the duplicate rows by
+	// their index in rows. Duplicates aren't highlighted if dupCols is nil.
+	dupCols    []int
+	duplicates map[int]bool
+
+	// Selection markers and the rows marked for multi-selection, by their
+	// index in rows.
+	indicator   *Indicator
+	multiSelect bool
+	marked      map[int]bool
+
+	// Cell selection. When enabled, colCursor is the index of the selected
+	// column in the selected row. If selectable is set, only the columns in
+	// it can be selected.
+	cellSelect bool
+	colCursor  int
+	selectable map[int]bool
+
+	// The corner of the selected range of cells opposite the cursor, if a
+	// range is selected, and where the clipboard escape sequence is written
+	// instead of using the system clipboard, if anywhere.
+	anchor    *cellPos
+	clipboard io.Writer
+
+	// Spreadsheet-style formulas. The sheet is nil unless formulas are
+	// enabled.
+	formulas bool
+	sheet    *sheet
+
+	// Disabled rows, by index.
+	disabled       map[int]bool
+	disabledPolicy DisabledPolicy
+
+	// Whether the selected row shows the full, wrapped cell contents.
+	wrapSelected bool
+
+	// Header rendering.
+	headerHidden bool
+	renderHeader HeaderRenderFunc
+
+	// Actions waiting for confirmation.
+	confirmations map[Action]Confirmation
+	confirm       confirm.Model
+	pending       *pendingAction
+
+	// Row metadata and coloring by recency. now is the time of the last
+	// recency tick.
+	rowMeta    RowMetaFunc
+	recency    *Recency
+	recencyTag int
+	now        time.Time
+
+	// Called with render statistics, if set.
+	onRenderStats func(Stats)
+
+	// Recorded keyboard macros.
+	macros macros
+
+	// Jumping to rows by typing their first characters.
+	typeAhead typeAhead
+
+	// What happens to the cursor when the rows are filtered or sorted.
+	selectionPolicy SelectionPolicy
+
+	// Number of rows at the end of rows that are frozen below the others.
+	frozen int
+
+	// Refreshing the rows, if configured.
+	refresh refreshState
+
+	// Cell editing. editing is the cell being edited, if any, and template
+	// the row inserted when the user adds a row.
+	editable bool
+	editing  *cellEdit
+	template Row
+
+	// Index of the first visible row. Only the visible rows are rendered
+	// into the viewport.
+	offset int
+
+	// Whether all rows are rendered into the viewport, which does the
+	// scrolling. See WithViewport.
+	embedded bool
 	viewport viewport.Model
+
+	// Whether clicks select rows and the mouse wheel moves the cursor, and
+	// the position of the table in the terminal window. See WithMouse.
+	mouse                bool
+	xPosition, yPosition int
 }
 
 // Row represents one line in the table.
@@ -30,8 +165,55 @@ type Row []string
 type Column struct {
 	Title string
 	Width int
+
+	// WidthPercent sizes the column relative to the table: the column gets
+	// this percentage of the width that's left after the fixed-width
+	// columns and the cell padding. The width is recalculated whenever the
+	// table is resized. Width is used instead as long as the table's width
+	// isn't known.
+	WidthPercent float64
+
+	// Group is the title of the column group this column belongs to.
+	// Adjacent columns with the same group are shown under a single label
+	// spanning all of them in an additional header row above the column
+	// titles. The group header row is only shown if at least one column has a
+	// group.
+	Group string
+
+	// Compare is used to sort the table by this column. Built-in comparators
+	// include CompareNatural, CompareNumeric, CompareDate, CompareSemver and
+	// CompareFold. If nil, values are compared according to the column's
+	// Kind.
+	Compare Comparator
+
+	// Hidden hides the column.
+	Hidden bool
+
+	// Direction is the writing direction of the column's text. Right-to-left
+	// text is aligned to the right.
+	Direction Direction
+
+	// Kind is the type of the column's data, which sets its default
+	// alignment, formatting and sorting. See Kind.
+	Kind Kind
+
+	// Format formats the column's values for display, overriding the
+	// formatting of its Kind. Sorting and filtering use the values
+	// themselves.
+	Format func(value string) string
+
+	// ReadOnly keeps the column's cells from being edited when editing is
+	// enabled.
+	ReadOnly bool
 }
 
+// HeaderRenderFunc renders the content of a column header, for example to
+// add icons, badges or sort indicators to the title. The sort state is the
+// column's own: its Order is Unsorted unless the table is sorted by this
+// column. The result is fit to the column width and styled with the Header
+// style.
+type HeaderRenderFunc func(col Column, sorted SortState) string
+
 // KeyMap defines keybindings. It satisfies to the help.KeyMap interface, which
 // is used to render the menu menu.
 type KeyMap struct {
@@ -43,6 +225,60 @@ type KeyMap struct {
 	HalfPageDown key.Binding
 	GotoTop      key.Binding
 	GotoBottom   key.Binding
+	Activate     key.Binding
+	DeleteRow    key.Binding
+	FilterColumn key.Binding
+	ClearFilters key.Binding
+	ToggleRegex  key.Binding
+	SwapPane     key.Binding
+	ShowCell     key.Binding
+	ClosePopup   key.Binding
+	ToggleStats  key.Binding
+
+	// Keybinding used when filter presets are configured.
+	NextFilterPreset key.Binding
+
+	// Keybindings used in the quick filter prompt. AcceptFilter closes the
+	// prompt and CancelFilter also restores the column's previous filter.
+	AcceptFilter key.Binding
+	CancelFilter key.Binding
+
+	// Keybindings used when cell selection is enabled.
+	CellLeft    key.Binding
+	CellRight   key.Binding
+	SelectUp    key.Binding
+	SelectDown  key.Binding
+	SelectLeft  key.Binding
+	SelectRight key.Binding
+
+	// Copy copies the selected range, cell or row to the clipboard.
+	Copy key.Binding
+
+	// Keybindings used when macros are enabled.
+	RecordMacro key.Binding
+	ReplayMacro key.Binding
+
+	// Keybinding used when multi-selection is enabled.
+	ToggleMark key.Binding
+
+	// Keybinding used when a pivot is configured.
+	TogglePivot key.Binding
+
+	// Keybinding used when duplicates are highlighted.
+	NextDuplicate key.Binding
+
+	// Keybindings used when refreshing is configured.
+	Refresh           key.Binding
+	ToggleAutoRefresh key.Binding
+
+	// Keybindings used when editing is enabled. NextField and PrevField
+	// move between the cells of a row being added.
+	EditCell   key.Binding
+	AddRow     key.Binding
+	CommitEdit key.Binding
+	CancelEdit key.Binding
+	NextField  key.Binding
+	PrevField  key.Binding
 }
 
 // DefaultKeyMap returns a default set of keybindings.
@@ -81,23 +317,220 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("end", "G"),
 			key.WithHelp("G/end", "go to end"),
 		),
+		Activate: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "select"),
+		),
+		DeleteRow: key.NewBinding(
+			key.WithKeys("x", "delete"),
+			key.WithHelp("x", "delete"),
+			key.WithDisabled(),
+		),
+		FilterColumn: key.NewBinding(
+			key.WithKeys("ctrl+f"),
+			key.WithHelp("ctrl+f", "filter column"),
+			key.WithDisabled(),
+		),
+		ClearFilters: key.NewBinding(
+			key.WithKeys("X"),
+			key.WithHelp("X", "clear filters"),
+		),
+		ToggleRegex: key.NewBinding(
+			key.WithKeys("ctrl+r"),
+			key.WithHelp("ctrl+r", "regex"),
+		),
+		NextFilterPreset: key.NewBinding(
+			key.WithKeys("F"),
+			key.WithHelp("F", "filter preset"),
+		),
+		AcceptFilter: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "apply filter"),
+		),
+		CancelFilter: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "cancel"),
+		),
+		SwapPane: key.NewBinding(
+			key.WithKeys("ctrl+w"),
+			key.WithHelp("ctrl+w", "swap pane"),
+		),
+		ShowCell: key.NewBinding(
+			key.WithKeys("v"),
+			key.WithHelp("v", "view cell"),
+			key.WithDisabled(),
+		),
+		ClosePopup: key.NewBinding(
+			key.WithKeys("esc", "q"),
+			key.WithHelp("esc", "close"),
+		),
+		ToggleStats: key.NewBinding(
+			key.WithKeys("S"),
+			key.WithHelp("S", "column stats"),
+			key.WithDisabled(),
+		),
+		CellLeft: key.NewBinding(
+			key.WithKeys("left", "h"),
+			key.WithHelp("←/h", "left"),
+		),
+		CellRight: key.NewBinding(
+			key.WithKeys("right", "l"),
+			key.WithHelp("→/l", "right"),
+		),
+		SelectUp: key.NewBinding(
+			key.WithKeys("shift+up", "K"),
+			key.WithHelp("shift+↑/K", "select up"),
+		),
+		SelectDown: key.NewBinding(
+			key.WithKeys("shift+down", "J"),
+			key.WithHelp("shift+↓/J", "select down"),
+		),
+		SelectLeft: key.NewBinding(
+			key.WithKeys("shift+left", "H"),
+			key.WithHelp("shift+←/H", "select left"),
+		),
+		SelectRight: key.NewBinding(
+			key.WithKeys("shift+right", "L"),
+			key.WithHelp("shift+→/L", "select right"),
+		),
+		Copy: key.NewBinding(
+			key.WithKeys("y"),
+			key.WithHelp("y", "copy"),
+			key.WithDisabled(),
+		),
+		RecordMacro: key.NewBinding(
+			key.WithKeys("q"),
+			key.WithHelp("q", "record macro"),
+			key.WithDisabled(),
+		),
+		ReplayMacro: key.NewBinding(
+			key.WithKeys("@"),
+			key.WithHelp("@", "replay macro"),
+			key.WithDisabled(),
+		),
+		ToggleMark: key.NewBinding(
+			key.WithKeys("m"),
+			key.WithHelp("m", "mark"),
+			key.WithDisabled(),
+		),
+		TogglePivot: key.NewBinding(
+			key.WithKeys("P"),
+			key.WithHelp("P", "pivot"),
+			key.WithDisabled(),
+		),
+		NextDuplicate: key.NewBinding(
+			key.WithKeys("D"),
+			key.WithHelp("D", "next duplicate"),
+			key.WithDisabled(),
+		),
+		Refresh: key.NewBinding(
+			key.WithKeys("r"),
+			key.WithHelp("r", "refresh"),
+			key.WithDisabled(),
+		),
+		ToggleAutoRefresh: key.NewBinding(
+			key.WithKeys("R"),
+			key.WithHelp("R", "auto-refresh"),
+			key.WithDisabled(),
+		),
+		EditCell: key.NewBinding(
+			key.WithKeys("e"),
+			key.WithHelp("e", "edit"),
+			key.WithDisabled(),
+		),
+		AddRow: key.NewBinding(
+			key.WithKeys("o"),
+			key.WithHelp("o", "add row"),
+			key.WithDisabled(),
+		),
+		CommitEdit: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "save"),
+		),
+		CancelEdit: key.NewBinding(
+			key.WithKeys("esc"),
+			key.WithHelp("esc", "cancel"),
+		),
+		NextField: key.NewBinding(
+			key.WithKeys("tab"),
+			key.WithHelp("tab", "next cell"),
+		),
+		PrevField: key.NewBinding(
+			key.WithKeys("shift+tab"),
+			key.WithHelp("shift+tab", "previous cell"),
+		),
+	}
+}
+
+// ShortHelp implements the KeyMap interface.
+func (km KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{km.LineUp, km.LineDown, km.Activate}
+}
+
+// FullHelp implements the KeyMap interface.
+func (km KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{km.LineUp, km.LineDown, km.GotoTop, km.GotoBottom},
+		{km.PageUp, km.PageDown, km.HalfPageUp, km.HalfPageDown},
+		{km.Activate, km.DeleteRow, km.FilterColumn},
+		{km.ShowCell, km.ToggleStats, km.Copy},
 	}
 }
 
 // Styles contains style definitions for this list component. By default, these
 // values are generated by DefaultStyles.
 type Styles struct {
-	Header   lipgloss.Style
-	Cell     lipgloss.Style
-	Selected lipgloss.Style
+	Header         lipgloss.Style
+	GroupHeader    lipgloss.Style
+	Cell           lipgloss.Style
+	Selected       lipgloss.Style
+	SelectedCell   lipgloss.Style
+	Disabled       lipgloss.Style
+	FormulaBar     lipgloss.Style
+	FilterChip     lipgloss.Style
+	SplitSeparator lipgloss.Style
+	Popup          lipgloss.Style
+	StatusBar      lipgloss.Style
+	FilterError    lipgloss.Style
+	Frozen         lipgloss.Style
+	Duplicate      lipgloss.Style
+	Range          lipgloss.Style
+	Refresh        lipgloss.Style
+
+	// Styles of the markers in the indicator column.
+	Indicator     lipgloss.Style
+	CellIndicator lipgloss.Style
+	MarkIndicator lipgloss.Style
 }
 
 // DefaultStyles returns a set of default style definitions for this table.
 func DefaultStyles() Styles {
 	return Styles{
-		Selected: lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("212")),
-		Header:   lipgloss.NewStyle().Bold(true).Padding(0, 1),
-		Cell:     lipgloss.NewStyle().Padding(0, 1),
+		Selected:     lipgloss.NewStyle().Bold(true).Foreground(lipgloss.Color("212")),
+		SelectedCell: lipgloss.NewStyle().Bold(true).Reverse(true),
+		Disabled:     lipgloss.NewStyle().Faint(true).Foreground(lipgloss.Color("240")),
+		Header:       lipgloss.NewStyle().Bold(true).Padding(0, 1),
+		GroupHeader:  lipgloss.NewStyle().Bold(true).Padding(0, 1).Align(lipgloss.Center),
+		Cell:         lipgloss.NewStyle().Padding(0, 1),
+		FormulaBar:   lipgloss.NewStyle().Faint(true).Padding(0, 1),
+		FilterChip: lipgloss.NewStyle().
+			Foreground(lipgloss.Color("252")).
+			Background(lipgloss.Color("238")).
+			Padding(0, 1),
+		SplitSeparator: lipgloss.NewStyle().Foreground(lipgloss.Color("240")),
+		Popup: lipgloss.NewStyle().
+			BorderStyle(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("240")).
+			Padding(0, 1),
+		StatusBar:     lipgloss.NewStyle().Faint(true).Padding(0, 1),
+		FilterError:   lipgloss.NewStyle().Foreground(lipgloss.Color("196")).Padding(0, 1),
+		Frozen:        lipgloss.NewStyle().Bold(true),
+		Duplicate:     lipgloss.NewStyle().Foreground(lipgloss.Color("214")),
+		Range:         lipgloss.NewStyle().Reverse(true),
+		Refresh:       lipgloss.NewStyle().Faint(true).Padding(0, 1),
+		Indicator:     lipgloss.NewStyle().Foreground(lipgloss.Color("212")),
+		CellIndicator: lipgloss.NewStyle().Foreground(lipgloss.Color("212")).Faint(true),
+		MarkIndicator: lipgloss.NewStyle().Foreground(lipgloss.Color("214")),
 	}
 }
 
@@ -115,6 +548,8 @@ type Option func(*Model)
 // New creates a new model for the table widget.
 func New(opts ...Option) Model {
 	m := Model{
+		id:       route.NextID(),
+		confirm:  confirm.New(),
 		cursor:   0,
 		viewport: viewport.New(0, 20),
 
@@ -126,6 +561,10 @@ func New(opts ...Option) Model {
 		opt(&m)
 	}
 
+	m.recalc()
+	m.reorder()
+	m.skipDisabled(1)
+	m.colCursor = m.selectableColumn(m.colCursor)
 	m.UpdateViewport()
 
 	return m
@@ -180,39 +619,85 @@ func WithKeyMap(km KeyMap) Option {
 	}
 }
 
-// Update is the Bubble Tea update loop.
+// WithCellSelect enables or disables cell selection. When enabled, a single
+// cell is selected instead of the entire row and the cursor can be moved
+// between columns.
+func WithCellSelect(v bool) Option {
+	return func(m *Model) {
+		m.cellSelect = v
+	}
+}
+
+// WithSelectableColumns restricts cell selection to the columns at the given
+// indices. By default, all columns are selectable.
+func WithSelectableColumns(cols ...int) Option {
+	return func(m *Model) {
+		m.setSelectableColumns(cols)
+	}
+}
+
+// WithWrapSelected sets whether the selected row expands to show the full
+// contents of its cells, wrapped to the column widths. All other rows stay
+// truncated.
+func WithWrapSelected(v bool) Option {
+	return func(m *Model) {
+		m.wrapSelected = v
+	}
+}
+
+// WithHeaderHidden hides or shows the header, including the column group
+// labels.
+func WithHeaderHidden(v bool) Option {
+	return func(m *Model) {
+		m.headerHidden = v
+	}
+}
+
+// WithHeaderRenderFunc sets a function to render the column headers with.
+func WithHeaderRenderFunc(f HeaderRenderFunc) Option {
+	return func(m *Model) {
+		m.renderHeader = f
+	}
+}
+
+// Update is the Bubble Tea update loop. Besides handling key presses it sends
+// an event.SelectionChangedMsg when the cursor moves, an event.ActivatedMsg
+// when the selected row is activated and a RowDeletedMsg when it's deleted.
+// Actions that require confirmation are performed once the user confirms, so
+// make sure to pass confirm.ResultMsg on to the table.
+//
+// Messages addressed to other tables with route.To are ignored.
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
-	if !m.focus {
+	msg, ok := route.Accept(m.id, msg)
+	if !ok {
 		return m, nil
 	}
 
-	var cmds []tea.Cmd
-
-	switch msg := msg.(type) {
-	case tea.KeyMsg:
-		switch {
-		case key.Matches(msg, m.KeyMap.LineUp):
-			m.MoveUp(1)
-		case key.Matches(msg, m.KeyMap.LineDown):
-			m.MoveDown(1)
-		case key.Matches(msg, m.KeyMap.PageUp):
-			m.MoveUp(m.viewport.Height)
-		case key.Matches(msg, m.KeyMap.PageDown):
-			m.MoveDown(m.viewport.Height)
-		case key.Matches(msg, m.KeyMap.HalfPageUp):
-			m.MoveUp(m.viewport.Height / 2)
-		case key.Matches(msg, m.KeyMap.HalfPageDown):
-			m.MoveDown(m.viewport.Height / 2)
-		case key.Matches(msg, m.KeyMap.LineDown):
-			m.MoveDown(1)
-		case key.Matches(msg, m.KeyMap.GotoTop):
-			m.GotoTop()
-		case key.Matches(msg, m.KeyMap.GotoBottom):
-			m.GotoBottom()
-		}
+	if msg, ok := msg.(RecencyTickMsg); ok {
+		return m, m.handleRecencyTick(msg)
+	}
+	if msg, ok := msg.(FileLoadedMsg); ok {
+		return m, m.handleFileLoaded(msg)
 	}
+	if msg, ok := msg.(RefreshTickMsg); ok {
+		return m, m.handleRefreshTick(msg)
+	}
+	if msg, ok := msg.(RefreshedMsg); ok {
+		m.handleRefreshed(msg)
+		return m, nil
+	}
+
+	if !m.focus {
+		return m, nil
+	}
+
+	return m, m.handle(msg)
+}
 
-	return m, tea.Batch(cmds...)
+// ID returns the table's unique ID. It's set on the event messages the table
+// sends and can be used to address messages to this table with route.To.
+func (m Model) ID() int {
+	return m.id
 }
 
 // Focused returns the focus state of the table.
@@ -235,31 +720,137 @@ func (m *Model) Blur() {
 
 // View renders the component.
 func (m Model) View() string {
-	return m.headersView() + "\n" + m.viewport.View()
+	body := m.viewport
+	if !m.embedded {
+		body.Height = m.bodyHeight()
+	}
+	view := body.View()
+	if f := m.frozenView(); f != "" {
+		view += "\n" + f
+	}
+	if f := m.columnFilterView(); f != "" {
+		view = f + "\n" + view
+	}
+	if !m.headerHidden {
+		view = m.headersView() + "\n" + view
+	}
+	if m.formulas {
+		view = m.formulaBarView() + "\n" + view
+	}
+	if r := m.refreshView(); r != "" {
+		view = r + "\n" + view
+	}
+	if s := m.statsView(); s != "" {
+		view += "\n" + s
+	}
+	if p := m.filterPresetView(); p != "" {
+		view += "\n" + p
+	}
+	if e := m.filterErrView(); e != "" {
+		view += "\n" + e
+	}
+	if m.popup.open {
+		view = m.popupView(view)
+	}
+	if c := m.confirmView(); c != "" {
+		view += "\n" + c
+	}
+	return view
 }
 
 // UpdateViewport updates the list content based on the previously defined
-// columns and rows.
+// columns and rows. Only the rows in the visible window are rendered, which
+// keeps rendering cheap for large tables.
 func (m *Model) UpdateViewport() {
-	renderedRows := make([]string, 0, len(m.rows))
-	for i := range m.rows {
-		renderedRows = append(renderedRows, m.renderRow(i))
+	var started time.Time
+	if m.onRenderStats != nil {
+		started = time.Now()
+	}
+
+	m.resolveWidths()
+
+	if m.embedded {
+		rendered := m.renderEmbedded()
+		if m.onRenderStats != nil {
+			m.onRenderStats(Stats{
+				RenderedRows: rendered,
+				TotalRows:    len(m.order),
+				Duration:     time.Since(started),
+			})
+		}
+		return
+	}
+
+	renderedRows := m.renderPane(m.pageSize())
+	content := lipgloss.JoinVertical(lipgloss.Left, renderedRows...)
+	if m.split {
+		other := m.renderInactivePane()
+		content = m.splitView(renderedRows, other)
+		renderedRows = append(renderedRows, other...)
+	}
+	m.viewport.SetContent(content)
+
+	if m.onRenderStats != nil {
+		m.onRenderStats(Stats{
+			RenderedRows: len(renderedRows),
+			TotalRows:    len(m.order),
+			Duration:     time.Since(started),
+		})
+	}
+}
+
+// renderPane renders the rows visible at the current scroll position in a
+// window of the given number of rows, scrolling to the cursor first.
+func (m *Model) renderPane(size int) []string {
+	w := m.window()
+	w.Size = size
+
+	// A wrapped selected row takes up more than one line, leaving room for
+	// fewer rows.
+	var selected string
+	if m.wrapSelected && m.cursor >= 0 && m.cursor < len(m.order) {
+		selected = m.renderRow(m.cursor)
+		w.Size = max(1, w.Size-lipgloss.Height(selected)+1)
 	}
 
-	m.viewport.SetContent(
-		lipgloss.JoinVertical(lipgloss.Left, renderedRows...),
-	)
+	w.EnsureVisible(m.cursor)
+	m.offset = w.Offset
+
+	start, end := w.Visible()
+	renderedRows := make([]string, 0, end-start)
+	for i := start; i < end; i++ {
+		if i == m.cursor && selected != "" {
+			renderedRows = append(renderedRows, selected)
+			continue
+		}
+		renderedRows = append(renderedRows, m.renderRow(i))
+	}
+	return renderedRows
 }
 
-// SelectedRow returns the selected row.
+// SelectedRow returns the selected row, or nil if no row is shown.
 // You can cast it to your own implementation.
 func (m Model) SelectedRow() Row {
-	return m.rows[m.cursor]
+	if m.cursor < 0 || m.cursor >= len(m.order) {
+		return nil
+	}
+	return m.rows[m.order[m.cursor]]
 }
 
 // SetRows set a new rows state.
 func (m *Model) SetRows(r []Row) {
+	if m.pivoted != nil {
+		m.pivoted.rows = r
+		m.repivot()
+		return
+	}
 	m.rows = r
+	m.editing = nil
+	m.recalc()
+	m.findDuplicates()
+	m.reorder()
+	m.cursor = clamp(m.cursor, 0, len(m.order)-1)
+	m.skipDisabled(1)
 	m.UpdateViewport()
 }
 
@@ -275,6 +866,101 @@ func (m *Model) SetHeight(h int) {
 	m.UpdateViewport()
 }
 
+// SetSize sets the width and the height of the table. Unlike the height set
+// with SetHeight, the height includes the lines above the rows, such as the
+// header. It satisfies the layout.Sizable interface.
+func (m *Model) SetSize(width, height int) {
+	m.viewport.Width = width
+	m.SetHeight(max(0, height-m.bodyTop()))
+}
+
+// MinSize returns the size of the table with a single row and every column
+// one cell wide.
+func (m Model) MinSize() (width, height int) {
+	width = m.indicatorWidth()
+	for _, c := range m.cols {
+		if !c.Hidden {
+			width += 1 + horizontalFrameSize(m.styles.Header)
+		}
+	}
+	return width, m.bodyTop() + 1 + m.frozen
+}
+
+// PreferredSize returns the size of the table with all rows and columns at
+// their set widths.
+func (m Model) PreferredSize() (width, height int) {
+	width = m.indicatorWidth()
+	for _, c := range m.cols {
+		if !c.Hidden {
+			width += c.Width + horizontalFrameSize(m.styles.Header)
+		}
+	}
+	return width, m.bodyTop() + len(m.order) + m.frozen
+}
+
+// WrapSelected returns whether the selected row shows the full contents of
+// its cells.
+func (m Model) WrapSelected() bool {
+	return m.wrapSelected
+}
+
+// SetWrapSelected sets whether the selected row expands to show the full
+// contents of its cells, wrapped to the column widths.
+func (m *Model) SetWrapSelected(v bool) {
+	m.wrapSelected = v
+	m.UpdateViewport()
+}
+
+// HeaderHidden returns whether the header is hidden.
+func (m Model) HeaderHidden() bool {
+	return m.headerHidden
+}
+
+// SetHeaderHidden hides or shows the header, including the column group
+// labels.
+func (m *Model) SetHeaderHidden(v bool) {
+	m.headerHidden = v
+}
+
+// SetHeaderRenderFunc sets a function to render the column headers with.
+// Pass nil to render the plain column titles.
+func (m *Model) SetHeaderRenderFunc(f HeaderRenderFunc) {
+	m.renderHeader = f
+}
+
+// Columns returns the table columns.
+func (m Model) Columns() []Column {
+	return m.cols
+}
+
+// SetColumns sets the table columns.
+func (m *Model) SetColumns(c []Column) {
+	m.cols = c
+	m.colCursor = m.selectableColumn(m.colCursor)
+	m.UpdateViewport()
+}
+
+// SetColumnHidden hides or shows the column at the given index.
+func (m *Model) SetColumnHidden(col int, hidden bool) {
+	if col < 0 || col >= len(m.cols) {
+		return
+	}
+	m.cols = append([]Column(nil), m.cols...)
+	m.cols[col].Hidden = hidden
+	m.colCursor = m.selectableColumn(m.colCursor)
+	m.UpdateViewport()
+}
+
+// SetColumnWidth sets the width of the column at the given index.
+func (m *Model) SetColumnWidth(col, width int) {
+	if col < 0 || col >= len(m.cols) {
+		return
+	}
+	m.cols = append([]Column(nil), m.cols...)
+	m.cols[col].Width = max(0, width)
+	m.UpdateViewport()
+}
+
 // Height returns the viewport height of the table.
 func (m Model) Height() int {
 	return m.viewport.Height
@@ -285,37 +971,128 @@ func (m Model) Width() int {
 	return m.viewport.Width
 }
 
-// Cursor returns the index of the selected row.
+// Cursor returns the position of the selected row in the table as it's
+// displayed, which differs from the row's index when the table is sorted.
 func (m Model) Cursor() int {
 	return m.cursor
 }
 
 // SetCursor sets the cursor position in the table.
 func (m *Model) SetCursor(n int) {
-	m.cursor = clamp(n, 0, len(m.rows)-1)
+	m.cursor = clamp(n, 0, len(m.order)-1)
+	m.skipDisabled(1)
 	m.UpdateViewport()
 }
 
 // MoveUp moves the selection up by any number of row.
 // It can not go above the first row.
 func (m *Model) MoveUp(n int) {
-	m.cursor = clamp(m.cursor-n, 0, len(m.rows)-1)
+	m.cursor = clamp(m.cursor-n, 0, len(m.order)-1)
+	m.skipDisabled(-1)
 	m.UpdateViewport()
-
-	if m.cursor < m.viewport.YOffset {
-		m.viewport.SetYOffset(m.cursor)
-	}
 }
 
 // MoveDown moves the selection down by any number of row.
 // It can not go below the last row.
 func (m *Model) MoveDown(n int) {
-	m.cursor = clamp(m.cursor+n, 0, len(m.rows)-1)
+	m.cursor = clamp(m.cursor+n, 0, len(m.order)-1)
+	m.skipDisabled(1)
+	m.UpdateViewport()
+}
+
+// CellSelect returns whether cell selection is enabled.
+func (m Model) CellSelect() bool {
+	return m.cellSelect
+}
+
+// SetCellSelect enables or disables cell selection.
+func (m *Model) SetCellSelect(v bool) {
+	m.cellSelect = v
+	m.colCursor = m.selectableColumn(m.colCursor)
 	m.UpdateViewport()
+}
+
+// SelectedCell returns the row and column index of the selected cell. The
+// row is the index of the row in the table's rows, regardless of sorting, so
+// it can be passed to CellValue and SetCell. The column is only meaningful
+// when cell selection is enabled.
+func (m Model) SelectedCell() (row, col int) {
+	if m.cursor < 0 || m.cursor >= len(m.order) {
+		return m.cursor, m.colCursor
+	}
+	return m.order[m.cursor], m.colCursor
+}
+
+// SetSelectableColumns restricts cell selection to the columns at the given
+// indices. Passing no indices makes all columns selectable again.
+func (m *Model) SetSelectableColumns(cols ...int) {
+	m.setSelectableColumns(cols)
+	m.colCursor = m.selectableColumn(m.colCursor)
+	m.UpdateViewport()
+}
+
+// ColumnSelectable returns whether the column at the given index can be
+// selected when cell selection is enabled. Hidden columns can't be selected.
+func (m Model) ColumnSelectable(col int) bool {
+	if col < 0 || col >= len(m.cols) {
+		return false
+	}
+	return !m.cols[col].Hidden && (m.selectable == nil || m.selectable[col])
+}
+
+// MoveLeft moves the cell selection left by any number of selectable
+// columns. It can not go past the first selectable column.
+func (m *Model) MoveLeft(n int) {
+	m.moveColumn(-1, n)
+	m.UpdateViewport()
+}
+
+// MoveRight moves the cell selection right by any number of selectable
+// columns. It can not go past the last selectable column.
+func (m *Model) MoveRight(n int) {
+	m.moveColumn(1, n)
+	m.UpdateViewport()
+}
 
-	if m.cursor > (m.viewport.YOffset + (m.viewport.Height - 1)) {
-		m.viewport.SetYOffset(m.cursor - (m.viewport.Height - 1))
+// moveColumn moves the cell selection n selectable columns in the given
+// direction, skipping columns that can't be selected.
+func (m *Model) moveColumn(dir, n int) {
+	for ; n > 0; n-- {
+		next := m.colCursor + dir
+		for next >= 0 && next < len(m.cols) && !m.ColumnSelectable(next) {
+			next += dir
+		}
+		if next < 0 || next >= len(m.cols) {
+			return
+		}
+		m.colCursor = next
+	}
+}
+
+func (m *Model) setSelectableColumns(cols []int) {
+	if len(cols) == 0 {
+		m.selectable = nil
+		return
+	}
+	m.selectable = make(map[int]bool, len(cols))
+	for _, c := range cols {
+		m.selectable[c] = true
+	}
+}
+
+// selectableColumn returns the selectable column closest to col, preferring
+// columns to the right.
+func (m Model) selectableColumn(col int) int {
+	col = clamp(col, 0, len(m.cols)-1)
+	for d := 0; d < len(m.cols); d++ {
+		if m.ColumnSelectable(col + d) {
+			return col + d
+		}
+		if m.ColumnSelectable(col - d) {
+			return col - d
+		}
 	}
+	return col
 }
 
 // GotoTop moves the selection to the first row.
@@ -325,7 +1102,7 @@ func (m *Model) GotoTop() {
 
 // GotoBottom moves the selection to the last row.
 func (m *Model) GotoBottom() {
-	m.MoveDown(len(m.rows))
+	m.MoveDown(len(m.order))
 }
 
 // FromValues create the table rows from a simple string. It uses `\n` by
@@ -344,28 +1121,164 @@ func (m *Model) FromValues(value, separator string) {
 	m.SetRows(rows)
 }
 
+// window returns the scroll window over the table's rows.
+func (m Model) window() virtual.Window {
+	return virtual.Window{
+		Total:  len(m.order),
+		Size:   m.pageSize(),
+		Offset: m.offset,
+	}
+}
+
 func (m Model) headersView() string {
 	var s = make([]string, 0, len(m.cols))
-	for _, col := range m.cols {
-		style := lipgloss.NewStyle().Width(col.Width).MaxWidth(col.Width).Inline(true)
-		renderedCell := style.Render(runewidth.Truncate(col.Title, col.Width, "…"))
+	for i, col := range m.cols {
+		if col.Hidden {
+			continue
+		}
+		col.Width = m.colWidth(i)
+		align := col.align(col.Title)
+		var renderedCell string
+		if m.renderHeader != nil {
+			sorted := SortState{Column: i}
+			if m.sort.Column == i {
+				sorted.Order = m.sort.Order
+			}
+			// Custom headers may be styled, so leave fitting them to
+			// lipgloss, which knows how to measure escape sequences.
+			style := lipgloss.NewStyle().Width(col.Width).MaxWidth(col.Width).Align(align).Inline(true)
+			renderedCell = style.Render(m.renderHeader(col, sorted))
+		} else {
+			renderedCell = fit(col.Title, col.Width, align)
+		}
 		s = append(s, m.styles.Header.Render(renderedCell))
 	}
-	return lipgloss.JoinHorizontal(lipgloss.Left, s...)
+	header := m.clip(m.indicatorPadding() + lipgloss.JoinHorizontal(lipgloss.Left, s...))
+
+	if groups := m.groupHeaderView(); groups != "" {
+		header = groups + "\n" + header
+	}
+	return header
+}
+
+// groupHeaderView renders the column group labels, each spanning the
+// columns of its group. It returns an empty string if no column belongs to a
+// group.
+func (m Model) groupHeaderView() string {
+	cols := make([]Column, 0, len(m.cols))
+	for i, col := range m.cols {
+		if !col.Hidden {
+			col.Width = m.colWidth(i)
+			cols = append(cols, col)
+		}
+	}
+
+	grouped := false
+	for _, col := range cols {
+		if col.Group != "" {
+			grouped = true
+			break
+		}
+	}
+	if !grouped {
+		return ""
+	}
+
+	var s []string
+	for i := 0; i < len(cols); {
+		group := cols[i].Group
+		j := i + 1
+		for group != "" && j < len(cols) && cols[j].Group == group {
+			j++
+		}
+
+		span := 0
+		for _, col := range cols[i:j] {
+			span += col.Width + horizontalFrameSize(m.styles.Header)
+		}
+		i = j
+
+		width := max(0, span-horizontalFrameSize(m.styles.GroupHeader))
+		style := lipgloss.NewStyle().Width(width).MaxWidth(width).Inline(true)
+		label := style.Render(truncate(group, width, "…"))
+		s = append(s, m.styles.GroupHeader.Copy().Width(span).MaxWidth(span).Render(label))
+	}
+	return m.clip(m.indicatorPadding() + lipgloss.JoinHorizontal(lipgloss.Left, s...))
 }
 
-func (m *Model) renderRow(rowID int) string {
+// horizontalFrameSize returns the horizontal margins, padding and borders of
+// a style. Unlike Style.GetHorizontalFrameSize, it only counts the borders
+// that are enabled.
+func horizontalFrameSize(s lipgloss.Style) int {
+	return s.GetHorizontalMargins() + s.GetHorizontalPadding() +
+		s.GetBorderLeftSize() + s.GetBorderRightSize()
+}
+
+// clip truncates a header line to the width of the table, the same way the
+// viewport truncates the rows.
+func (m Model) clip(line string) string {
+	if m.viewport.Width <= 0 {
+		return line
+	}
+	return lipgloss.NewStyle().MaxWidth(m.viewport.Width).Render(line)
+}
+
+// renderRow renders the row at the given position.
+func (m *Model) renderRow(pos int) string {
+	row := m.renderCells(m.order[pos], pos == m.cursor)
+	if m.indicator != nil {
+		return lipgloss.JoinHorizontal(lipgloss.Top, m.indicatorView(pos), m.styleRow(pos, row))
+	}
+	return m.styleRow(pos, row)
+}
+
+// renderCells renders the cells of the row at the given index in rows.
+func (m *Model) renderCells(rowID int, selected bool) string {
 	var s = make([]string, 0, len(m.cols))
-	for i, value := range m.rows[rowID] {
-		style := lipgloss.NewStyle().Width(m.cols[i].Width).MaxWidth(m.cols[i].Width).Inline(true)
-		renderedCell := m.styles.Cell.Render(style.Render(runewidth.Truncate(value, m.cols[i].Width, "…")))
+	for i := range m.cols {
+		if m.cols[i].Hidden {
+			continue
+		}
+		value := m.cols[i].format(m.CellValue(rowID, i))
+		width := m.colWidth(i)
+		var renderedCell string
+		align := m.cols[i].align(value)
+		if e := m.editing; e != nil && e.row == rowID && e.col == i {
+			renderedCell = m.styles.Cell.Render(m.editView(width))
+		} else if m.wrapSelected && selected {
+			renderedCell = m.styles.Cell.Render(lipgloss.NewStyle().Width(width).Align(align).Render(value))
+		} else {
+			renderedCell = m.styles.Cell.Render(fit(value, width, align))
+		}
+		if m.cellSelect && selected && i == m.colCursor {
+			renderedCell = m.styles.SelectedCell.Render(renderedCell)
+		} else if m.anchor != nil && m.inRange(rowID, i) {
+			renderedCell = m.styles.Range.Render(renderedCell)
+		}
 		s = append(s, renderedCell)
 	}
 
-	row := lipgloss.JoinHorizontal(lipgloss.Left, s...)
+	return lipgloss.JoinHorizontal(lipgloss.Top, s...)
+}
 
-	if rowID == m.cursor {
+// styleRow applies the style of the row at the given position.
+func (m *Model) styleRow(pos int, row string) string {
+	rowID := m.order[pos]
+	selected := pos == m.cursor && !m.cellSelect && (m.indicator == nil || !m.indicator.Only)
+
+	switch {
+	case selected && m.disabled[rowID]:
+		return m.styles.Selected.Copy().Inherit(m.styles.Disabled).Render(row)
+	case selected:
 		return m.styles.Selected.Render(row)
+	case m.disabled[rowID]:
+		return m.styles.Disabled.Render(row)
+	case m.duplicates[rowID]:
+		return m.styles.Duplicate.Render(row)
+	}
+
+	if style, ok := m.recencyStyle(rowID); ok {
+		return style.Render(row)
 	}
 
 	return row
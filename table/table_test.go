@@ -1,6 +1,42 @@
 package table
 
-import "testing"
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/bubbletest"
+	"github.com/charmbracelet/bubbles/event"
+	"github.com/charmbracelet/bubbles/route"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// testTable returns a table configured with the options of a fixture and
+// then with the given options.
+func testTable(fixture []Option, opts ...Option) Model {
+	return New(append(append([]Option(nil), fixture...), opts...)...)
+}
+
+// numberedRows returns n rows with a single cell each, "row 0" to "row n-1".
+func numberedRows(n int) []Row {
+	rows := make([]Row, n)
+	for i := range rows {
+		rows[i] = Row{fmt.Sprintf("row %d", i)}
+	}
+	return rows
+}
+
+// filesFixture is a table of files and directories.
+var filesFixture = []Option{
+	WithColumns([]Column{{Title: "Name", Width: 10}, {Title: "Kind", Width: 10}}),
+	WithRows([]Row{
+		{"main.go", "file"},
+		{"internal", "dir"},
+		{"go.mod", "file"},
+		{"cmd", "dir"},
+	}),
+}
 
 func TestFromValues(t *testing.T) {
 	input := "foo1,bar1\nfoo2,bar2\nfoo3,bar3"
@@ -52,3 +88,345 @@ func deepEqual(a, b []Row) bool {
 	}
 	return true
 }
+
+func TestMoveDownScrollsWindow(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "N", Width: 4}}),
+		WithHeight(3),
+	)
+	table.FromValues("r0\nr1\nr2\nr3\nr4\nr5\nr6\nr7", ",")
+
+	table.MoveDown(5)
+	if table.offset != 3 {
+		t.Fatalf("expected offset 3, got %d", table.offset)
+	}
+
+	view := table.View()
+	if !strings.Contains(view, "r5") || strings.Contains(view, "r0") {
+		t.Fatalf("expected only the visible rows to be rendered, got:\n%s", view)
+	}
+
+	table.GotoTop()
+	if table.offset != 0 {
+		t.Fatalf("expected offset 0, got %d", table.offset)
+	}
+}
+
+func TestGroupHeader(t *testing.T) {
+	table := New(
+		WithColumns([]Column{
+			{Title: "Name", Width: 6},
+			{Title: "Jan", Width: 4, Group: "Q1"},
+			{Title: "Feb", Width: 4, Group: "Q1"},
+			{Title: "Apr", Width: 3, Group: "Q2 long label"},
+		}),
+		WithStyles(Styles{
+			Header:      lipgloss.NewStyle().Padding(0, 1),
+			GroupHeader: lipgloss.NewStyle().Padding(0, 1).Align(lipgloss.Center),
+		}),
+	)
+
+	lines := strings.Split(table.headersView(), "\n")
+	if len(lines) != 2 {
+		t.Fatalf("expected 2 header lines, got %d", len(lines))
+	}
+	if lipgloss.Width(lines[0]) != lipgloss.Width(lines[1]) {
+		t.Fatalf("group header width %d doesn't match header width %d", lipgloss.Width(lines[0]), lipgloss.Width(lines[1]))
+	}
+	if want := strings.Repeat(" ", 13) + "Q1" + strings.Repeat(" ", 6) + "Q2…" + " "; lines[0] != want {
+		t.Fatalf("expected group header %q, got %q", want, lines[0])
+	}
+
+	table.SetWidth(14)
+	lines = strings.Split(table.headersView(), "\n")
+	for _, l := range lines {
+		if w := lipgloss.Width(l); w != 14 {
+			t.Fatalf("expected header lines to be truncated to 14 cells, got %d", w)
+		}
+	}
+}
+
+func TestNoGroupHeader(t *testing.T) {
+	table := New(WithColumns([]Column{{Title: "Name", Width: 6}}))
+	if strings.Contains(table.headersView(), "\n") {
+		t.Fatal("expected no group header row")
+	}
+}
+
+func TestDisabledRowsAreSkipped(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "Option", Width: 10}}),
+		WithRows([]Row{{"a"}, {"b"}, {"c"}, {"d"}, {"e"}}),
+		WithDisabledRows(0, 2, 4),
+	)
+
+	if table.Cursor() != 1 {
+		t.Fatalf("expected cursor to start on first enabled row, got %d", table.Cursor())
+	}
+	table.MoveDown(1)
+	if table.Cursor() != 3 {
+		t.Fatalf("expected cursor to skip to row 3, got %d", table.Cursor())
+	}
+	table.GotoBottom()
+	if table.Cursor() != 3 {
+		t.Fatalf("expected cursor to stay on last enabled row, got %d", table.Cursor())
+	}
+	table.GotoTop()
+	if table.Cursor() != 1 {
+		t.Fatalf("expected cursor on first enabled row, got %d", table.Cursor())
+	}
+}
+
+func TestDisabledRowsCanBeSelected(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "Option", Width: 10}}),
+		WithRows([]Row{{"a"}, {"b"}}),
+		WithDisabledRows(1),
+		WithDisabledPolicy(SelectDisabled),
+		WithFocused(true),
+	)
+
+	table.MoveDown(1)
+	if table.Cursor() != 1 {
+		t.Fatalf("expected cursor on disabled row, got %d", table.Cursor())
+	}
+
+	_, cmd := table.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if cmd != nil {
+		if _, ok := cmd().(event.ActivatedMsg); ok {
+			t.Fatal("expected disabled row not to be activated")
+		}
+	}
+}
+
+func TestSelectableColumns(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "Key"}, {Title: "Value"}, {Title: "Type"}, {Title: "Default"}}),
+		WithRows([]Row{{"a", "1", "int", "0"}}),
+		WithCellSelect(true),
+		WithSelectableColumns(1, 3),
+	)
+
+	if _, col := table.SelectedCell(); col != 1 {
+		t.Fatalf("expected first selectable column to be selected, got %d", col)
+	}
+	table.MoveRight(1)
+	if _, col := table.SelectedCell(); col != 3 {
+		t.Fatalf("expected cursor to skip to column 3, got %d", col)
+	}
+	table.MoveRight(1)
+	if _, col := table.SelectedCell(); col != 3 {
+		t.Fatalf("expected cursor to stay on column 3, got %d", col)
+	}
+	table.MoveLeft(5)
+	if _, col := table.SelectedCell(); col != 1 {
+		t.Fatalf("expected cursor to stop at column 1, got %d", col)
+	}
+
+	table.SetSelectableColumns()
+	table.MoveLeft(1)
+	if _, col := table.SelectedCell(); col != 0 {
+		t.Fatalf("expected all columns to be selectable, got %d", col)
+	}
+}
+
+func TestThemesKeepColumnsAligned(t *testing.T) {
+	for _, theme := range []Theme{ThemeDefault, ThemeMinimal, ThemeBordered, ThemeCompact, ThemeHighContrast, ThemeK9s} {
+		table := New(
+			WithColumns([]Column{{Title: "Name", Width: 6, Group: "G"}, {Title: "Size", Width: 4, Group: "G"}}),
+			WithRows([]Row{{"a", "1"}, {"b", "2"}}),
+			WithHeight(2),
+			WithTheme(theme),
+		)
+		lines := strings.Split(table.View(), "\n")
+		width := lipgloss.Width(lines[len(lines)-1])
+		for _, l := range lines {
+			if w := lipgloss.Width(l); w != width {
+				t.Fatalf("theme %d: expected all lines to be %d cells wide, got %d in %q", theme, width, w, l)
+			}
+		}
+	}
+}
+
+func TestHeaderHidden(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "Name", Width: 6}}),
+		WithRows([]Row{{"a"}}),
+		WithHeight(1),
+		WithHeaderHidden(true),
+	)
+	if strings.Contains(table.View(), "Name") {
+		t.Fatal("expected header to be hidden")
+	}
+	table.SetHeaderHidden(false)
+	if !strings.Contains(table.View(), "Name") {
+		t.Fatal("expected header to be shown")
+	}
+}
+
+func TestHeaderRenderFunc(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "Name", Width: 6}, {Title: "Size", Width: 6}}),
+		WithSort(1, Descending),
+		WithHeaderRenderFunc(func(col Column, sorted SortState) string {
+			switch sorted.Order {
+			case Ascending:
+				return col.Title + "↑"
+			case Descending:
+				return col.Title + "↓"
+			}
+			return col.Title
+		}),
+	)
+	header := table.headersView()
+	if !strings.Contains(header, "Size↓") || strings.Contains(header, "Name↓") {
+		t.Fatalf("expected sort indicator on the sorted column only, got %q", header)
+	}
+}
+
+func TestWidthPercent(t *testing.T) {
+	table := New(
+		WithColumns([]Column{
+			{Title: "ID", Width: 4},
+			{Title: "Name", WidthPercent: 25},
+			{Title: "Description", WidthPercent: 75, Width: 3},
+		}),
+		WithRows([]Row{{"1", "a", "b"}}),
+		WithHeight(1),
+	)
+
+	// Without a known table width, Width is used.
+	if w := table.colWidth(2); w != 3 {
+		t.Fatalf("expected fallback width 3, got %d", w)
+	}
+
+	for _, width := range []int{40, 81, 120} {
+		table.SetWidth(width)
+		// 3 columns with 2 cells of padding each and 4 cells fixed.
+		free := width - 6 - 4
+		if w := table.colWidth(1); w != free/4 {
+			t.Fatalf("width %d: expected %d, got %d", width, free/4, w)
+		}
+		for _, line := range strings.Split(table.View(), "\n") {
+			if w := lipgloss.Width(line); w != width {
+				t.Fatalf("width %d: expected line to fill the table, got %d", width, w)
+			}
+		}
+	}
+}
+
+func TestWrapSelected(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "Name", Width: 5}, {Title: "Notes", Width: 10}}),
+		WithRows([]Row{
+			{"a", "short"},
+			{"b", "a rather long note that wraps"},
+			{"c", "another long note that wraps"},
+			{"d", "short"},
+		}),
+		WithHeight(4),
+		WithWrapSelected(true),
+	)
+
+	if h := lipgloss.Height(table.viewport.View()); h != 4 {
+		t.Fatalf("expected viewport height 4, got %d", h)
+	}
+
+	table.MoveDown(3)
+	table.MoveUp(1)
+	content := table.viewport.View()
+	if !strings.Contains(content, "another") || !strings.Contains(content, "wraps") {
+		t.Fatalf("expected selected row to be wrapped, got:\n%s", content)
+	}
+	if strings.Contains(content, "rather long") {
+		t.Fatalf("expected other rows to stay truncated, got:\n%s", content)
+	}
+	if strings.Count(content, "\n")+1 != 4 {
+		t.Fatalf("expected content to fit the viewport, got:\n%s", content)
+	}
+}
+
+func TestRightToLeftColumns(t *testing.T) {
+	table := New(
+		WithColumns([]Column{
+			{Title: "שם", Width: 8, Direction: RightToLeft},
+			{Title: "Value", Width: 8, Direction: AutoDirection},
+		}),
+		WithRows([]Row{{"שלום עולם גדול", "مرحبا"}, {"אב", "hello"}}),
+		WithStyles(Styles{}),
+		WithHeight(2),
+	)
+
+	lines := strings.Split(table.View(), "\n")
+	want := []string{
+		"      שם" + "Value   ",
+		"שלום עו…" + "   مرحبا",
+		"      אב" + "hello   ",
+	}
+	for i, w := range want {
+		if lines[i] != w {
+			t.Errorf("line %d: expected %q, got %q", i, w, lines[i])
+		}
+	}
+}
+
+func TestSize(t *testing.T) {
+	table := testTable(filesFixture)
+	if w, h := table.MinSize(); w != 6 || h != 2 {
+		t.Errorf("expected a minimum size of 6x2, got %dx%d", w, h)
+	}
+	if w, h := table.PreferredSize(); w != 24 || h != 5 {
+		t.Errorf("expected a preferred size of 24x5, got %dx%d", w, h)
+	}
+
+	table.SetSize(30, 4)
+	if table.Width() != 30 || table.Height() != 3 {
+		t.Errorf("expected the header to take a line of the height, got %dx%d", table.Width(), table.Height())
+	}
+	if h := len(strings.Split(table.View(), "\n")); h != 4 {
+		t.Errorf("expected a view of 4 lines, got %d", h)
+	}
+}
+
+func TestViewGolden(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "Name", Width: 8}, {Title: "Size", Width: 6}}),
+		WithRows([]Row{{"go.mod", "1K"}, {"main.go", "4K"}, {"README", "2K"}}),
+		WithHeight(4),
+		WithFocused(true),
+		WithIndicator(DefaultIndicator()),
+	)
+
+	h := bubbletest.New(t, table)
+	h.AssertView("view")
+
+	h.Press("down", "down")
+	h.AssertView("view_moved")
+}
+
+func TestRoutedMessages(t *testing.T) {
+	table := testTable(filesFixture)
+	other := testTable(filesFixture)
+
+	// Addressed messages are unwrapped before the focus check.
+	table, _ = table.Update(route.To(table.ID(), tea.KeyMsg{Type: tea.KeyDown}))
+	if table.Cursor() != 0 {
+		t.Fatal("expected an unfocused table to ignore addressed keys")
+	}
+	table.Focus()
+	table, _ = table.Update(route.To(table.ID(), tea.KeyMsg{Type: tea.KeyDown}))
+	if table.Cursor() != 1 {
+		t.Fatalf("expected the addressed key to move the cursor, got %d", table.Cursor())
+	}
+	table, _ = table.Update(route.To(other.ID(), tea.KeyMsg{Type: tea.KeyDown}))
+	if table.Cursor() != 1 {
+		t.Fatal("expected keys addressed to another table to be ignored")
+	}
+
+	// Messages that don't need focus are handled when addressed, too.
+	table = testTable(filesFixture, WithRefresh(Refresh{}))
+	table, _ = table.Update(route.To(table.ID(), RefreshedMsg{ID: table.ID(), Rows: []Row{{"fresh"}}}))
+	if table.rows[0][0] != "fresh" {
+		t.Fatal("expected the addressed refresh to be handled by an unfocused table")
+	}
+}
@@ -0,0 +1,155 @@
+package table
+
+import (
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Type-ahead
+//
+// When type-ahead is enabled, typing characters jumps the cursor to the next
+// row whose first visible column starts with the typed text, ignoring case,
+// like lists and tables in graphical interfaces do. The typed text is reset
+// after a pause, one second by default. Typing the same character again
+// moves on to the next row starting with it.
+//
+// Typed characters go to type-ahead instead of the table's single-character
+// keybindings, such as j and k, so use the keys that aren't characters, like
+// the arrow keys, for those while it's enabled.
+
+const defaultTypeAheadTimeout = time.Second
+
+// typeAhead holds the state of type-ahead navigation.
+type typeAhead struct {
+	enabled bool
+	timeout time.Duration
+
+	// prefix is the text typed so far and last when it was last typed.
+	prefix string
+	last   time.Time
+}
+
+// WithTypeAhead enables or disables jumping to rows by typing their first
+// characters.
+func WithTypeAhead(v bool) Option {
+	return func(m *Model) {
+		m.SetTypeAhead(v)
+	}
+}
+
+// WithTypeAheadTimeout sets how long a pause in typing resets the typed text.
+func WithTypeAheadTimeout(d time.Duration) Option {
+	return func(m *Model) {
+		m.typeAhead.timeout = d
+	}
+}
+
+// SetTypeAhead enables or disables jumping to rows by typing their first
+// characters.
+func (m *Model) SetTypeAhead(v bool) {
+	m.typeAhead.enabled = v
+	m.typeAhead.prefix = ""
+}
+
+// TypeAheadPrefix returns the text typed to jump to a row, if it hasn't been
+// reset yet.
+func (m Model) TypeAheadPrefix() string {
+	if m.typeAheadExpired(time.Now()) {
+		return ""
+	}
+	return m.typeAhead.prefix
+}
+
+// typeAheadExpired returns whether the typed text is reset by the given time.
+func (m Model) typeAheadExpired(now time.Time) bool {
+	timeout := m.typeAhead.timeout
+	if timeout <= 0 {
+		timeout = defaultTypeAheadTimeout
+	}
+	return now.Sub(m.typeAhead.last) > timeout
+}
+
+// acceptsTypeAhead returns whether a key press is typed text for type-ahead.
+// Spaces are only typed after other characters.
+func (m Model) acceptsTypeAhead(msg tea.KeyMsg) bool {
+	if !m.typeAhead.enabled || msg.Alt {
+		return false
+	}
+	switch msg.Type {
+	case tea.KeyRunes:
+		return true
+	case tea.KeySpace:
+		return m.TypeAheadPrefix() != ""
+	}
+	return false
+}
+
+// handleTypeAhead adds a typed character to the typed text and jumps to the
+// next row starting with it.
+func (m *Model) handleTypeAhead(msg tea.KeyMsg) {
+	now := time.Now()
+	if m.typeAheadExpired(now) {
+		m.typeAhead.prefix = ""
+	}
+	m.typeAhead.last = now
+
+	typed := string(msg.Runes)
+	if msg.Type == tea.KeySpace {
+		typed = " "
+	}
+
+	// New text starts looking at the next row, so that typing a character
+	// again moves on; longer text keeps the selected row if it still
+	// matches.
+	start := m.cursor
+	if m.typeAhead.prefix == "" {
+		start++
+	}
+	m.typeAhead.prefix += typed
+	prefix := strings.ToLower(m.typeAhead.prefix)
+
+	if pos, ok := m.rowWithPrefix(prefix, start); ok {
+		m.SetCursor(pos)
+		return
+	}
+
+	// Typing the same character repeatedly cycles through the rows starting
+	// with it.
+	if r := []rune(prefix); len(r) > 1 && strings.Count(prefix, string(r[0])) == len(r) {
+		if pos, ok := m.rowWithPrefix(string(r[0]), m.cursor+1); ok {
+			m.SetCursor(pos)
+		}
+	}
+}
+
+// rowWithPrefix returns the position of the first row, from the given
+// position on and wrapping around, whose first visible column starts with the
+// given lowercase prefix. Disabled rows are left out if the cursor skips
+// them.
+func (m Model) rowWithPrefix(prefix string, start int) (int, bool) {
+	col := -1
+	for i, c := range m.cols {
+		if !c.Hidden {
+			col = i
+			break
+		}
+	}
+	if col < 0 {
+		return 0, false
+	}
+
+	n := len(m.order)
+	for i := 0; i < n; i++ {
+		pos := (start + i) % n
+		if m.disabledPolicy == SkipDisabled && m.rowDisabled(pos) {
+			continue
+		}
+		s := m.cols[col].format(m.CellValue(m.order[pos], col))
+		if strings.HasPrefix(strings.ToLower(strings.TrimSpace(s)), prefix) {
+			return pos, true
+		}
+	}
+	return 0, false
+}
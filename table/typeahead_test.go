@@ -0,0 +1,77 @@
+package table
+
+import (
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/bubbletest"
+)
+
+var typeAheadFixture = []Option{
+	WithColumns([]Column{{Title: "Name", Width: 10}, {Title: "Kind", Width: 10}}),
+	WithRows([]Row{
+		{"main.go", "file"},
+		{"Makefile", "file"},
+		{"go.mod", "file"},
+		{"go.sum", "file"},
+		{"cmd", "dir"},
+	}),
+	WithFocused(true),
+	WithTypeAhead(true),
+}
+
+func TestTypeAhead(t *testing.T) {
+	h := bubbletest.New(t, testTable(typeAheadFixture))
+
+	h.Type("go.s")
+	table := h.Model().(Model)
+	if table.Cursor() != 3 || table.TypeAheadPrefix() != "go.s" {
+		t.Fatalf("expected go.sum to be selected, got %d with %q", table.Cursor(), table.TypeAheadPrefix())
+	}
+
+	// The typed text resets after a pause.
+	table.typeAhead.last = time.Now().Add(-2 * time.Second)
+	h = bubbletest.New(t, table)
+	h.Type("c")
+	if table = h.Model().(Model); table.Cursor() != 4 || table.TypeAheadPrefix() != "c" {
+		t.Fatalf("expected cmd to be selected, got %d with %q", table.Cursor(), table.TypeAheadPrefix())
+	}
+
+	// Keys that aren't characters still work.
+	h.Press("up")
+	if table = h.Model().(Model); table.Cursor() != 3 {
+		t.Fatalf("expected the arrow keys to move the cursor, got %d", table.Cursor())
+	}
+	if msgs := h.Msgs(); len(msgs) != 2 {
+		t.Fatalf("expected a selection change for each move, got %v", msgs)
+	}
+}
+
+func TestTypeAheadRepeat(t *testing.T) {
+	h := bubbletest.New(t, testTable(typeAheadFixture))
+
+	// Typing the same character cycles through the rows starting with it,
+	// ignoring case.
+	for _, want := range []int{1, 0, 1} {
+		h.Type("m")
+		if table := h.Model().(Model); table.Cursor() != want {
+			t.Fatalf("expected row %d to be selected, got %d", want, table.Cursor())
+		}
+	}
+}
+
+func TestTypeAheadSkipsDisabledRows(t *testing.T) {
+	h := bubbletest.New(t, testTable(typeAheadFixture, WithDisabledRows(2)))
+	h.Type("go")
+	if table := h.Model().(Model); table.Cursor() != 3 {
+		t.Fatalf("expected the disabled row to be skipped, got %d", table.Cursor())
+	}
+}
+
+func TestTypeAheadDisabled(t *testing.T) {
+	h := bubbletest.New(t, testTable(typeAheadFixture, WithTypeAhead(false)))
+	h.Type("j")
+	if table := h.Model().(Model); table.Cursor() != 1 {
+		t.Fatalf("expected j to move down without type-ahead, got %d", table.Cursor())
+	}
+}
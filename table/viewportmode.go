@@ -0,0 +1,79 @@
+package table
+
+import (
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// WithViewport renders the table body into the given viewport instead of the
+// table's own. All rows are rendered into the viewport, which does the
+// scrolling, so its features apply to the body: high performance rendering,
+// its style, and scrolling with the mouse wheel if it's enabled. The header
+// and anything below the body stay in place.
+//
+// The table's size is taken from the viewport. When using high performance
+// rendering, the table returns viewport.Sync commands from Update whenever the
+// body changes; call viewport.Sync yourself after changing the table with its
+// methods. Split mode isn't available in this mode.
+//
+// Since every row is rendered, this mode is best suited for tables of up to a
+// few thousand rows.
+func WithViewport(vp viewport.Model) Option {
+	return func(m *Model) {
+		m.viewport = vp
+		m.embedded = true
+	}
+}
+
+// Viewport returns the viewport the table body is rendered into.
+func (m Model) Viewport() viewport.Model {
+	return m.viewport
+}
+
+// renderEmbedded renders all rows into the viewport and scrolls it to keep
+// the selected row visible. It returns the number of rendered rows.
+func (m *Model) renderEmbedded() int {
+	rows := make([]string, len(m.order))
+	for i := range m.order {
+		rows[i] = m.renderRow(i)
+	}
+	m.viewport.SetContent(lipgloss.JoinVertical(lipgloss.Left, rows...))
+
+	// Only the selected row can span several lines, so the selected row
+	// starts on the line of its position.
+	if m.cursor >= 0 && m.cursor < len(rows) {
+		top := m.cursor
+		bottom := top + lipgloss.Height(rows[m.cursor]) - 1
+		switch {
+		case top < m.viewport.YOffset:
+			m.viewport.SetYOffset(top)
+		case bottom >= m.viewport.YOffset+m.viewport.Height:
+			m.viewport.SetYOffset(bottom - m.viewport.Height + 1)
+		}
+	}
+	m.offset = m.viewport.YOffset
+	return len(rows)
+}
+
+// updateEmbedded passes mouse messages to the viewport, moving the cursor
+// along if the viewport scrolls it out of view.
+func (m *Model) updateEmbedded(msg tea.MouseMsg) tea.Cmd {
+	var cmd tea.Cmd
+	m.viewport, cmd = m.viewport.Update(msg)
+	if m.viewport.YOffset == m.offset {
+		return cmd
+	}
+
+	m.offset = m.viewport.YOffset
+	last := m.offset + m.viewport.Height - 1
+	if m.cursor < m.offset {
+		m.cursor = m.offset
+		m.skipDisabled(1)
+	} else if m.cursor > last {
+		m.cursor = last
+		m.skipDisabled(-1)
+	}
+	m.UpdateViewport()
+	return cmd
+}
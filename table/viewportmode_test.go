@@ -0,0 +1,60 @@
+package table
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/bubbletest"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+var embeddedFixture = []Option{
+	WithColumns([]Column{{Title: "Name", Width: 10}}),
+	WithRows(numberedRows(50)),
+	WithFocused(true),
+}
+
+func TestViewportKeepsCursorVisible(t *testing.T) {
+	table := testTable(embeddedFixture, WithViewport(viewport.New(20, 5)))
+	table.MoveDown(12)
+
+	if got := table.Viewport().YOffset; got != 8 {
+		t.Fatalf("expected the viewport to scroll to 8, got %d", got)
+	}
+	view := table.View()
+	if !strings.Contains(view, "Name") || !strings.Contains(view, "row 12") || strings.Contains(view, "row 7 ") {
+		t.Fatalf("unexpected view:\n%s", view)
+	}
+}
+
+func TestViewportMouseWheelMovesCursor(t *testing.T) {
+	vp := viewport.New(20, 5)
+	vp.MouseWheelEnabled = true
+	vp.MouseWheelDelta = 3
+	table := testTable(embeddedFixture, WithViewport(vp))
+
+	table, _ = table.Update(tea.MouseMsg{Type: tea.MouseWheelDown})
+	table, _ = table.Update(tea.MouseMsg{Type: tea.MouseWheelDown})
+	if got := table.Viewport().YOffset; got != 6 {
+		t.Fatalf("expected the viewport to scroll to 6, got %d", got)
+	}
+	if table.Cursor() != 6 {
+		t.Fatalf("expected the cursor to follow, got %d", table.Cursor())
+	}
+}
+
+func TestViewportHighPerformanceSync(t *testing.T) {
+	vp := viewport.New(20, 5)
+	vp.HighPerformanceRendering = true
+	table := testTable(embeddedFixture, WithViewport(vp))
+
+	_, cmd := table.Update(tea.KeyMsg{Type: tea.KeyDown})
+	for _, msg := range bubbletest.Collect(cmd) {
+		if reflect.TypeOf(msg).Name() == "syncScrollAreaMsg" {
+			return
+		}
+	}
+	t.Fatal("expected a sync command")
+}
@@ -0,0 +1,108 @@
+package table
+
+import "encoding/json"
+
+// ViewState is a snapshot of how the user has arranged the table: where the
+// cursor is, how it's scrolled, sorted and filtered, and which columns are
+// hidden and how wide they are. It only has exported fields of basic types, so
+// it can be saved with encoding/json or similar and restored in a later
+// session with RestoreViewState.
+type ViewState struct {
+	Cursor       int
+	ColumnCursor int
+	Offset       int
+	Sort         SortState
+	Filter       string
+
+	// Hidden holds the indices of the hidden columns.
+	Hidden []int
+
+	// Widths holds the width of every column, by index.
+	Widths []int
+
+	// ColumnFilters holds the column filters, by column index.
+	ColumnFilters map[int]string
+}
+
+// ViewState returns the current view state of the table.
+func (m Model) ViewState() ViewState {
+	s := ViewState{
+		Cursor:       m.cursor,
+		ColumnCursor: m.colCursor,
+		Offset:       m.offset,
+		Sort:         m.sort,
+		Filter:       m.filter,
+		Widths:       make([]int, len(m.cols)),
+	}
+	for i, col := range m.cols {
+		s.Widths[i] = col.Width
+		if col.Hidden {
+			s.Hidden = append(s.Hidden, i)
+		}
+	}
+	for col, filter := range m.columnFilters {
+		if s.ColumnFilters == nil {
+			s.ColumnFilters = make(map[int]string, len(m.columnFilters))
+		}
+		s.ColumnFilters[col] = filter
+	}
+	return s
+}
+
+// RestoreViewState restores a view state returned by ViewState. Since the
+// table may have changed in the meantime, values that don't fit the table
+// anymore, such as a cursor past the last row or widths of columns that don't
+// exist, are adjusted or ignored.
+func (m *Model) RestoreViewState(s ViewState) {
+	m.cols = append([]Column(nil), m.cols...)
+	for i := range m.cols {
+		if i < len(s.Widths) {
+			m.cols[i].Width = max(0, s.Widths[i])
+		}
+		m.cols[i].Hidden = false
+	}
+	for _, i := range s.Hidden {
+		if i >= 0 && i < len(m.cols) {
+			m.cols[i].Hidden = true
+		}
+	}
+
+	m.columnFilters = nil
+	for col, filter := range s.ColumnFilters {
+		if col >= 0 && col < len(m.cols) && filter != "" {
+			if m.columnFilters == nil {
+				m.columnFilters = make(map[int]string)
+			}
+			m.columnFilters[col] = filter
+		}
+	}
+
+	m.sort = s.Sort
+	m.filter = s.Filter
+	m.reorder()
+
+	m.cursor = clamp(s.Cursor, 0, len(m.order)-1)
+	m.skipDisabled(1)
+	m.colCursor = m.selectableColumn(s.ColumnCursor)
+
+	w := m.window()
+	w.SetOffset(s.Offset)
+	m.offset = w.Offset
+	m.UpdateViewport()
+}
+
+// MarshalState returns the view state of the table as JSON. It satisfies the
+// state.Stateful interface along with UnmarshalState.
+func (m Model) MarshalState() ([]byte, error) {
+	return json.Marshal(m.ViewState())
+}
+
+// UnmarshalState restores a view state returned by MarshalState.
+func (m *Model) UnmarshalState(data []byte) error {
+	var s ViewState
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	m.RestoreViewState(s)
+	return nil
+}
@@ -0,0 +1,84 @@
+package table
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestViewStateRoundTrip(t *testing.T) {
+	newTable := func() Model {
+		rows := make([]Row, 50)
+		for i := range rows {
+			rows[i] = Row{strings.Repeat("x", i%3+1), "v"}
+		}
+		return New(
+			WithColumns([]Column{{Title: "Name", Width: 10}, {Title: "Value", Width: 5}}),
+			WithRows(rows),
+			WithHeight(5),
+		)
+	}
+
+	table := newTable()
+	table.SetFilter("xx")
+	table.SetColumnFilter(1, "v")
+	table.SortBy(0, Descending)
+	table.SetColumnHidden(1, true)
+	table.SetColumnWidth(0, 20)
+	table.MoveDown(12)
+
+	state := table.ViewState()
+	b, err := json.Marshal(state)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded ViewState
+	if err := json.Unmarshal(b, &decoded); err != nil {
+		t.Fatal(err)
+	}
+
+	restored := newTable()
+	restored.RestoreViewState(decoded)
+	if got := restored.ViewState(); !reflect.DeepEqual(got, state) {
+		t.Fatalf("expected %+v, got %+v", state, got)
+	}
+	if restored.View() != table.View() {
+		t.Fatalf("expected restored table to look the same:\n%s\n\n%s", table.View(), restored.View())
+	}
+}
+
+func TestRestoreViewStateClampsCursor(t *testing.T) {
+	table := New(
+		WithColumns([]Column{{Title: "Name", Width: 10}}),
+		WithRows([]Row{{"a"}, {"b"}}),
+	)
+	table.RestoreViewState(ViewState{Cursor: 10, Hidden: []int{3}, Widths: []int{4, 5, 6}})
+	if table.Cursor() != 1 {
+		t.Fatalf("expected cursor to be clamped to 1, got %d", table.Cursor())
+	}
+	if table.Columns()[0].Width != 4 {
+		t.Fatalf("expected width 4, got %d", table.Columns()[0].Width)
+	}
+}
+
+func TestMarshalState(t *testing.T) {
+	table := testTable(filesFixture)
+	table.SetColumnFilter(1, "dir")
+	table.SetCursor(1)
+
+	data, err := table.MarshalState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored := testTable(filesFixture)
+	if err := restored.UnmarshalState(data); err != nil {
+		t.Fatal(err)
+	}
+	if got := restored.ColumnFilter(1); got != "dir" {
+		t.Errorf("expected the column filter to be restored, got %q", got)
+	}
+	if got := restored.SelectedRow()[0]; got != "cmd" {
+		t.Errorf("expected cmd to be selected, got %q", got)
+	}
+}
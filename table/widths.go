@@ -0,0 +1,53 @@
+package table
+
+// resolveWidths calculates the widths of the columns with a WidthPercent
+// from the width of the table.
+func (m *Model) resolveWidths() {
+	m.widths = make([]int, 0, len(m.cols))
+
+	// The space left for relative columns: the table width minus the fixed
+	// columns, the padding of all visible columns and the indicator column.
+	free := m.viewport.Width - m.indicatorWidth()
+	var percent float64
+	last := -1
+	for i, col := range m.cols {
+		m.widths = append(m.widths, col.Width)
+		if col.Hidden {
+			continue
+		}
+		free -= horizontalFrameSize(m.styles.Cell)
+		if col.WidthPercent > 0 {
+			percent += col.WidthPercent
+			last = i
+		} else {
+			free -= col.Width
+		}
+	}
+	if m.viewport.Width <= 0 || last < 0 {
+		return
+	}
+
+	free = max(0, free)
+	used := 0
+	for i, col := range m.cols {
+		if col.Hidden || col.WidthPercent <= 0 {
+			continue
+		}
+		m.widths[i] = int(float64(free) * col.WidthPercent / 100)
+		used += m.widths[i]
+	}
+
+	// Give the cells lost to rounding to the last relative column, so that
+	// columns adding up to 100% fill the table exactly.
+	if percent >= 100 {
+		m.widths[last] += free - used
+	}
+}
+
+// colWidth returns the width of the column at the given index.
+func (m Model) colWidth(i int) int {
+	if i < len(m.widths) {
+		return m.widths[i]
+	}
+	return m.cols[i].Width
+}
@@ -0,0 +1,61 @@
+package table
+
+import "testing"
+
+func TestWidthDistribution(t *testing.T) {
+	tests := []struct {
+		name  string
+		cols  []Column
+		width int
+		opts  []Option
+		want  []int
+	}{
+		{
+			// 3 columns with 2 cells of padding each and 10 cells fixed
+			// leave 34 cells.
+			name:  "even",
+			cols:  []Column{{Width: 10}, {WidthPercent: 50}, {WidthPercent: 50}},
+			width: 50,
+			want:  []int{10, 17, 17},
+		},
+		{
+			name:  "rounding",
+			cols:  []Column{{Width: 10}, {WidthPercent: 50}, {WidthPercent: 50}},
+			width: 51,
+			want:  []int{10, 17, 18},
+		},
+		{
+			name:  "less than 100%",
+			cols:  []Column{{Width: 10}, {WidthPercent: 30}, {WidthPercent: 30}},
+			width: 50,
+			want:  []int{10, 10, 10},
+		},
+		{
+			name:  "hidden",
+			cols:  []Column{{Width: 10}, {Width: 5, Hidden: true}, {WidthPercent: 100}},
+			width: 50,
+			want:  []int{10, 5, 36},
+		},
+		{
+			name:  "indicator",
+			cols:  []Column{{Width: 10}, {WidthPercent: 100}},
+			width: 50,
+			opts:  []Option{WithIndicator(DefaultIndicator())},
+			want:  []int{10, 34},
+		},
+		{
+			name:  "too narrow",
+			cols:  []Column{{Width: 10}, {WidthPercent: 100}},
+			width: 8,
+			want:  []int{10, 0},
+		},
+	}
+	for _, tc := range tests {
+		table := New(append([]Option{WithColumns(tc.cols), WithWidth(tc.width)}, tc.opts...)...)
+		for i, want := range tc.want {
+			if got := table.colWidth(i); got != want {
+				t.Errorf("%s: expected column %d to be %d wide, got %d", tc.name, i, want, got)
+			}
+		}
+	}
+}
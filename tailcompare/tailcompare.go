@@ -0,0 +1,363 @@
+// Package tailcompare provides a view that tails two logs side by side, such
+// as the logs of two instances of a service or of a run that passed and one
+// that failed. Lines are aligned by their timestamps, so that what happened
+// at the same time is on the same row, and rows where the logs diverge are
+// highlighted.
+//
+// Stream lines into either side from channels, or append them yourself:
+//
+//	m.compare = tailcompare.New(80, 20)
+//	return m, tea.Batch(
+//	    m.compare.Listen(tailcompare.Left, left),
+//	    m.compare.Listen(tailcompare.Right, right),
+//	)
+package tailcompare
+
+import (
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/route"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/truncate"
+)
+
+// Side is one of the two logs.
+type Side int
+
+// The sides.
+const (
+	Left Side = iota
+	Right
+)
+
+// LineMsg carries a line read from a source passed to Listen.
+type LineMsg struct {
+	ID   int
+	Side Side
+	Line string
+
+	// The source, to listen for the next line.
+	source <-chan string
+}
+
+// TimeFunc returns the time of a log line and the rest of the line after
+// the timestamp, compared between the logs. It returns false if the line has
+// no timestamp, such as the continuation of a multi-line message.
+type TimeFunc func(line string) (t time.Time, rest string, ok bool)
+
+// timestampPattern matches common timestamps at the start of a line, such as
+// RFC 3339 ones, or "2006-01-02 15:04:05.000", optionally in brackets.
+var timestampPattern = regexp.MustCompile(`^\[?(\d{4}-\d{2}-\d{2}[T ]\d{2}:\d{2}:\d{2}(?:[.,]\d+)?(?:Z|[+-]\d{2}:?\d{2})?)\]?\s*`)
+
+// ParseTimestamp is the default TimeFunc. It parses RFC 3339 timestamps and
+// timestamps like "2006-01-02 15:04:05.000" at the start of the line.
+func ParseTimestamp(line string) (time.Time, string, bool) {
+	match := timestampPattern.FindStringSubmatchIndex(line)
+	if match == nil {
+		return time.Time{}, line, false
+	}
+	s := strings.Replace(line[match[2]:match[3]], ",", ".", 1)
+	s = strings.Replace(s, " ", "T", 1)
+	for _, layout := range []string{time.RFC3339Nano, "2006-01-02T15:04:05.999999999", "2006-01-02T15:04:05.999999999Z0700"} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, line[match[1]:], true
+		}
+	}
+	return time.Time{}, line, false
+}
+
+// KeyMap defines the keybindings that scroll both logs together and toggle
+// following their ends. It satisfies the help.KeyMap interface, so the
+// bindings can be listed with the help bubble.
+type KeyMap struct {
+	// Viewport scrolls both logs.
+	Viewport viewport.KeyMap
+
+	// Follow toggles following the end of the logs as lines come in.
+	Follow key.Binding
+}
+
+// DefaultKeyMap returns a default set of keybindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Viewport: viewport.DefaultKeyMap(),
+		Follow: key.NewBinding(
+			key.WithKeys("f"),
+			key.WithHelp("f", "follow"),
+		),
+	}
+}
+
+// ShortHelp implements the KeyMap interface.
+func (km KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{km.Viewport.Up, km.Viewport.Down, km.Follow}
+}
+
+// FullHelp implements the KeyMap interface.
+func (km KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{km.Viewport.Up, km.Viewport.Down},
+		{km.Viewport.PageUp, km.Viewport.PageDown},
+		{km.Follow},
+	}
+}
+
+// Styles contains the styles used to render the view.
+type Styles struct {
+	// Line styles lines that are the same on both sides, Diverged lines that
+	// differ from the line on the other side, and Only lines that have no
+	// line on the other side.
+	Line     lipgloss.Style
+	Diverged lipgloss.Style
+	Only     lipgloss.Style
+
+	// Gap fills the row of the side that has no line.
+	Gap lipgloss.Style
+
+	Separator lipgloss.Style
+}
+
+// DefaultStyles returns a set of default style definitions for the view.
+func DefaultStyles() Styles {
+	return Styles{
+		Line:      lipgloss.NewStyle(),
+		Diverged:  lipgloss.NewStyle().Foreground(lipgloss.Color("214")),
+		Only:      lipgloss.NewStyle().Foreground(lipgloss.Color("42")),
+		Gap:       lipgloss.NewStyle().Foreground(lipgloss.Color("238")),
+		Separator: lipgloss.NewStyle().Foreground(lipgloss.Color("240")).SetString("│"),
+	}
+}
+
+// entry is a line of a log.
+type entry struct {
+	line string
+	rest string
+	t    time.Time
+}
+
+// row is a row of the aligned logs: the indices of the lines on either side,
+// -1 for none.
+type row [2]int
+
+// Model is the tail-compare view.
+type Model struct {
+	KeyMap KeyMap
+	Styles Styles
+
+	// ParseTime finds the timestamps of lines.
+	ParseTime TimeFunc
+
+	// Follow keeps the end of the logs in view as lines come in.
+	Follow bool
+
+	id        int
+	width     int
+	height    int
+	logs      [2][]entry
+	rows      []row
+	viewports [2]viewport.Model
+}
+
+// New creates a new tail-compare view of the given size.
+func New(width, height int) Model {
+	m := Model{
+		KeyMap:    DefaultKeyMap(),
+		Styles:    DefaultStyles(),
+		ParseTime: ParseTimestamp,
+		Follow:    true,
+		id:        route.NextID(),
+	}
+	for i := range m.viewports {
+		m.viewports[i] = viewport.New(0, 0)
+	}
+	m.SetSize(width, height)
+	return m
+}
+
+// ID returns the view's unique ID.
+func (m Model) ID() int {
+	return m.id
+}
+
+// SetSize sets the size of the view. The sides share the width.
+func (m *Model) SetSize(width, height int) {
+	m.width, m.height = width, height
+	sep := lipgloss.Width(m.Styles.Separator.String())
+	left := max(0, width-sep) / 2
+	m.viewports[Left].Width, m.viewports[Left].Height = left, height
+	m.viewports[Right].Width, m.viewports[Right].Height = max(0, width-sep-left), height
+	m.render()
+}
+
+// MinSize returns the size of a line of a single cell on each side. It
+// satisfies the layout.Sizable interface along with SetSize.
+func (m Model) MinSize() (width, height int) {
+	return 2 + lipgloss.Width(m.Styles.Separator.String()), 1
+}
+
+// PreferredSize returns the size that shows every line of both sides without
+// truncating them.
+func (m Model) PreferredSize() (width, height int) {
+	left, h := m.viewports[Left].PreferredSize()
+	right, _ := m.viewports[Right].PreferredSize()
+	return 2*max(left, right) + lipgloss.Width(m.Styles.Separator.String()), h
+}
+
+// Append adds lines to a side.
+func (m *Model) Append(side Side, lines ...string) {
+	for _, line := range lines {
+		e := entry{line: line, rest: line}
+		if t, rest, ok := m.ParseTime(line); ok {
+			e.t, e.rest = t, rest
+		} else if n := len(m.logs[side]); n > 0 {
+			// Lines without a timestamp continue the line before them.
+			e.t = m.logs[side][n-1].t
+		}
+		m.logs[side] = append(m.logs[side], e)
+	}
+	m.align()
+	m.render()
+}
+
+// Lines returns the lines of a side.
+func (m Model) Lines(side Side) []string {
+	lines := make([]string, len(m.logs[side]))
+	for i, e := range m.logs[side] {
+		lines[i] = e.line
+	}
+	return lines
+}
+
+// Listen returns a command that reads the next line of a side from a source.
+// The view keeps listening until the source is closed.
+func (m Model) Listen(side Side, source <-chan string) tea.Cmd {
+	id := m.id
+	return func() tea.Msg {
+		line, ok := <-source
+		if !ok {
+			return nil
+		}
+		return LineMsg{ID: id, Side: side, Line: line, source: source}
+	}
+}
+
+// align pairs the lines of both logs by their timestamps. Lines with the same
+// timestamp share rows in the order they came in.
+func (m *Model) align() {
+	left, right := m.logs[Left], m.logs[Right]
+	m.rows = nil
+	i, j := 0, 0
+	for i < len(left) || j < len(right) {
+		switch {
+		case j >= len(right) || i < len(left) && left[i].t.Before(right[j].t):
+			m.rows = append(m.rows, row{i, -1})
+			i++
+		case i >= len(left) || right[j].t.Before(left[i].t):
+			m.rows = append(m.rows, row{-1, j})
+			j++
+		default:
+			m.rows = append(m.rows, row{i, j})
+			i++
+			j++
+		}
+	}
+}
+
+// Diverged returns the number of rows where the logs differ.
+func (m Model) Diverged() int {
+	var n int
+	for _, r := range m.rows {
+		if r[Left] < 0 || r[Right] < 0 || m.logs[Left][r[Left]].rest != m.logs[Right][r[Right]].rest {
+			n++
+		}
+	}
+	return n
+}
+
+// render sets the content of the viewports to the aligned rows.
+func (m *Model) render() {
+	for side := range m.viewports {
+		vp := &m.viewports[side]
+		lines := make([]string, len(m.rows))
+		for i, r := range m.rows {
+			lines[i] = m.cellView(r, Side(side), vp.Width)
+		}
+		vp.SetContent(strings.Join(lines, "\n"))
+	}
+	if m.Follow {
+		m.viewports[Left].GotoBottom()
+	}
+	m.viewports[Right].SetYOffset(m.viewports[Left].YOffset)
+}
+
+func (m Model) cellView(r row, side Side, width int) string {
+	other := 1 - side
+	if r[side] < 0 {
+		return m.Styles.Gap.Render(strings.Repeat("·", width))
+	}
+	e := m.logs[side][r[side]]
+	style := m.Styles.Line
+	switch {
+	case r[other] < 0:
+		style = m.Styles.Only
+	case m.logs[other][r[other]].rest != e.rest:
+		style = m.Styles.Diverged
+	}
+	return style.Render(truncate.StringWithTail(e.line, uint(width), "…"))
+}
+
+// Update handles lines read from sources and scrolls both sides together.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if msg, ok := msg.(LineMsg); ok {
+		if msg.ID != m.id {
+			return m, nil
+		}
+		m.Append(msg.Side, msg.Line)
+		return m, m.Listen(msg.Side, msg.source)
+	}
+
+	msg, ok := route.Accept(m.id, msg)
+	if !ok {
+		return m, nil
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok && key.Matches(msg, m.KeyMap.Follow) {
+		m.Follow = !m.Follow
+		m.render()
+		return m, nil
+	}
+
+	// The left side handles scrolling, and the right side follows it.
+	left := &m.viewports[Left]
+	yOffset := left.YOffset
+	left.KeyMap = m.KeyMap.Viewport
+	var cmd tea.Cmd
+	*left, cmd = left.Update(msg)
+	if left.YOffset != yOffset {
+		m.viewports[Right].SetYOffset(left.YOffset)
+
+		// Scrolling up stops following, and scrolling to the end follows
+		// again.
+		m.Follow = left.AtBottom()
+	}
+	return m, cmd
+}
+
+// View renders the sides next to each other.
+func (m Model) View() string {
+	sep := strings.TrimSuffix(strings.Repeat(m.Styles.Separator.String()+"\n", max(1, m.height)), "\n")
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		m.viewports[Left].View(), sep, m.viewports[Right].View())
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
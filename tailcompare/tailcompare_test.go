@@ -0,0 +1,95 @@
+package tailcompare
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestParseTimestamp(t *testing.T) {
+	tests := []struct {
+		line string
+		exp  time.Time
+		rest string
+		ok   bool
+	}{
+		{"2022-03-04T05:06:07Z started", time.Date(2022, 3, 4, 5, 6, 7, 0, time.UTC), "started", true},
+		{"[2022-03-04 05:06:07,250] INFO ok", time.Date(2022, 3, 4, 5, 6, 7, 250e6, time.UTC), "INFO ok", true},
+		{"    at main.go:12", time.Time{}, "    at main.go:12", false},
+	}
+	for _, tc := range tests {
+		got, rest, ok := ParseTimestamp(tc.line)
+		if ok != tc.ok || !got.Equal(tc.exp) || rest != tc.rest {
+			t.Errorf("%q: expected %v %q %v, got %v %q %v", tc.line, tc.exp, tc.rest, tc.ok, got, rest, ok)
+		}
+	}
+}
+
+func TestAlign(t *testing.T) {
+	m := New(21, 4)
+	m.Styles = Styles{
+		Gap:       lipgloss.NewStyle(),
+		Separator: lipgloss.NewStyle().SetString("|"),
+	}
+	m.Append(Left,
+		"2022-01-01T00:00:01Z a",
+		"2022-01-01T00:00:02Z b",
+		"2022-01-01T00:00:04Z d",
+	)
+	m.Append(Right,
+		"2022-01-01T00:00:01Z a",
+		"2022-01-01T00:00:03Z c",
+		"2022-01-01T00:00:04Z x",
+	)
+
+	exp := []row{{0, 0}, {1, -1}, {-1, 1}, {2, 2}}
+	if len(m.rows) != len(exp) {
+		t.Fatalf("expected rows %v, got %v", exp, m.rows)
+	}
+	for i := range exp {
+		if m.rows[i] != exp[i] {
+			t.Fatalf("expected rows %v, got %v", exp, m.rows)
+		}
+	}
+	if m.Diverged() != 3 {
+		t.Errorf("expected 3 diverged rows, got %d", m.Diverged())
+	}
+
+	lines := strings.Split(m.View(), "\n")
+	if exp := "··········|2022-01-0…"; lines[2] != exp {
+		t.Errorf("expected a gap on the left, got %q", lines[2])
+	}
+}
+
+func TestListen(t *testing.T) {
+	m := New(40, 2)
+	source := make(chan string, 3)
+	source <- "2022-01-01T00:00:01Z a"
+	source <- "2022-01-01T00:00:02Z b"
+	source <- "2022-01-01T00:00:03Z c"
+	close(source)
+
+	cmd := m.Listen(Right, source)
+	for cmd != nil {
+		msg := cmd()
+		if msg == nil {
+			break
+		}
+		m, cmd = m.Update(msg)
+	}
+	if n := len(m.Lines(Right)); n != 3 {
+		t.Fatalf("expected 3 lines, got %d", n)
+	}
+	if !m.Follow || m.viewports[Right].YOffset != 1 {
+		t.Errorf("expected the view to follow the end, offset %d", m.viewports[Right].YOffset)
+	}
+
+	// Scrolling up stops following.
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyUp})
+	if m.Follow || m.viewports[Right].YOffset != 0 {
+		t.Errorf("expected both sides to scroll up and stop following")
+	}
+}
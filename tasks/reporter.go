@@ -0,0 +1,77 @@
+package tasks
+
+import (
+	"bytes"
+	"strings"
+	"sync"
+)
+
+// event is progress or output a running task reported.
+type event struct {
+	id      int
+	index   int
+	percent float64
+	line    string
+	isLine  bool
+}
+
+// eventMsg carries the events reported since the last one was received.
+type eventMsg struct {
+	id     int
+	events []event
+}
+
+// Reporter reports the progress and the output of a running task. It's an
+// io.Writer, so the output of commands can be captured with it:
+//
+//	cmd := exec.Command("make")
+//	cmd.Stdout, cmd.Stderr = r, r
+//	return cmd.Run()
+type Reporter struct {
+	id     int
+	index  int
+	events chan<- event
+
+	mtx     sync.Mutex
+	partial bytes.Buffer
+}
+
+// Progress reports the progress of the task, from 0 to 1. The task shows a
+// progress bar instead of the spinner once it reported progress.
+func (r *Reporter) Progress(percent float64) {
+	r.events <- event{id: r.id, index: r.index, percent: percent}
+}
+
+// Println adds a line to the output of the task.
+func (r *Reporter) Println(line string) {
+	r.events <- event{id: r.id, index: r.index, percent: -1, line: line, isLine: true}
+}
+
+// Write adds the lines written to the output of the task. A line is added
+// once it's complete.
+func (r *Reporter) Write(p []byte) (int, error) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+
+	r.partial.Write(p)
+	for {
+		line, err := r.partial.ReadString('\n')
+		if err != nil {
+			// Keep the incomplete line until the rest of it is written.
+			r.partial.Reset()
+			r.partial.WriteString(line)
+			return len(p), nil
+		}
+		r.Println(strings.TrimRight(line, "\r\n"))
+	}
+}
+
+// flush adds the incomplete line written last, if any.
+func (r *Reporter) flush() {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	if r.partial.Len() > 0 {
+		r.Println(r.partial.String())
+		r.partial.Reset()
+	}
+}
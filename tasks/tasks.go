@@ -0,0 +1,574 @@
+// Package tasks provides a task runner view: it runs a list of named tasks,
+// one after the other or several at once, and shows each task's state as it
+// goes from pending to running, with a spinner or a progress bar, to done or
+// failed, with the output each task captured below it and a summary of all
+// tasks at the end. It's the familiar UI of package managers and CI steps.
+//
+//	m.tasks = tasks.New([]tasks.Task{
+//	    {Name: "Download", Run: download},
+//	    {Name: "Build", Run: func(r *tasks.Reporter) error {
+//	        cmd := exec.Command("make")
+//	        cmd.Stdout, cmd.Stderr = r, r
+//	        return cmd.Run()
+//	    }},
+//	})
+//
+//	func (m model) Init() tea.Cmd {
+//	    return m.tasks.Start()
+//	}
+//
+// A FinishedMsg is sent when all tasks have finished.
+package tasks
+
+import (
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/i18n"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/progress"
+	"github.com/charmbracelet/bubbles/route"
+	"github.com/charmbracelet/bubbles/spinner"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Func is the function a task runs. It reports its progress and its output to
+// the Reporter. The task fails if it returns an error.
+type Func func(r *Reporter) error
+
+// Task is a named task.
+type Task struct {
+	Name string
+	Run  Func
+}
+
+// Status is the status of a task.
+type Status int
+
+// Available statuses.
+const (
+	Pending Status = iota
+	Running
+	Done
+	Failed
+)
+
+// String implements fmt.Stringer.
+func (s Status) String() string {
+	return [...]string{"pending", "running", "done", "failed"}[s]
+}
+
+// FinishedMsg is sent when all tasks have finished.
+type FinishedMsg struct {
+	// ID is the ID of the task runner that sent the message.
+	ID int
+
+	// Failed is the number of tasks that failed.
+	Failed int
+}
+
+// doneMsg is sent when a task has returned.
+type doneMsg struct {
+	id    int
+	index int
+	err   error
+}
+
+// KeyMap defines the keybindings for moving between tasks and showing or
+// hiding the output of the selected task. It satisfies the help.KeyMap
+// interface, so the bindings can be listed with the help bubble.
+type KeyMap struct {
+	Up           key.Binding
+	Down         key.Binding
+	ToggleOutput key.Binding
+}
+
+// DefaultKeyMap returns a default set of keybindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "down"),
+		),
+		ToggleOutput: key.NewBinding(
+			key.WithKeys("enter", " "),
+			key.WithHelp("enter", "toggle output"),
+		),
+	}
+}
+
+// ShortHelp implements the KeyMap interface.
+func (km KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{km.Up, km.Down, km.ToggleOutput}
+}
+
+// FullHelp implements the KeyMap interface.
+func (km KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{km.ShortHelp()}
+}
+
+// Styles contains the styles used to render the task runner.
+type Styles struct {
+	Pending lipgloss.Style
+	Done    lipgloss.Style
+	Failed  lipgloss.Style
+
+	Name         lipgloss.Style
+	SelectedName lipgloss.Style
+	Elapsed      lipgloss.Style
+	Output       lipgloss.Style
+	Error        lipgloss.Style
+	Summary      lipgloss.Style
+}
+
+// DefaultStyles returns a set of default style definitions for the task
+// runner.
+func DefaultStyles() Styles {
+	return Styles{
+		Pending:      lipgloss.NewStyle().Foreground(lipgloss.Color("240")).SetString("·"),
+		Done:         lipgloss.NewStyle().Foreground(lipgloss.Color("42")).SetString("✓"),
+		Failed:       lipgloss.NewStyle().Foreground(lipgloss.Color("196")).SetString("✗"),
+		Name:         lipgloss.NewStyle(),
+		SelectedName: lipgloss.NewStyle().Underline(true),
+		Elapsed:      lipgloss.NewStyle().Foreground(lipgloss.Color("240")),
+		Output:       lipgloss.NewStyle().Foreground(lipgloss.Color("245")).PaddingLeft(4),
+		Error:        lipgloss.NewStyle().Foreground(lipgloss.Color("196")).PaddingLeft(4),
+		Summary:      lipgloss.NewStyle().Bold(true).MarginTop(1),
+	}
+}
+
+// task is the state of a task.
+type task struct {
+	Task
+	status   Status
+	percent  float64 // -1 until progress was reported
+	output   []string
+	err      error
+	started  time.Time
+	elapsed  time.Duration
+	expanded *bool // nil to expand the output while running or failed
+}
+
+// Model is the task runner.
+type Model struct {
+	KeyMap KeyMap
+	Styles Styles
+
+	// Spinner is shown next to running tasks, and Progress next to running
+	// tasks that report progress.
+	Spinner  spinner.Model
+	Progress progress.Model
+
+	// Parallel is the most tasks run at once. Tasks run one after the other
+	// if it's 0 or 1.
+	Parallel int
+
+	// StopOnFailure leaves the pending tasks pending once a task failed.
+	StopOnFailure bool
+
+	// OutputLines is the number of the last lines of output shown below a
+	// task. All of it is shown if it's 0.
+	OutputLines int
+
+	id     int
+	tasks  []task
+	cursor int
+	focus  bool
+	events chan event
+	start  time.Time
+	end    time.Time
+	now    func() time.Time
+}
+
+// Option is used to set options in New.
+type Option func(*Model)
+
+// New creates a new task runner of the given tasks.
+func New(tasks []Task, opts ...Option) Model {
+	m := Model{
+		KeyMap:      DefaultKeyMap(),
+		Styles:      DefaultStyles(),
+		Spinner:     spinner.New(spinner.WithSpinner(spinner.Dot)),
+		Progress:    progress.New(progress.WithWidth(20), progress.WithoutPercentage()),
+		OutputLines: 5,
+		id:          route.NextID(),
+		now:         time.Now,
+	}
+	for _, t := range tasks {
+		m.tasks = append(m.tasks, task{Task: t, percent: -1})
+	}
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
+}
+
+// WithParallel sets the most tasks run at once.
+func WithParallel(n int) Option {
+	return func(m *Model) {
+		m.Parallel = n
+	}
+}
+
+// WithStopOnFailure leaves the pending tasks pending once a task failed.
+func WithStopOnFailure() Option {
+	return func(m *Model) {
+		m.StopOnFailure = true
+	}
+}
+
+// WithStyles sets the styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) {
+		m.Styles = s
+	}
+}
+
+// WithKeyMap sets the key map.
+func WithKeyMap(km KeyMap) Option {
+	return func(m *Model) {
+		m.KeyMap = km
+	}
+}
+
+// ID returns the task runner's unique ID.
+func (m Model) ID() int {
+	return m.id
+}
+
+// Focus focuses the task runner, so that the output of tasks can be expanded
+// and collapsed with the keyboard.
+func (m *Model) Focus() {
+	m.focus = true
+}
+
+// Blur blurs the task runner.
+func (m *Model) Blur() {
+	m.focus = false
+}
+
+// Focused returns whether the task runner is focused.
+func (m Model) Focused() bool {
+	return m.focus
+}
+
+// Status returns the status of a task.
+func (m Model) Status(i int) Status {
+	return m.tasks[i].status
+}
+
+// Err returns the error a failed task returned.
+func (m Model) Err(i int) error {
+	return m.tasks[i].err
+}
+
+// Output returns the output a task captured.
+func (m Model) Output(i int) []string {
+	return m.tasks[i].output
+}
+
+// Finished returns whether all tasks have finished, or the remaining ones
+// won't run because a task failed and StopOnFailure is set.
+func (m Model) Finished() bool {
+	return !m.start.IsZero() && m.running() == 0 && m.next() < 0
+}
+
+// Start starts running the tasks.
+func (m *Model) Start() tea.Cmd {
+	m.events = make(chan event, 64) //nolint:gomnd
+	m.start, m.end = m.now(), time.Time{}
+	cmds := []tea.Cmd{m.Spinner.Tick, m.listen()}
+	for m.running() < max(1, m.Parallel) {
+		cmd := m.run(m.next())
+		if cmd == nil {
+			break
+		}
+		cmds = append(cmds, cmd)
+	}
+	return tea.Batch(cmds...)
+}
+
+// next returns the index of the next task to run, or -1 if there's none.
+func (m Model) next() int {
+	for i, t := range m.tasks {
+		if t.status == Failed && m.StopOnFailure {
+			return -1
+		}
+		if t.status == Pending {
+			return i
+		}
+	}
+	return -1
+}
+
+// running returns the number of tasks running.
+func (m Model) running() int {
+	var n int
+	for _, t := range m.tasks {
+		if t.status == Running {
+			n++
+		}
+	}
+	return n
+}
+
+// run starts running a task.
+func (m *Model) run(i int) tea.Cmd {
+	if i < 0 {
+		return nil
+	}
+	t := &m.tasks[i]
+	t.status = Running
+	t.started = m.now()
+
+	r := &Reporter{id: m.id, index: i, events: m.events}
+	id, run := m.id, t.Run
+	return func() tea.Msg {
+		var err error
+		if run != nil {
+			err = run(r)
+		}
+		r.flush()
+		return doneMsg{id: id, index: i, err: err}
+	}
+}
+
+// listen waits for the events of running tasks, and returns all events
+// reported by then.
+func (m Model) listen() tea.Cmd {
+	id, events := m.id, m.events
+	return func() tea.Msg {
+		e, ok := <-events
+		if !ok {
+			return nil
+		}
+		msg := eventMsg{id: id, events: []event{e}}
+		for {
+			select {
+			case e, ok := <-events:
+				if !ok {
+					return msg
+				}
+				msg.events = append(msg.events, e)
+			default:
+				return msg
+			}
+		}
+	}
+}
+
+// Update handles the messages of running tasks, the spinner and key presses
+// while the task runner is focused.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case eventMsg:
+		if msg.id != m.id {
+			return m, nil
+		}
+		for _, e := range msg.events {
+			t := &m.tasks[e.index]
+			if e.isLine {
+				t.output = append(t.output, e.line)
+			} else {
+				t.percent = clamp(e.percent, 0, 1)
+			}
+		}
+		return m, m.listen()
+
+	case doneMsg:
+		if msg.id != m.id {
+			return m, nil
+		}
+		t := &m.tasks[msg.index]
+		t.status, t.err = Done, msg.err
+		if msg.err != nil {
+			t.status = Failed
+		}
+		t.elapsed = m.now().Sub(t.started)
+
+		if cmd := m.run(m.next()); cmd != nil {
+			return m, cmd
+		}
+		if m.running() > 0 {
+			return m, nil
+		}
+
+		// Stop listening once the events of all tasks were reported.
+		close(m.events)
+		m.end = m.now()
+		id, failed := m.id, m.failed()
+		return m, func() tea.Msg {
+			return FinishedMsg{ID: id, Failed: failed}
+		}
+
+	case spinner.TickMsg:
+		if m.running() == 0 {
+			return m, nil
+		}
+		var cmd tea.Cmd
+		m.Spinner, cmd = m.Spinner.Update(msg)
+		return m, cmd
+	}
+
+	if !m.focus || len(m.tasks) == 0 {
+		return m, nil
+	}
+	msg, ok := route.Accept(m.id, msg)
+	if !ok {
+		return m, nil
+	}
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch {
+		case key.Matches(msg, m.KeyMap.Up):
+			m.cursor = max(0, m.cursor-1)
+		case key.Matches(msg, m.KeyMap.Down):
+			m.cursor = min(len(m.tasks)-1, m.cursor+1)
+		case key.Matches(msg, m.KeyMap.ToggleOutput):
+			t := &m.tasks[m.cursor]
+			expanded := !m.expanded(*t)
+			t.expanded = &expanded
+		}
+	}
+	return m, nil
+}
+
+// failed returns the number of tasks that failed.
+func (m Model) failed() int {
+	var n int
+	for _, t := range m.tasks {
+		if t.status == Failed {
+			n++
+		}
+	}
+	return n
+}
+
+// expanded returns whether the output of a task is shown: while it's running
+// and once it failed, unless it was toggled.
+func (m Model) expanded(t task) bool {
+	if t.expanded != nil {
+		return *t.expanded
+	}
+	return t.status == Running || t.status == Failed
+}
+
+// View renders the tasks and the summary.
+func (m Model) View() string {
+	var b strings.Builder
+	for i, t := range m.tasks {
+		if i > 0 {
+			b.WriteByte('\n')
+		}
+		b.WriteString(m.taskView(i, t))
+	}
+	if m.start.IsZero() {
+		return b.String()
+	}
+	b.WriteByte('\n')
+	b.WriteString(m.Styles.Summary.Render(m.summary()))
+	return b.String()
+}
+
+func (m Model) taskView(i int, t task) string {
+	var icon string
+	switch t.status {
+	case Pending:
+		icon = m.Styles.Pending.String()
+	case Running:
+		icon = m.Spinner.View()
+	case Done:
+		icon = m.Styles.Done.String()
+	case Failed:
+		icon = m.Styles.Failed.String()
+	}
+
+	name := m.Styles.Name
+	if m.focus && i == m.cursor {
+		name = m.Styles.SelectedName
+	}
+	line := icon + " " + name.Render(t.Name)
+
+	switch t.status {
+	case Running:
+		if t.percent >= 0 {
+			line += " " + m.Progress.ViewAs(t.percent)
+		}
+	case Done, Failed:
+		line += " " + m.Styles.Elapsed.Render(formatDuration(t.elapsed))
+	}
+
+	if !m.expanded(t) {
+		return line
+	}
+	lines := []string{line}
+	output := t.output
+	if m.OutputLines > 0 && len(output) > m.OutputLines {
+		output = output[len(output)-m.OutputLines:]
+	}
+	for _, l := range output {
+		lines = append(lines, m.Styles.Output.Render(l))
+	}
+	if t.err != nil {
+		lines = append(lines, m.Styles.Error.Render(t.err.Error()))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// summary returns how many tasks are done and failed, and how long they took.
+func (m Model) summary() string {
+	var done int
+	for _, t := range m.tasks {
+		if t.status == Done {
+			done++
+		}
+	}
+	end := m.end
+	if end.IsZero() {
+		end = m.now()
+	}
+	s := i18n.Format("%d/%d done", done, len(m.tasks))
+	if failed := m.failed(); failed > 0 {
+		s += " · " + i18n.Format("%d failed", failed)
+	}
+	return s + " · " + formatDuration(end.Sub(m.start))
+}
+
+// formatDuration formats a duration in seconds, or in tenths of a second
+// below ten seconds.
+func formatDuration(d time.Duration) string {
+	if d < 10*time.Second {
+		return d.Round(100 * time.Millisecond).String()
+	}
+	return d.Round(time.Second).String()
+}
+
+func clamp(v, low, high float64) float64 {
+	if v < low {
+		return low
+	}
+	if v > high {
+		return high
+	}
+	return v
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
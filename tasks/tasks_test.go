@@ -0,0 +1,90 @@
+package tasks
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func plainStyles() Styles {
+	return Styles{
+		Pending: lipgloss.NewStyle().SetString("·"),
+		Done:    lipgloss.NewStyle().SetString("✓"),
+		Failed:  lipgloss.NewStyle().SetString("✗"),
+		Output:  lipgloss.NewStyle().PaddingLeft(2),
+		Error:   lipgloss.NewStyle().PaddingLeft(2),
+	}
+}
+
+func TestTasks(t *testing.T) {
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	m := New([]Task{
+		{Name: "fetch", Run: func(r *Reporter) error {
+			r.Progress(0.5)
+			fmt.Fprint(r, "fetched 3 files\nwarning: ")
+			fmt.Fprint(r, "slow mirror")
+			return nil
+		}},
+		{Name: "build", Run: func(r *Reporter) error {
+			r.Println("compiling")
+			return errors.New("exit status 2")
+		}},
+		{Name: "deploy"},
+	}, WithStyles(plainStyles()), WithStopOnFailure())
+	m.now = func() time.Time { return now }
+
+	m.Start()
+	if m.Status(0) != Running || m.Status(1) != Pending {
+		t.Fatal("expected only the first task to run")
+	}
+
+	// Runs the tasks one after the other, like the Bubble Tea runtime would.
+	cmd := m.run(-1)
+	for i := 0; i < 2; i++ {
+		r := &Reporter{id: m.id, index: i, events: m.events}
+		done := m.tasks[i].Run(r)
+		r.flush()
+		for len(m.events) > 0 {
+			m, _ = m.Update(m.listen()())
+		}
+		now = now.Add(time.Second)
+		m, cmd = m.Update(doneMsg{id: m.id, index: i, err: done})
+	}
+
+	if m.Status(0) != Done || m.Status(1) != Failed || m.Status(2) != Pending {
+		t.Fatalf("expected the last task not to run, got %v %v %v", m.Status(0), m.Status(1), m.Status(2))
+	}
+	if !m.Finished() {
+		t.Fatal("expected the runner to be finished")
+	}
+	if msg, ok := cmd().(FinishedMsg); !ok || msg.Failed != 1 || msg.ID != m.ID() {
+		t.Fatalf("expected a FinishedMsg with one failed task, got %#v", msg)
+	}
+
+	exp := strings.Join([]string{
+		"✓ fetch 1s",
+		"✗ build 1s",
+		"  compiling",
+		"  exit status 2",
+		"· deploy",
+		"1/3 done · 1 failed · 2s",
+	}, "\n")
+	if got := m.View(); got != exp {
+		t.Errorf("expected view:\n%s\ngot:\n%s", exp, got)
+	}
+
+	// The output of the first task can be expanded.
+	m.Focus()
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if output := m.Output(0); len(output) != 2 || output[1] != "warning: slow mirror" {
+		t.Errorf("expected the incomplete line to be captured, got %q", output)
+	}
+	if !strings.Contains(m.View(), "  warning: slow mirror") {
+		t.Errorf("expected the output of fetch to be shown, got:\n%s", m.View())
+	}
+}
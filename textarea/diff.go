@@ -0,0 +1,166 @@
+package textarea
+
+import "strings"
+
+// LineChange describes how a line differs from the original text.
+type LineChange int
+
+// Line changes.
+const (
+	LineUnchanged LineChange = iota
+	LineAdded
+	LineModified
+
+	// LineDeleted marks an unchanged line above which lines of the original
+	// text were deleted, or below which if it's the last line.
+	LineDeleted
+)
+
+// hunk is a range of lines of the original text, a0 to a1, that was replaced
+// by a range of lines of the value, b0 to b1. Either range can be empty.
+type hunk struct {
+	a0, a1 int
+	b0, b1 int
+}
+
+// SetOriginal sets the original text the value is compared to. While it's set,
+// a gutter left of the line numbers marks the lines that were added or
+// modified, and the RevertHunk keybinding reverts the changes at the cursor.
+// The gutter takes up a column of the textarea's width.
+func (m *Model) SetOriginal(s string) {
+	m.original = strings.Split(s, "\n")
+	m.KeyMap.RevertHunk.SetEnabled(true)
+	m.SetWidth(m.viewport.Width)
+}
+
+// ClearOriginal stops comparing the value to an original text.
+func (m *Model) ClearOriginal() {
+	m.original = nil
+	m.KeyMap.RevertHunk.SetEnabled(false)
+	m.SetWidth(m.viewport.Width)
+}
+
+// LineChanges returns how every line of the value differs from the original
+// text. It's nil if no original text is set.
+func (m Model) LineChanges() []LineChange {
+	if m.original == nil {
+		return nil
+	}
+
+	changes := make([]LineChange, len(m.value))
+	for _, h := range m.hunks() {
+		if h.b0 == h.b1 {
+			row := min(h.b0, len(m.value)-1)
+			if changes[row] == LineUnchanged {
+				changes[row] = LineDeleted
+			}
+			continue
+		}
+		for row := h.b0; row < h.b1; row++ {
+			if row-h.b0 < h.a1-h.a0 {
+				changes[row] = LineModified
+			} else {
+				changes[row] = LineAdded
+			}
+		}
+	}
+	return changes
+}
+
+// RevertHunk reverts the lines changed around the cursor to the original text.
+// It does nothing if no original text is set.
+func (m *Model) RevertHunk() {
+	if m.original == nil {
+		return
+	}
+	for _, h := range m.hunks() {
+		inside := h.b0 <= m.row && m.row < h.b1
+		deletedHere := h.b0 == h.b1 && m.row == min(h.b0, len(m.value)-1)
+		if !inside && !deletedHere {
+			continue
+		}
+
+		if len(m.value)-(h.b1-h.b0)+(h.a1-h.a0) > maxHeight {
+			return
+		}
+		value := make([][]rune, 0, maxHeight)
+		value = append(value, m.value[:h.b0]...)
+		for _, line := range m.original[h.a0:h.a1] {
+			value = append(value, []rune(line))
+		}
+		value = append(value, m.value[h.b1:]...)
+		if len(value) == 0 {
+			value = append(value, nil)
+		}
+
+		m.value = value
+		m.row = clamp(h.b0, 0, len(m.value)-1)
+		m.SetCursor(0)
+		return
+	}
+}
+
+// hunks returns the ranges of lines that differ between the original text
+// and the value, found through their longest common subsequence.
+func (m Model) hunks() []hunk {
+	a := m.original
+	b := make([]string, len(m.value))
+	for i, line := range m.value {
+		b[i] = string(line)
+	}
+
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and
+	// b[j:].
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+
+	var (
+		hunks  []hunk
+		i, j   int
+		a0, b0 int
+	)
+	flush := func() {
+		if i > a0 || j > b0 {
+			hunks = append(hunks, hunk{a0: a0, a1: i, b0: b0, b1: j})
+		}
+	}
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			flush()
+			i++
+			j++
+			a0, b0 = i, j
+		case j >= len(b) || i < len(a) && lcs[i+1][j] >= lcs[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+	flush()
+	return hunks
+}
+
+// gutterView renders the diff gutter for a line.
+func (m Model) gutterView(change LineChange) string {
+	switch change {
+	case LineAdded:
+		return m.style.DiffAdded.String()
+	case LineModified:
+		return m.style.DiffModified.String()
+	case LineDeleted:
+		return m.style.DiffDeleted.String()
+	}
+	return " "
+}
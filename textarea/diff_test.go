@@ -0,0 +1,88 @@
+package textarea
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestLineChanges(t *testing.T) {
+	textarea := newTextArea()
+	textarea.SetValue("a\nB\nc\nnew\ne")
+	textarea.SetOriginal("a\nb\nc\nd\ne\nf")
+
+	want := []LineChange{LineUnchanged, LineModified, LineUnchanged, LineModified, LineDeleted}
+	if got := textarea.LineChanges(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	textarea.SetValue("x\na\nb\nc\nd\ne\nf")
+	want = []LineChange{LineAdded, LineUnchanged, LineUnchanged, LineUnchanged, LineUnchanged, LineUnchanged, LineUnchanged}
+	if got := textarea.LineChanges(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %v, got %v", want, got)
+	}
+
+	textarea.ClearOriginal()
+	if got := textarea.LineChanges(); got != nil {
+		t.Fatalf("expected no changes without an original, got %v", got)
+	}
+}
+
+func TestRevertHunk(t *testing.T) {
+	textarea := newTextArea()
+	textarea.SetOriginal("a\nb\nc\nd")
+	textarea.SetValue("a\nB\nB2\nc")
+
+	// The cursor is on the last line, above which nothing changed, and below
+	// which d was deleted.
+	textarea, _ = textarea.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("r"), Alt: true})
+	if v := textarea.Value(); v != "a\nB\nB2\nc\nd" {
+		t.Fatalf("expected the deleted line to be restored, got %q", v)
+	}
+
+	textarea.row = 2
+	textarea.RevertHunk()
+	if v := textarea.Value(); v != "a\nb\nc\nd" {
+		t.Fatalf("expected the modified lines to be reverted, got %q", v)
+	}
+	if textarea.row != 1 || textarea.col != 0 {
+		t.Errorf("expected the cursor at the start of the hunk, got %d:%d", textarea.row, textarea.col)
+	}
+}
+
+func TestRevertHunkWithoutOriginal(t *testing.T) {
+	textarea := newTextArea()
+	textarea.SetValue("hello\nworld")
+
+	if textarea.KeyMap.RevertHunk.Enabled() {
+		t.Fatal("expected the RevertHunk keybinding to be disabled without an original text")
+	}
+	textarea.RevertHunk()
+	if v := textarea.Value(); v != "hello\nworld" {
+		t.Fatalf("expected the value to be kept without an original text, got %q", v)
+	}
+
+	textarea.SetOriginal("hello")
+	if !textarea.KeyMap.RevertHunk.Enabled() {
+		t.Fatal("expected the RevertHunk keybinding to be enabled with an original text")
+	}
+}
+
+func TestDiffGutter(t *testing.T) {
+	textarea := newTextArea()
+	textarea.Prompt = ""
+	textarea.ShowLineNumbers = false
+	textarea.SetWidth(10)
+	textarea.SetOriginal("a\nb")
+	textarea.SetValue("a\nB")
+
+	if w := textarea.Width(); w != 9 {
+		t.Errorf("expected the gutter to take a column, got a width of %d", w)
+	}
+	lines := strings.Split(textarea.View(), "\n")
+	if !strings.HasPrefix(lines[0], " a") || !strings.HasPrefix(lines[1], "▎B") {
+		t.Errorf("expected a gutter mark on the modified line, got %q", lines[:2])
+	}
+}
@@ -0,0 +1,120 @@
+package textarea
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+// LimitUnit is what a Limit counts.
+type LimitUnit int
+
+// Limit units.
+const (
+	LimitRunes LimitUnit = iota
+	LimitWords
+	LimitLines
+)
+
+// Limit limits the length of the value.
+type Limit struct {
+	Unit LimitUnit
+	Max  int
+
+	// Hard limits block input that would exceed Max. Soft limits let the
+	// value exceed Max, and the prompt is rendered with the OverLimit style
+	// while it does, as a warning.
+	Hard bool
+}
+
+// RuneCount returns the number of characters in the value, counting line
+// breaks.
+func (m Model) RuneCount() int {
+	n := len(m.value) - 1
+	for _, line := range m.value {
+		n += len(line)
+	}
+	return n
+}
+
+// WordCount returns the number of words in the value.
+func (m Model) WordCount() int {
+	var n int
+	for _, line := range m.value {
+		n += len(strings.Fields(string(line)))
+	}
+	return n
+}
+
+// Count returns the length of the value in the given unit.
+func (m Model) Count(unit LimitUnit) int {
+	switch unit {
+	case LimitWords:
+		return m.WordCount()
+	case LimitLines:
+		return m.LineCount()
+	default:
+		return m.RuneCount()
+	}
+}
+
+// OverLimit returns whether the value exceeds one of the Limits.
+func (m Model) OverLimit() bool {
+	return m.exceeds(false)
+}
+
+// exceeds returns whether the value exceeds one of the Limits, or one of the
+// hard ones.
+func (m Model) exceeds(hardOnly bool) bool {
+	for _, l := range m.Limits {
+		if hardOnly && !l.Hard {
+			continue
+		}
+		if m.Count(l.Unit) > l.Max {
+			return true
+		}
+	}
+	return false
+}
+
+// hasHardLimit returns whether one of the Limits is hard.
+func (m Model) hasHardLimit() bool {
+	for _, l := range m.Limits {
+		if l.Hard {
+			return true
+		}
+	}
+	return false
+}
+
+// promptStyle returns the style of the prompt, which warns when a soft limit
+// is exceeded.
+func (m Model) promptStyle() lipgloss.Style {
+	if m.OverLimit() {
+		return m.style.OverLimit
+	}
+	return m.style.Prompt
+}
+
+// snapshot is the value and the cursor position of a textarea, used to undo
+// edits that exceed a hard limit.
+type snapshot struct {
+	value    [][]rune
+	row, col int
+}
+
+// snapshot returns a copy of the value and the cursor position.
+func (m Model) snapshot() snapshot {
+	value := make([][]rune, len(m.value), maxHeight)
+	for i, line := range m.value {
+		value[i] = append([]rune(nil), line...)
+	}
+	return snapshot{value: value, row: m.row, col: m.col}
+}
+
+// restore restores a snapshot.
+func (m *Model) restore(s snapshot) {
+	m.value = s.value
+	m.row = s.row
+	m.col = s.col
+}
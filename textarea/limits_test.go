@@ -0,0 +1,62 @@
+package textarea
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestCounts(t *testing.T) {
+	textarea := newTextArea()
+	textarea.SetValue("one two\nthree")
+
+	if n := textarea.RuneCount(); n != 13 {
+		t.Errorf("expected 13 characters, got %d", n)
+	}
+	if n := textarea.WordCount(); n != 3 {
+		t.Errorf("expected 3 words, got %d", n)
+	}
+	if n := textarea.Count(LimitLines); n != 2 {
+		t.Errorf("expected 2 lines, got %d", n)
+	}
+}
+
+func TestHardLimit(t *testing.T) {
+	textarea := newTextArea()
+	textarea.Limits = []Limit{
+		{Unit: LimitWords, Max: 2, Hard: true},
+		{Unit: LimitLines, Max: 2, Hard: true},
+	}
+
+	for _, r := range "one two three" {
+		textarea, _ = textarea.Update(keyPress(r))
+	}
+	if v := textarea.Value(); v != "one two " {
+		t.Fatalf("expected the third word to be blocked, got %q", v)
+	}
+
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+	textarea, _ = textarea.Update(enter)
+	textarea, _ = textarea.Update(enter)
+	if n := textarea.LineCount(); n != 2 {
+		t.Fatalf("expected the third line to be blocked, got %d lines", n)
+	}
+	if textarea.row != 1 || textarea.col != 0 {
+		t.Errorf("expected the cursor to stay on the second line, got %d:%d", textarea.row, textarea.col)
+	}
+}
+
+func TestSoftLimit(t *testing.T) {
+	textarea := newTextArea()
+	textarea.Limits = []Limit{{Unit: LimitRunes, Max: 3}}
+
+	for _, r := range "four" {
+		textarea, _ = textarea.Update(keyPress(r))
+	}
+	if v := textarea.Value(); v != "four" {
+		t.Fatalf("expected a soft limit not to block input, got %q", v)
+	}
+	if !textarea.OverLimit() {
+		t.Error("expected the textarea to be over its limit")
+	}
+}
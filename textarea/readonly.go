@@ -0,0 +1,117 @@
+package textarea
+
+import (
+	"strings"
+	"unicode/utf8"
+
+	"github.com/atotto/clipboard"
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// copyErrMsg is sent when copying to the clipboard failed.
+type copyErrMsg struct{ error }
+
+// SetReadOnly makes the textarea read-only, or editable again. A read-only
+// textarea keeps cursor navigation, searching and copying, but rejects edits
+// from the user; the value can still be set with SetValue.
+func (m *Model) SetReadOnly(v bool) {
+	m.readOnly = v
+}
+
+// ReadOnly returns whether the textarea is read-only.
+func (m Model) ReadOnly() bool {
+	return m.readOnly
+}
+
+// navigates returns whether a key only moves the cursor or copies, and is
+// allowed in read-only mode.
+func (m Model) navigates(msg tea.KeyMsg) bool {
+	return key.Matches(msg,
+		m.KeyMap.CharacterForward,
+		m.KeyMap.CharacterBackward,
+		m.KeyMap.WordForward,
+		m.KeyMap.WordBackward,
+		m.KeyMap.LineNext,
+		m.KeyMap.LinePrevious,
+		m.KeyMap.LineStart,
+		m.KeyMap.LineEnd,
+		m.KeyMap.InputBegin,
+		m.KeyMap.InputEnd,
+		m.KeyMap.Copy,
+	)
+}
+
+// FindNext moves the cursor to the next occurrence of the query after the
+// cursor, wrapping around to the start. It returns whether the query was
+// found.
+func (m *Model) FindNext(query string) bool {
+	if query == "" {
+		return false
+	}
+	for i := 0; i <= len(m.value); i++ {
+		row := (m.row + i) % len(m.value)
+		for _, col := range occurrences(m.value[row], query) {
+			if i > 0 || col > m.col {
+				m.row = row
+				m.SetCursor(col)
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// FindPrevious moves the cursor to the previous occurrence of the query
+// before the cursor, wrapping around to the end. It returns whether the query
+// was found.
+func (m *Model) FindPrevious(query string) bool {
+	if query == "" {
+		return false
+	}
+	for i := 0; i <= len(m.value); i++ {
+		row := (m.row - i + len(m.value)) % len(m.value)
+		cols := occurrences(m.value[row], query)
+		for j := len(cols) - 1; j >= 0; j-- {
+			if i > 0 || cols[j] < m.col {
+				m.row = row
+				m.SetCursor(cols[j])
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// occurrences returns the columns at which the query occurs in a line.
+func occurrences(line []rune, query string) []int {
+	var (
+		cols []int
+		s    = string(line)
+		col  int
+	)
+	for {
+		i := strings.Index(s, query)
+		if i < 0 {
+			return cols
+		}
+		col += len([]rune(s[:i]))
+		cols = append(cols, col)
+
+		// Continue after the first rune of the occurrence.
+		_, size := utf8.DecodeRuneInString(s[i:])
+		s = s[i+size:]
+		col++
+	}
+}
+
+// copyLine returns a command that copies the cursor's line to the clipboard.
+func (m Model) copyLine() tea.Cmd {
+	line := string(m.value[m.row])
+	return func() tea.Msg {
+		if err := clipboard.WriteAll(line); err != nil {
+			return copyErrMsg{err}
+		}
+		return nil
+	}
+}
@@ -0,0 +1,70 @@
+package textarea
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestReadOnly(t *testing.T) {
+	textarea := newTextArea()
+	textarea.SetValue("one\ntwo")
+	textarea.SetReadOnly(true)
+
+	for _, msg := range []tea.Msg{
+		keyPress('x'),
+		tea.KeyMsg{Type: tea.KeyEnter},
+		tea.KeyMsg{Type: tea.KeyBackspace},
+		tea.KeyMsg{Type: tea.KeyCtrlK},
+		pasteMsg("pasted"),
+	} {
+		textarea, _ = textarea.Update(msg)
+	}
+	if v := textarea.Value(); v != "one\ntwo" {
+		t.Fatalf("expected edits to be rejected, got %q", v)
+	}
+
+	textarea, _ = textarea.Update(tea.KeyMsg{Type: tea.KeyUp})
+	textarea, _ = textarea.Update(tea.KeyMsg{Type: tea.KeyHome})
+	if textarea.row != 0 || textarea.col != 0 {
+		t.Fatalf("expected the cursor to move, got %d:%d", textarea.row, textarea.col)
+	}
+
+	textarea.SetReadOnly(false)
+	textarea, _ = textarea.Update(keyPress('x'))
+	if v := textarea.Value(); v != "xone\ntwo" {
+		t.Fatalf("expected edits once editable again, got %q", v)
+	}
+}
+
+func TestFind(t *testing.T) {
+	textarea := newTextArea()
+	textarea.SetValue("foo bar\nbaz foo\nfoo")
+	textarea.moveToBegin()
+
+	tests := []struct {
+		next     bool
+		row, col int
+	}{
+		{true, 1, 4},
+		{true, 2, 0},
+		{true, 0, 0}, // wraps around
+		{false, 2, 0},
+		{false, 1, 4},
+	}
+	for i, tt := range tests {
+		var found bool
+		if tt.next {
+			found = textarea.FindNext("foo")
+		} else {
+			found = textarea.FindPrevious("foo")
+		}
+		if !found || textarea.row != tt.row || textarea.col != tt.col {
+			t.Fatalf("%d: expected foo at %d:%d, got %v at %d:%d", i, tt.row, tt.col, found, textarea.row, textarea.col)
+		}
+	}
+
+	if textarea.FindNext("qux") {
+		t.Error("expected qux not to be found")
+	}
+}
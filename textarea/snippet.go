@@ -0,0 +1,179 @@
+package textarea
+
+import (
+	"regexp"
+	"sort"
+	"strconv"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// tabstopPattern matches the tab stops of a snippet: ${1}, or ${1:default}.
+var tabstopPattern = regexp.MustCompile(`\$\{(\d+)(?::([^}]*))?\}`)
+
+// tabstop is a tab stop of an inserted snippet: a range of the value, as
+// offsets into the value's runes, line breaks included.
+type tabstop struct {
+	start, length int
+}
+
+// snippetState is the state of an inserted snippet.
+type snippetState struct {
+	// The tab stops in the order they're visited.
+	stops   []tabstop
+	current int
+
+	// Whether typing replaces the text of the current tab stop.
+	replace bool
+}
+
+// InsertSnippet inserts a snippet at the cursor and moves the cursor to its
+// first tab stop. Tab stops are written ${1}, ${2} and so on, and are visited
+// in that order with the NextTabstop and PrevTabstop keybindings; ${0} is
+// visited last if it's set, the end of the snippet otherwise. A tab stop can
+// have default text, written ${1:default} or passed in tabstops, where the
+// first one is the default of ${1}. Typing at a tab stop replaces its default
+// text.
+//
+//	m.InsertSnippet("${1:feat}(${2}): ${3}\n\n${0}", "", "scope")
+func (m *Model) InsertSnippet(text string, tabstops ...string) {
+	type stop struct {
+		n int
+		tabstop
+	}
+	var (
+		stops    []stop
+		seen     = make(map[int]bool)
+		expanded []rune
+		last     int
+		start    = m.cursorOffset()
+	)
+	for _, match := range tabstopPattern.FindAllStringSubmatchIndex(text, -1) {
+		expanded = append(expanded, []rune(text[last:match[0]])...)
+		last = match[1]
+
+		n, _ := strconv.Atoi(text[match[2]:match[3]])
+		var def string
+		if match[4] >= 0 {
+			def = text[match[4]:match[5]]
+		}
+		if n > 0 && n <= len(tabstops) && tabstops[n-1] != "" {
+			def = tabstops[n-1]
+		}
+
+		if !seen[n] {
+			seen[n] = true
+			stops = append(stops, stop{n, tabstop{start + len(expanded), len([]rune(def))}})
+		}
+		expanded = append(expanded, []rune(def)...)
+	}
+	expanded = append(expanded, []rune(text[last:])...)
+
+	// Visit ${1} and up in order, then ${0} or the end of the snippet.
+	sort.SliceStable(stops, func(i, j int) bool {
+		return stops[i].n != 0 && (stops[j].n == 0 || stops[i].n < stops[j].n)
+	})
+	if !seen[0] {
+		stops = append(stops, stop{0, tabstop{start + len(expanded), 0}})
+	}
+
+	m.InsertString(string(expanded))
+
+	s := &snippetState{}
+	for _, st := range stops {
+		s.stops = append(s.stops, st.tabstop)
+	}
+	m.snippet = s
+	m.gotoTabstop(0)
+}
+
+// SnippetActive returns whether the cursor is moving between the tab stops of
+// an inserted snippet.
+func (m Model) SnippetActive() bool {
+	return m.snippet != nil
+}
+
+// gotoTabstop moves the cursor to the end of the tab stop with the given
+// index. The snippet ends at the last tab stop.
+func (m *Model) gotoTabstop(i int) {
+	s := m.snippet
+	i = clamp(i, 0, len(s.stops)-1)
+	s.current = i
+	stop := s.stops[i]
+	s.replace = stop.length > 0
+	m.setCursorOffset(stop.start + stop.length)
+
+	if i == len(s.stops)-1 {
+		m.snippet = nil
+	}
+}
+
+// replaceTabstop deletes the text of the current tab stop, before typing
+// replaces it.
+func (m *Model) replaceTabstop() {
+	s := m.snippet
+	s.replace = false
+	stop := s.stops[s.current]
+
+	runes := []rune(m.Value())
+	runes = append(runes[:stop.start], runes[stop.start+stop.length:]...)
+	m.setRunes(runes)
+	m.setCursorOffset(stop.start)
+	s.shift(stop.start, -stop.length)
+}
+
+// shift updates the tab stops after the value changed by delta runes at the
+// given offset. The current tab stop grows or shrinks if the change was made
+// inside it, and tab stops after it move.
+func (s *snippetState) shift(at, delta int) {
+	for i := range s.stops {
+		stop := &s.stops[i]
+		switch {
+		case i == s.current && stop.start <= at && at <= stop.start+stop.length:
+			stop.length = max(0, stop.length+delta)
+		case stop.start > at || stop.start == at && i > s.current:
+			stop.start = max(at, stop.start+delta)
+		}
+	}
+}
+
+// isTyping returns whether a key types text.
+func isTyping(msg tea.KeyMsg) bool {
+	return (msg.Type == tea.KeyRunes || msg.Type == tea.KeySpace) && !msg.Alt
+}
+
+// cursorOffset returns the offset of the cursor into the value's runes.
+func (m Model) cursorOffset() int {
+	offset := m.col
+	for _, line := range m.value[:m.row] {
+		offset += len(line) + 1
+	}
+	return offset
+}
+
+// setCursorOffset moves the cursor to an offset into the value's runes.
+func (m *Model) setCursorOffset(offset int) {
+	for row, line := range m.value {
+		if offset <= len(line) || row == len(m.value)-1 {
+			m.row = row
+			m.SetCursor(offset)
+			return
+		}
+		offset -= len(line) + 1
+	}
+}
+
+// setRunes replaces the value, keeping the cursor in bounds.
+func (m *Model) setRunes(runes []rune) {
+	value := make([][]rune, 1, maxHeight)
+	for _, r := range runes {
+		if r == '\n' {
+			value = append(value, nil)
+			continue
+		}
+		value[len(value)-1] = append(value[len(value)-1], r)
+	}
+	m.value = value
+	m.row = min(m.row, len(m.value)-1)
+	m.SetCursor(m.col)
+}
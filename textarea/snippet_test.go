@@ -0,0 +1,55 @@
+package textarea
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/bubbles/bubbletest"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestInsertSnippet(t *testing.T) {
+	textarea := newTextArea()
+	tab := tea.KeyMsg{Type: tea.KeyTab}
+
+	textarea.InsertSnippet("${1:feat}(${2}): ${3}\n\n${0}", "", "scope")
+	if v := textarea.Value(); v != "feat(scope): \n\n" {
+		t.Fatalf("expected the defaults to be inserted, got %q", v)
+	}
+	if !textarea.SnippetActive() || textarea.col != 4 {
+		t.Fatalf("expected the cursor at the end of the first tab stop, got %d", textarea.col)
+	}
+
+	// Typing replaces the default text.
+	textarea = bubbletest.TypeString(textarea, "fix").(Model)
+	textarea, _ = textarea.Update(tab)
+	if v := textarea.Value(); v != "fix(scope): \n\n" || textarea.col != 9 {
+		t.Fatalf("expected the second tab stop after fix(scope, got %q at %d", v, textarea.col)
+	}
+
+	// Typing at the second tab stop replaces its default, and moves the third.
+	textarea = bubbletest.TypeString(textarea, "ui").(Model)
+	textarea, _ = textarea.Update(tab)
+	textarea = bubbletest.TypeString(textarea, "summary").(Model)
+	if v := textarea.Value(); v != "fix(ui): summary\n\n" {
+		t.Fatalf("expected the tab stops to be filled in, got %q", v)
+	}
+
+	textarea, _ = textarea.Update(tea.KeyMsg{Type: tea.KeyShiftTab})
+	if textarea.row != 0 || textarea.col != 6 {
+		t.Fatalf("expected the cursor back at the end of ui, got %d:%d", textarea.row, textarea.col)
+	}
+
+	textarea, _ = textarea.Update(tab)
+	textarea, _ = textarea.Update(tab)
+	if textarea.SnippetActive() || textarea.row != 2 || textarea.col != 0 {
+		t.Fatalf("expected the snippet to end at ${0}, got %v at %d:%d", textarea.SnippetActive(), textarea.row, textarea.col)
+	}
+}
+
+func TestInsertSnippetWithoutTabstops(t *testing.T) {
+	textarea := newTextArea()
+	textarea.InsertSnippet("key: value")
+	if textarea.SnippetActive() || textarea.col != len("key: value") {
+		t.Fatalf("expected the cursor after the snippet, got %v at %d", textarea.SnippetActive(), textarea.col)
+	}
+}
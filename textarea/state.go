@@ -0,0 +1,45 @@
+package textarea
+
+import "encoding/json"
+
+// State is the content of the textarea and the position of the cursor. It
+// only has exported fields of basic types, so it can be saved with
+// encoding/json or similar and restored in a later session with
+// RestoreState.
+type State struct {
+	Value string
+
+	// Row and Column are the line and the column, in runes, of the cursor.
+	Row    int
+	Column int
+}
+
+// State returns the current state of the textarea.
+func (m Model) State() State {
+	return State{Value: m.Value(), Row: m.row, Column: m.col}
+}
+
+// RestoreState restores a state returned by State. A cursor past the end of
+// the value is moved to the end of its line or of the value.
+func (m *Model) RestoreState(s State) {
+	m.SetValue(s.Value)
+	m.row = clamp(s.Row, 0, len(m.value)-1)
+	m.SetCursor(s.Column)
+	m.repositionView()
+}
+
+// MarshalState returns the state of the textarea as JSON. It satisfies the
+// state.Stateful interface along with UnmarshalState.
+func (m Model) MarshalState() ([]byte, error) {
+	return json.Marshal(m.State())
+}
+
+// UnmarshalState restores a state returned by MarshalState.
+func (m *Model) UnmarshalState(data []byte) error {
+	var s State
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	m.RestoreState(s)
+	return nil
+}
@@ -54,6 +54,17 @@ type KeyMap struct {
 	CapitalizeWordForward key.Binding
 
 	TransposeCharacterBackward key.Binding
+
+	// RevertHunk is enabled while an original text is set, see
+	// Model.SetOriginal.
+	RevertHunk key.Binding
+
+	// Copy copies the cursor's line to the clipboard.
+	Copy key.Binding
+
+	// Used while a snippet is inserted, see InsertSnippet.
+	NextTabstop key.Binding
+	PrevTabstop key.Binding
 }
 
 // DefaultKeyMap is the default set of key bindings for navigating and acting
@@ -83,6 +94,13 @@ var DefaultKeyMap = KeyMap{
 	UppercaseWordForward:  key.NewBinding(key.WithKeys("alt+u")),
 
 	TransposeCharacterBackward: key.NewBinding(key.WithKeys("ctrl+t")),
+
+	RevertHunk: key.NewBinding(key.WithKeys("alt+r"), key.WithDisabled()),
+
+	Copy: key.NewBinding(key.WithKeys("alt+w")),
+
+	NextTabstop: key.NewBinding(key.WithKeys("tab")),
+	PrevTabstop: key.NewBinding(key.WithKeys("shift+tab")),
 }
 
 // LineInfo is a helper for keeping track of line information regarding
@@ -126,6 +144,14 @@ type Style struct {
 	Placeholder      lipgloss.Style
 	Prompt           lipgloss.Style
 	Text             lipgloss.Style
+
+	// The prompt while a soft limit is exceeded, see Limit.
+	OverLimit lipgloss.Style
+
+	// The marks in the diff gutter, see SetOriginal.
+	DiffAdded    lipgloss.Style
+	DiffModified lipgloss.Style
+	DiffDeleted  lipgloss.Style
 }
 
 // Model is the Bubble Tea model for this text area element.
@@ -173,6 +199,9 @@ type Model struct {
 	// accept. If 0 or less, there's no limit.
 	CharLimit int
 
+	// Limits limit the length of the value by characters, words or lines.
+	Limits []Limit
+
 	// If promptFunc is set, it replaces Prompt as a generator for
 	// prompt strings at the beginning of each line.
 	promptFunc func(line int) string
@@ -212,6 +241,17 @@ type Model struct {
 	// viewport is the vertically-scrollable viewport of the multi-line text
 	// input.
 	viewport *viewport.Model
+
+	// original is the text the value is compared to, if any, split into
+	// lines.
+	original []string
+
+	// readOnly rejects edits from the user.
+	readOnly bool
+
+	// snippet is the inserted snippet whose tab stops the cursor moves
+	// between, if any.
+	snippet *snippetState
 }
 
 // New creates a new model with default settings.
@@ -260,6 +300,10 @@ func DefaultStyles() (Style, Style) {
 		Placeholder:      lipgloss.NewStyle().Foreground(lipgloss.Color("240")),
 		Prompt:           lipgloss.NewStyle().Foreground(lipgloss.Color("7")),
 		Text:             lipgloss.NewStyle(),
+		OverLimit:        lipgloss.NewStyle().Foreground(lipgloss.Color("1")),
+		DiffAdded:        lipgloss.NewStyle().Foreground(lipgloss.Color("2")).SetString("▎"),
+		DiffModified:     lipgloss.NewStyle().Foreground(lipgloss.Color("4")).SetString("▎"),
+		DiffDeleted:      lipgloss.NewStyle().Foreground(lipgloss.Color("1")).SetString("▁"),
 	}
 	blurred := Style{
 		Base:             lipgloss.NewStyle(),
@@ -270,6 +314,10 @@ func DefaultStyles() (Style, Style) {
 		Placeholder:      lipgloss.NewStyle().Foreground(lipgloss.Color("240")),
 		Prompt:           lipgloss.NewStyle().Foreground(lipgloss.Color("7")),
 		Text:             lipgloss.NewStyle().Foreground(lipgloss.AdaptiveColor{Light: "245", Dark: "7"}),
+		OverLimit:        lipgloss.NewStyle().Foreground(lipgloss.Color("1")),
+		DiffAdded:        lipgloss.NewStyle().Foreground(lipgloss.Color("2")).SetString("▎"),
+		DiffModified:     lipgloss.NewStyle().Foreground(lipgloss.Color("4")).SetString("▎"),
+		DiffDeleted:      lipgloss.NewStyle().Foreground(lipgloss.Color("1")).SetString("▁"),
 	}
 
 	return focused, blurred
@@ -449,6 +497,7 @@ func (m *Model) Blur() {
 
 // Reset sets the input to its default state with no input.
 func (m *Model) Reset() {
+	m.snippet = nil
 	m.value = make([][]rune, minHeight, maxHeight)
 	m.col = 0
 	m.row = 0
@@ -787,22 +836,31 @@ func (m *Model) moveToEnd() {
 func (m *Model) SetWidth(w int) {
 	m.viewport.Width = clamp(w, minWidth, maxWidth)
 
-	// Since the width of the textarea input is dependant on the width of the
-	// prompt and line numbers, we need to calculate it by subtracting.
-	inputWidth := w
-	if m.ShowLineNumbers {
-		inputWidth -= rw.StringWidth(fmt.Sprintf(m.lineNumberFormat, 0))
+	if m.promptFunc == nil {
+		m.promptWidth = rw.StringWidth(m.Prompt)
 	}
 
-	// Account for base style borders and padding.
-	inputWidth -= m.style.Base.GetHorizontalFrameSize()
+	// Since the width of the textarea input is dependant on the width of the
+	// prompt and line numbers, we need to calculate it by subtracting.
+	m.width = clamp(w-m.reservedWidth(), minWidth, maxWidth)
+}
 
+// reservedWidth returns the width taken up by everything but the input: the
+// prompt, the line numbers, the diff gutter and the base style's frame.
+func (m Model) reservedWidth() int {
+	w := m.promptWidth
 	if m.promptFunc == nil {
-		m.promptWidth = rw.StringWidth(m.Prompt)
+		w = rw.StringWidth(m.Prompt)
+	}
+	if m.ShowLineNumbers {
+		w += rw.StringWidth(fmt.Sprintf(m.lineNumberFormat, 0))
+	}
+	if m.original != nil {
+		w++ // the diff gutter
 	}
 
-	inputWidth -= m.promptWidth
-	m.width = clamp(inputWidth, minWidth, maxWidth)
+	// Account for base style borders and padding.
+	return w + m.style.Base.GetHorizontalFrameSize()
 }
 
 // SetPromptFunc supersedes the Prompt field and sets a dynamic prompt
@@ -828,6 +886,29 @@ func (m *Model) SetHeight(h int) {
 	m.viewport.Height = clamp(h, minHeight, maxHeight)
 }
 
+// SetSize sets the width and the height of the textarea, as SetWidth and
+// SetHeight do. It satisfies the layout.Sizable interface.
+func (m *Model) SetSize(width, height int) {
+	m.SetWidth(width)
+	m.SetHeight(height)
+}
+
+// MinSize returns the size of the textarea with the smallest input it
+// allows.
+func (m Model) MinSize() (width, height int) {
+	return m.reservedWidth() + minWidth, minHeight
+}
+
+// PreferredSize returns the size that shows all of the value without
+// wrapping or scrolling, within the limits of the textarea.
+func (m Model) PreferredSize() (width, height int) {
+	for _, line := range m.value {
+		width = max(width, rw.StringWidth(string(line))+1) // room for the cursor
+	}
+	width = clamp(width, minWidth, maxWidth)
+	return m.reservedWidth() + width, clamp(len(m.value), minHeight, maxHeight)
+}
+
 // Update is the Bubble Tea update loop.
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 	if !m.focus {
@@ -844,9 +925,43 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		m.value[m.row] = make([]rune, 0)
 	}
 
+	// Remember the value to undo edits that exceed a hard limit.
+	var before *snapshot
+	switch msg.(type) {
+	case tea.KeyMsg, pasteMsg:
+		if m.hasHardLimit() && !m.exceeds(true) {
+			s := m.snapshot()
+			before = &s
+		}
+	}
+
+	// Where the cursor was and how long the value was, to keep the tab stops
+	// of an inserted snippet in place.
+	var snippetAt, snippetLen int
+
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if m.readOnly && !m.navigates(msg) {
+			break
+		}
+
+		// Typing at a tab stop replaces its default text.
+		if m.snippet != nil && m.snippet.replace {
+			if isTyping(msg) {
+				m.replaceTabstop()
+			} else if !key.Matches(msg, m.KeyMap.NextTabstop, m.KeyMap.PrevTabstop) {
+				m.snippet.replace = false
+			}
+		}
+		snippetAt, snippetLen = m.cursorOffset(), m.RuneCount()
+
 		switch {
+		case m.snippet != nil && key.Matches(msg, m.KeyMap.NextTabstop):
+			m.gotoTabstop(m.snippet.current + 1)
+		case m.snippet != nil && key.Matches(msg, m.KeyMap.PrevTabstop):
+			m.gotoTabstop(m.snippet.current - 1)
+		case key.Matches(msg, m.KeyMap.Copy):
+			cmds = append(cmds, m.copyLine())
 		case key.Matches(msg, m.KeyMap.DeleteAfterCursor):
 			m.col = clamp(m.col, 0, len(m.value[m.row]))
 			if m.col >= len(m.value[m.row]) {
@@ -930,6 +1045,9 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 			m.capitalizeRight()
 		case key.Matches(msg, m.KeyMap.TransposeCharacterBackward):
 			m.transposeLeft()
+		case key.Matches(msg, m.KeyMap.RevertHunk):
+			m.RevertHunk()
+			m.snippet = nil
 
 		default:
 			if m.CharLimit > 0 && rw.StringWidth(m.Value()) >= m.CharLimit {
@@ -942,10 +1060,25 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		}
 
 	case pasteMsg:
-		m.handlePaste(string(msg))
+		if !m.readOnly {
+			m.handlePaste(string(msg))
+		}
 
 	case pasteErrMsg:
 		m.Err = msg
+
+	case copyErrMsg:
+		m.Err = msg
+	}
+
+	if before != nil && m.exceeds(true) {
+		m.restore(*before)
+	}
+
+	if _, ok := msg.(tea.KeyMsg); ok && m.snippet != nil && !m.readOnly {
+		if delta := m.RuneCount() - snippetLen; delta != 0 {
+			m.snippet.shift(min(snippetAt, m.cursorOffset()), delta)
+		}
 	}
 
 	vp, cmd := m.viewport.Update(msg)
@@ -977,6 +1110,7 @@ func (m Model) View() string {
 	lineInfo := m.LineInfo()
 
 	var newLines int
+	changes := m.LineChanges()
 
 	displayLine := 0
 	for l, line := range m.value {
@@ -990,10 +1124,14 @@ func (m Model) View() string {
 
 		for wl, wrappedLine := range wrappedLines {
 			prompt := m.getPromptString(displayLine)
-			prompt = m.style.Prompt.Render(prompt)
+			prompt = m.promptStyle().Render(prompt)
 			s.WriteString(style.Render(prompt))
 			displayLine++
 
+			if changes != nil {
+				s.WriteString(m.gutterView(changes[l]))
+			}
+
 			if m.ShowLineNumbers {
 				if wl == 0 {
 					if m.row == l {
@@ -1042,10 +1180,14 @@ func (m Model) View() string {
 	// To do this we can simply pad out a few extra new lines in the view.
 	for i := 0; i < m.height; i++ {
 		prompt := m.getPromptString(displayLine)
-		prompt = m.style.Prompt.Render(prompt)
+		prompt = m.promptStyle().Render(prompt)
 		s.WriteString(prompt)
 		displayLine++
 
+		if changes != nil {
+			s.WriteString(" ")
+		}
+
 		if m.ShowLineNumbers {
 			lineNumber := m.style.EndOfBuffer.Render((fmt.Sprintf(m.lineNumberFormat, string(m.EndOfBufferCharacter))))
 			s.WriteString(lineNumber)
@@ -1079,9 +1221,13 @@ func (m Model) placeholderView() string {
 	)
 
 	prompt := m.getPromptString(0)
-	prompt = m.style.Prompt.Render(prompt)
+	prompt = m.promptStyle().Render(prompt)
 	s.WriteString(m.style.CursorLine.Render(prompt))
 
+	if m.original != nil {
+		s.WriteString(m.style.CursorLine.Render(" "))
+	}
+
 	if m.ShowLineNumbers {
 		s.WriteString(m.style.CursorLine.Render(m.style.CursorLineNumber.Render((fmt.Sprintf(m.lineNumberFormat, 1)))))
 	}
@@ -1097,9 +1243,13 @@ func (m Model) placeholderView() string {
 	for i := 1; i < m.height; i++ {
 		s.WriteRune('\n')
 		prompt := m.getPromptString(i)
-		prompt = m.style.Prompt.Render(prompt)
+		prompt = m.promptStyle().Render(prompt)
 		s.WriteString(prompt)
 
+		if m.original != nil {
+			s.WriteString(" ")
+		}
+
 		if m.ShowLineNumbers {
 			eob := m.style.EndOfBuffer.Render((fmt.Sprintf(m.lineNumberFormat, string(m.EndOfBufferCharacter))))
 			s.WriteString(eob)
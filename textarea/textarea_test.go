@@ -444,3 +444,43 @@ func newTextArea() Model {
 func keyPress(key rune) tea.Msg {
 	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{key}, Alt: false}
 }
+
+func TestSize(t *testing.T) {
+	textarea := newTextArea()
+	textarea.SetValue("foo\nfoobar")
+
+	// The prompt and the line numbers take up 5 cells.
+	if w, h := textarea.MinSize(); w != 7 || h != 1 {
+		t.Errorf("expected a minimum size of 7x1, got %dx%d", w, h)
+	}
+	if w, h := textarea.PreferredSize(); w != 12 || h != 2 {
+		t.Errorf("expected a preferred size of 12x2, got %dx%d", w, h)
+	}
+
+	textarea.SetSize(12, 2)
+	if textarea.Width() != 7 || textarea.Height() != 2 {
+		t.Errorf("expected an input of 7x2, got %dx%d", textarea.Width(), textarea.Height())
+	}
+}
+
+func TestStateRoundTrip(t *testing.T) {
+	textarea := newTextArea()
+	textarea.SetValue("first\nsecond line\nthird")
+	textarea.CursorUp()
+	textarea.SetCursor(3)
+
+	data, err := textarea.MarshalState()
+	if err != nil {
+		t.Fatal(err)
+	}
+	restored := newTextArea()
+	if err := restored.UnmarshalState(data); err != nil {
+		t.Fatal(err)
+	}
+	if got, exp := restored.State(), textarea.State(); got != exp {
+		t.Fatalf("expected %+v, got %+v", exp, got)
+	}
+	if restored.Line() != 1 {
+		t.Fatalf("expected the cursor on the second line, got %d", restored.Line())
+	}
+}
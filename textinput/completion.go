@@ -0,0 +1,136 @@
+package textinput
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// CompleteFunc is a function that returns the completions of the text before
+// the cursor. Every completion replaces the text before the cursor.
+type CompleteFunc func(text string) []string
+
+// Completions returns the completions found the last time tab was pressed, if
+// there was more than one. They're rendered below the input until the next
+// key is pressed.
+func (m Model) Completions() []string {
+	return m.completions
+}
+
+// complete completes the text before the cursor. A single completion is
+// inserted; otherwise the text the completions have in common is, and the
+// completions are kept to be shown.
+func (m *Model) complete() {
+	m.completions = nil
+	text := string(m.value[:m.pos])
+	completions := m.Complete(text)
+	if len(completions) == 0 {
+		return
+	}
+
+	prefix := completions[0]
+	for _, c := range completions[1:] {
+		prefix = commonPrefix(prefix, c)
+	}
+	if len(completions) > 1 {
+		m.completions = completions
+	}
+	if !strings.HasPrefix(prefix, text) || prefix == text {
+		return
+	}
+
+	tail := string(m.value[m.pos:])
+	m.SetValue(prefix + tail)
+	if m.Err == nil {
+		m.SetCursor(len([]rune(prefix)))
+	}
+}
+
+// completionsView renders the completions, without the text they have in
+// common up to the last path separator or space.
+func (m Model) completionsView() string {
+	prefix := m.completions[0]
+	for _, c := range m.completions[1:] {
+		prefix = commonPrefix(prefix, c)
+	}
+	cut := strings.LastIndexAny(prefix, " "+string(filepath.Separator)) + 1
+
+	labels := make([]string, len(m.completions))
+	for i, c := range m.completions {
+		labels[i] = c[cut:]
+	}
+	return m.CompletionStyle.Inline(true).Render(strings.Join(labels, "  "))
+}
+
+// commonPrefix returns the longest prefix two strings have in common.
+func commonPrefix(a, b string) string {
+	ra, rb := []rune(a), []rune(b)
+	n := 0
+	for n < len(ra) && n < len(rb) && ra[n] == rb[n] {
+		n++
+	}
+	return string(ra[:n])
+}
+
+// PathCompleter completes filesystem paths, like a shell does. It completes
+// the last word of the text, so it can be used for prompts that take a
+// command too. Directories are completed with a trailing separator and a
+// leading ~ stands for the home directory.
+//
+//	m.Complete = textinput.PathCompleter{}.Complete
+type PathCompleter struct {
+	// ShowHidden completes files starting with a dot. They're always
+	// completed if the word being completed starts with a dot.
+	ShowHidden bool
+}
+
+// Complete returns the paths the last word of the text completes to.
+func (c PathCompleter) Complete(text string) []string {
+	start := strings.LastIndex(text, " ") + 1
+	head, word := text[:start], text[start:]
+	dir, base := filepath.Split(word)
+
+	list := dir
+	if list == "" {
+		list = "."
+	}
+	if list == "~" || strings.HasPrefix(list, "~"+string(filepath.Separator)) {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return nil
+		}
+		list = home + list[1:]
+	}
+
+	files, err := ioutil.ReadDir(list)
+	if err != nil {
+		return nil
+	}
+
+	var completions []string
+	for _, f := range files {
+		name := f.Name()
+		if !strings.HasPrefix(name, base) {
+			continue
+		}
+		if strings.HasPrefix(name, ".") && !c.ShowHidden && !strings.HasPrefix(base, ".") {
+			continue
+		}
+		if isDir(filepath.Join(list, name), f) {
+			name += string(filepath.Separator)
+		}
+		completions = append(completions, head+dir+name)
+	}
+	return completions
+}
+
+// isDir returns whether a file is a directory, following symlinks.
+func isDir(path string, f os.FileInfo) bool {
+	if f.Mode()&os.ModeSymlink != 0 {
+		if target, err := os.Stat(path); err == nil {
+			return target.IsDir()
+		}
+	}
+	return f.IsDir()
+}
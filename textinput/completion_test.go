@@ -0,0 +1,84 @@
+package textinput
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func TestPathCompleter(t *testing.T) {
+	dir, err := ioutil.TempDir("", "textinput")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	for _, name := range []string{"notes.txt", "nested", ".hidden"} {
+		path := filepath.Join(dir, name)
+		if name == "nested" {
+			err = os.Mkdir(path, 0o700)
+		} else {
+			err = ioutil.WriteFile(path, nil, 0o600)
+		}
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	prefix := dir + string(filepath.Separator)
+
+	got := PathCompleter{}.Complete("cat " + prefix + "n")
+	want := []string{"cat " + prefix + "nested/", "cat " + prefix + "notes.txt"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %q, got %q", want, got)
+	}
+
+	if got := (PathCompleter{}).Complete(prefix); len(got) != 2 {
+		t.Errorf("expected hidden files to be skipped, got %q", got)
+	}
+	if got := (PathCompleter{ShowHidden: true}).Complete(prefix); len(got) != 3 {
+		t.Errorf("expected hidden files to be completed, got %q", got)
+	}
+	if got := (PathCompleter{}).Complete(prefix + "."); len(got) != 1 {
+		t.Errorf("expected hidden files to be completed after a dot, got %q", got)
+	}
+}
+
+func TestComplete(t *testing.T) {
+	m := New()
+	m.Prompt = ""
+	m.Focus()
+	m.Complete = func(text string) []string {
+		var matches []string
+		for _, c := range []string{"checkout", "cherry-pick", "commit"} {
+			if strings.HasPrefix(c, text) {
+				matches = append(matches, c)
+			}
+		}
+		return matches
+	}
+	tab := tea.KeyMsg{Type: tea.KeyTab}
+
+	m.SetValue("ch")
+	m.CursorEnd()
+	m, _ = m.Update(tab)
+	if m.Value() != "che" || len(m.Completions()) != 2 {
+		t.Fatalf("expected the common prefix and 2 completions, got %q and %q", m.Value(), m.Completions())
+	}
+	if lines := strings.Split(m.View(), "\n"); len(lines) != 2 || lines[1] != "checkout  cherry-pick" {
+		t.Errorf("expected the completions below the input, got %q", lines)
+	}
+
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	if len(m.Completions()) != 0 {
+		t.Errorf("expected the completions to be cleared, got %q", m.Completions())
+	}
+	m, _ = m.Update(tab)
+	if m.Value() != "checkout" || m.Position() != len("checkout") {
+		t.Errorf("expected the single completion to be inserted, got %q at %d", m.Value(), m.Position())
+	}
+}
@@ -0,0 +1,63 @@
+package textinput
+
+import (
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// DebouncedChangeMsg is sent when the value of a text input changed and
+// typing paused. See OnChangeDebounced.
+type DebouncedChangeMsg struct {
+	ID    int
+	Value string
+}
+
+// debounceMsg is sent when the time to wait for typing to pause is up.
+type debounceMsg struct {
+	id  int
+	tag int
+}
+
+// debounceState is the state of debouncing changes.
+type debounceState struct {
+	// The value the last time OnChangeDebounced was called.
+	value string
+
+	// The tag of the debounce message we're expecting to receive.
+	tag int
+}
+
+// OnChangeDebounced returns a command that sends a DebouncedChangeMsg once the
+// value hasn't changed for the given duration, so a search can run when
+// typing pauses instead of on every keystroke. Call it after every update;
+// it returns nil if the value didn't change since the last call, and every
+// change starts the wait over.
+//
+//	m.input, cmd = m.input.Update(msg)
+//	cmds = append(cmds, cmd, m.input.OnChangeDebounced(300*time.Millisecond))
+func (m *Model) OnChangeDebounced(d time.Duration) tea.Cmd {
+	value := m.Value()
+	if value == m.debounce.value {
+		return nil
+	}
+	m.debounce.value = value
+	m.debounce.tag++
+
+	id, tag := m.id, m.debounce.tag
+	return tea.Tick(d, func(time.Time) tea.Msg {
+		return debounceMsg{id: id, tag: tag}
+	})
+}
+
+// handleDebounce sends a DebouncedChangeMsg if no change was made since the
+// given debounce message was scheduled.
+func (m Model) handleDebounce(msg debounceMsg) tea.Cmd {
+	if msg.id != m.id || msg.tag != m.debounce.tag {
+		return nil
+	}
+	value := m.debounce.value
+	return func() tea.Msg {
+		return DebouncedChangeMsg{ID: m.id, Value: value}
+	}
+}
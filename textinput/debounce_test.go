@@ -0,0 +1,43 @@
+package textinput
+
+import (
+	"testing"
+	"time"
+)
+
+func TestOnChangeDebounced(t *testing.T) {
+	m := New()
+	m.Focus()
+
+	if cmd := m.OnChangeDebounced(time.Millisecond); cmd != nil {
+		t.Fatal("expected no command without a change")
+	}
+
+	m = typeRunes(m, "a")
+	first := m.OnChangeDebounced(time.Millisecond)
+	if cmd := m.OnChangeDebounced(time.Millisecond); cmd != nil {
+		t.Fatal("expected no command when the value didn't change again")
+	}
+	m = typeRunes(m, "b")
+	second := m.OnChangeDebounced(time.Millisecond)
+
+	// The first wait was overtaken by typing.
+	if _, cmd := m.Update(first()); cmd != nil {
+		t.Fatal("expected the stale debounce to be dropped")
+	}
+
+	_, cmd := m.Update(second())
+	if cmd == nil {
+		t.Fatal("expected a debounced change")
+	}
+	msg, ok := cmd().(DebouncedChangeMsg)
+	if !ok || msg.Value != "ab" || msg.ID != m.ID() {
+		t.Fatalf("expected a debounced change to ab, got %#v", msg)
+	}
+
+	// Another text input ignores it.
+	other := New()
+	if _, cmd := other.Update(second()); cmd != nil {
+		t.Fatal("expected another text input to ignore the debounce")
+	}
+}
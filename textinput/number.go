@@ -0,0 +1,127 @@
+package textinput
+
+import (
+	"strings"
+	"unicode"
+)
+
+// NumberFormat formats numbers as they're entered, inserting group
+// separators between the thousands. With a NumberFormat set the input only
+// accepts digits, a leading minus sign and a decimal separator, and Value
+// returns the raw number, such as "-1234.5", which strconv can parse.
+type NumberFormat struct {
+	Group   rune
+	Decimal rune
+}
+
+// Number formats of common locales.
+var (
+	NumberFormatEnglish = NumberFormat{Group: ',', Decimal: '.'}
+	NumberFormatGerman  = NumberFormat{Group: '.', Decimal: ','}
+	NumberFormatFrench  = NumberFormat{Group: ' ', Decimal: ','}
+	NumberFormatSwiss   = NumberFormat{Group: '\'', Decimal: '.'}
+)
+
+// localeNumberFormats are the number formats of locales, by language and by
+// language and region.
+var localeNumberFormats = map[string]NumberFormat{
+	"en": NumberFormatEnglish, "ja": NumberFormatEnglish, "ko": NumberFormatEnglish,
+	"zh": NumberFormatEnglish, "he": NumberFormatEnglish, "th": NumberFormatEnglish,
+	"de": NumberFormatGerman, "es": NumberFormatGerman, "it": NumberFormatGerman,
+	"nl": NumberFormatGerman, "pt": NumberFormatGerman, "da": NumberFormatGerman,
+	"id": NumberFormatGerman, "tr": NumberFormatGerman, "el": NumberFormatGerman,
+	"fr": NumberFormatFrench, "ru": NumberFormatFrench, "pl": NumberFormatFrench,
+	"cs": NumberFormatFrench, "sv": NumberFormatFrench, "fi": NumberFormatFrench,
+	"nb": NumberFormatFrench, "uk": NumberFormatFrench, "hu": NumberFormatFrench,
+	"de-ch": NumberFormatSwiss, "it-ch": NumberFormatSwiss, "fr-ch": NumberFormatSwiss,
+	"pt-br": NumberFormatGerman, "es-mx": NumberFormatEnglish, "en-za": NumberFormatFrench,
+}
+
+// NumberFormatForLocale returns the number format of a locale, such as "de",
+// "de-CH" or "de_CH.UTF-8" as found in the LANG environment variable. Unknown
+// locales are formatted like English.
+func NumberFormatForLocale(locale string) NumberFormat {
+	locale = strings.ToLower(locale)
+	if i := strings.IndexAny(locale, ".@"); i >= 0 {
+		locale = locale[:i]
+	}
+	locale = strings.ReplaceAll(locale, "_", "-")
+
+	if f, ok := localeNumberFormats[locale]; ok {
+		return f
+	}
+	if i := strings.Index(locale, "-"); i >= 0 {
+		if f, ok := localeNumberFormats[locale[:i]]; ok {
+			return f
+		}
+	}
+	return NumberFormatEnglish
+}
+
+// raw returns the number entered in the given runes: the digits, a leading
+// minus sign and a dot for the first decimal separator. Anything else is
+// dropped. Every rune of the result stands for a rune that isn't a group
+// separator in the formatted number, in the same order.
+func (f NumberFormat) raw(runes []rune) []rune {
+	var (
+		raw     = make([]rune, 0, len(runes))
+		decimal bool
+	)
+	for _, r := range runes {
+		switch {
+		case unicode.IsDigit(r):
+			raw = append(raw, r)
+		case r == '-' && len(raw) == 0:
+			raw = append(raw, r)
+		case (r == f.Decimal || r == '.' && f.Group != '.') && !decimal:
+			decimal = true
+			raw = append(raw, '.')
+		}
+	}
+	return raw
+}
+
+// format formats a raw number.
+func (f NumberFormat) format(raw []rune) []rune {
+	var sign []rune
+	if len(raw) > 0 && raw[0] == '-' {
+		sign, raw = raw[:1], raw[1:]
+	}
+	integer, fraction := raw, []rune(nil)
+	for i, r := range raw {
+		if r == '.' {
+			integer, fraction = raw[:i], raw[i+1:]
+			fraction = append([]rune{f.Decimal}, fraction...)
+			break
+		}
+	}
+
+	formatted := append([]rune{}, sign...)
+	for i, r := range integer {
+		if i > 0 && (len(integer)-i)%3 == 0 {
+			formatted = append(formatted, f.Group)
+		}
+		formatted = append(formatted, r)
+	}
+	return append(formatted, fraction...)
+}
+
+// formatNumber formats the value with the NumberFormat, if it's set, keeping
+// the cursor after the same digit.
+func (m *Model) formatNumber() {
+	if m.NumberFormat == nil {
+		return
+	}
+	f := *m.NumberFormat
+
+	before := len(f.raw(m.value[:m.pos]))
+	m.value = f.format(f.raw(m.value))
+
+	pos := 0
+	for n := 0; pos < len(m.value) && n < before; pos++ {
+		if m.value[pos] != f.Group {
+			n++
+		}
+	}
+	m.SetCursor(pos)
+}
@@ -0,0 +1,74 @@
+package textinput
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func typeRunes(m Model, s string) Model {
+	for _, r := range s {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+	return m
+}
+
+func TestNumberFormatForLocale(t *testing.T) {
+	tests := map[string]NumberFormat{
+		"en_US.UTF-8": NumberFormatEnglish,
+		"de":          NumberFormatGerman,
+		"de_CH.UTF-8": NumberFormatSwiss,
+		"fr-CA":       NumberFormatFrench,
+		"xx":          NumberFormatEnglish,
+	}
+	for locale, want := range tests {
+		if got := NumberFormatForLocale(locale); got != want {
+			t.Errorf("%s: expected %q, got %q", locale, want, got)
+		}
+	}
+}
+
+func TestNumberFormat(t *testing.T) {
+	m := New()
+	m.Prompt = ""
+	m.Prefix = "$"
+	m.Suffix = " USD"
+	m.NumberFormat = &NumberFormatEnglish
+	m.Focus()
+
+	m = typeRunes(m, "-1234a567.5.")
+	if m.Value() != "-1234567.5" {
+		t.Fatalf("expected the raw number, got %q", m.Value())
+	}
+	m.Cursor.Blink = true
+	if v := m.View(); v != "$-1,234,567.5  USD" {
+		t.Fatalf("expected the formatted number between the affixes, got %q", v)
+	}
+
+	// Editing in the middle keeps the cursor after the same digit.
+	m.SetCursor(3) // after "-1,"
+	m = typeRunes(m, "0")
+	if string(m.value) != "-10,234,567.5" || m.Position() != 3 {
+		t.Fatalf("expected the digit to be inserted, got %q with the cursor at %d", string(m.value), m.Position())
+	}
+	m, _ = m.Update(tea.KeyMsg{Type: tea.KeyBackspace})
+	if string(m.value) != "-1,234,567.5" || m.Position() != 2 {
+		t.Fatalf("expected the digit to be deleted, got %q with the cursor at %d", string(m.value), m.Position())
+	}
+}
+
+func TestNumberFormatGerman(t *testing.T) {
+	m := New()
+	m.NumberFormat = &NumberFormatGerman
+	m.SetValue("1234567.25")
+	if string(m.value) != "1.234.567,25" || m.Value() != "1234567.25" {
+		t.Fatalf("expected 1.234.567,25, got %q (%q)", string(m.value), m.Value())
+	}
+
+	m.Focus()
+	m.Reset()
+	m = typeRunes(m, "1.234,5")
+	if string(m.value) != "1.234,5" || m.Value() != "1234.5" {
+		t.Fatalf("expected 1.234,5, got %q (%q)", string(m.value), m.Value())
+	}
+}
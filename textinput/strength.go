@@ -0,0 +1,171 @@
+package textinput
+
+import (
+	"math"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/i18n"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// defaultMeterWidth is the width of the strength meter if the input has no
+// Width.
+const defaultMeterWidth = 20
+
+// StrengthFunc is a function that scores a password.
+type StrengthFunc func(password string) Strength
+
+// Strength is the score of a password.
+type Strength struct {
+	// Score goes from 0, the weakest, to 1, the strongest.
+	Score float64
+
+	// Rules are the rules the password was checked against, rendered as a
+	// checklist below the meter.
+	Rules []StrengthRule
+}
+
+// StrengthRule is a rule a password was checked against.
+type StrengthRule struct {
+	Name   string
+	Passed bool
+}
+
+// Label returns a word describing the strength: "weak", "fair" or "strong".
+func (s Strength) Label() string {
+	switch {
+	case s.Score < 0.4: //nolint:gomnd
+		return "weak"
+	case s.Score < 0.8: //nolint:gomnd
+		return "fair"
+	default:
+		return "strong"
+	}
+}
+
+// DefaultStrength scores a password by the classes of characters it contains
+// and whether it's at least eight characters long. Every rule passed adds the
+// same amount to the score.
+func DefaultStrength(password string) Strength {
+	var lower, upper, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsDigit(r):
+			digit = true
+		case !unicode.IsSpace(r):
+			symbol = true
+		}
+	}
+
+	rules := []StrengthRule{
+		{Name: i18n.Text("at least 8 characters"), Passed: len([]rune(password)) >= 8}, //nolint:gomnd
+		{Name: i18n.Text("a lowercase letter"), Passed: lower},
+		{Name: i18n.Text("an uppercase letter"), Passed: upper},
+		{Name: i18n.Text("a digit"), Passed: digit},
+		{Name: i18n.Text("a symbol"), Passed: symbol},
+	}
+
+	var passed int
+	for _, r := range rules {
+		if r.Passed {
+			passed++
+		}
+	}
+	return Strength{
+		Score: float64(passed) / float64(len(rules)),
+		Rules: rules,
+	}
+}
+
+// StrengthStyles defines the styles of the strength meter.
+type StrengthStyles struct {
+	// The filled part of the meter, by strength.
+	Weak   lipgloss.Style
+	Fair   lipgloss.Style
+	Strong lipgloss.Style
+
+	// The empty part of the meter.
+	Empty lipgloss.Style
+
+	// The checklist of rules.
+	RulePassed lipgloss.Style
+	RuleFailed lipgloss.Style
+}
+
+// DefaultStrengthStyles returns the default styles of the strength meter.
+func DefaultStrengthStyles() StrengthStyles {
+	return StrengthStyles{
+		Weak:       lipgloss.NewStyle().Foreground(lipgloss.Color("9")),
+		Fair:       lipgloss.NewStyle().Foreground(lipgloss.Color("11")),
+		Strong:     lipgloss.NewStyle().Foreground(lipgloss.Color("10")),
+		Empty:      lipgloss.NewStyle().Foreground(lipgloss.Color("240")),
+		RulePassed: lipgloss.NewStyle().Foreground(lipgloss.Color("10")),
+		RuleFailed: lipgloss.NewStyle().Foreground(lipgloss.Color("240")),
+	}
+}
+
+// NewPassword creates a new model for entering a password: the input is
+// masked and rendered with a strength meter scored by DefaultStrength.
+func NewPassword() Model {
+	m := New()
+	m.EchoMode = EchoPassword
+	m.Strength = DefaultStrength
+	return m
+}
+
+// PasswordStrength returns the score of the value. It's empty if the
+// Strength function isn't set.
+func (m Model) PasswordStrength() Strength {
+	if m.Strength == nil {
+		return Strength{}
+	}
+	return m.Strength(string(m.value))
+}
+
+// strengthView renders the strength meter and the checklist of rules.
+func (m Model) strengthView() string {
+	var (
+		s      = m.StrengthStyles
+		st     = m.PasswordStrength()
+		width  = defaultMeterWidth
+		filled lipgloss.Style
+	)
+	if m.Width > 0 {
+		width = m.Width
+	}
+	switch st.Label() {
+	case "weak":
+		filled = s.Weak
+	case "fair":
+		filled = s.Fair
+	default:
+		filled = s.Strong
+	}
+
+	n := int(math.Round(math.Max(0, math.Min(1, st.Score)) * float64(width)))
+	if len(m.value) == 0 {
+		n = 0
+	}
+
+	var b strings.Builder
+	b.WriteString(filled.Inline(true).Render(strings.Repeat("█", n)))
+	b.WriteString(s.Empty.Inline(true).Render(strings.Repeat("░", width-n)))
+	if len(m.value) > 0 {
+		b.WriteString(" " + filled.Inline(true).Render(st.Label()))
+	}
+
+	for _, r := range st.Rules {
+		b.WriteString("\n")
+		if r.Passed {
+			b.WriteString(s.RulePassed.Inline(true).Render("✓ " + r.Name))
+		} else {
+			b.WriteString(s.RuleFailed.Inline(true).Render("✗ " + r.Name))
+		}
+	}
+	return b.String()
+}
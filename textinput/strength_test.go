@@ -0,0 +1,45 @@
+package textinput
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestDefaultStrength(t *testing.T) {
+	tests := []struct {
+		password string
+		score    float64
+		label    string
+	}{
+		{"abc", 0.2, "weak"},
+		{"abcdefgh1", 0.6, "fair"},
+		{"Abcdefg1!", 1, "strong"},
+	}
+	for _, tt := range tests {
+		s := DefaultStrength(tt.password)
+		if s.Score != tt.score || s.Label() != tt.label {
+			t.Errorf("%q: expected %v (%s), got %v (%s)", tt.password, tt.score, tt.label, s.Score, s.Label())
+		}
+	}
+}
+
+func TestPasswordView(t *testing.T) {
+	m := NewPassword()
+	m.Prompt = ""
+	m.Width = 10
+	m.SetValue("abcdefgh1")
+
+	lines := strings.Split(m.View(), "\n")
+	if len(lines) != 7 {
+		t.Fatalf("expected the input, the meter and 5 rules, got %q", lines)
+	}
+	if !strings.HasPrefix(lines[0], "*********") || strings.Contains(lines[0], "abc") {
+		t.Errorf("expected the input to be masked, got %q", lines[0])
+	}
+	if lines[1] != "██████░░░░ fair" {
+		t.Errorf("expected a fair meter, got %q", lines[1])
+	}
+	if lines[2] != "✓ at least 8 characters" || lines[4] != "✗ an uppercase letter" {
+		t.Errorf("unexpected checklist %q", lines[2:])
+	}
+}
@@ -2,6 +2,7 @@ package textinput
 
 import (
 	"strings"
+	"sync"
 	"time"
 	"unicode"
 
@@ -12,6 +13,21 @@ import (
 	rw "github.com/mattn/go-runewidth"
 )
 
+// Internal ID management. Used to ensure that debounce messages are received
+// only by text inputs that sent them.
+var (
+	lastID int
+	idMtx  sync.Mutex
+)
+
+// Return the next ID we should use on the Model.
+func nextID() int {
+	idMtx.Lock()
+	defer idMtx.Unlock()
+	lastID++
+	return lastID
+}
+
 // Internal messages for clipboard operations.
 type pasteMsg string
 type pasteErrMsg struct{ error }
@@ -34,6 +50,24 @@ const (
 	// EchoOnEdit.
 )
 
+// CursorShape describes what the cursor looks like. Set it with the Shape
+// field of the Cursor.
+type CursorShape = cursor.Shape
+
+// Available cursor shapes.
+const (
+	// CursorBlock renders the character under the cursor in reverse video.
+	// This is the default shape.
+	CursorBlock = cursor.ShapeBlock
+
+	// CursorUnderline underlines the character under the cursor.
+	CursorUnderline = cursor.ShapeUnderline
+
+	// CursorBar renders a thin bar in place of the character under the
+	// cursor.
+	CursorBar = cursor.ShapeBar
+)
+
 // ValidateFunc is a function that returns an error if the input is invalid.
 type ValidateFunc func(string) error
 
@@ -68,9 +102,16 @@ type Model struct {
 
 	// Width is the maximum number of characters that can be displayed at once.
 	// It essentially treats the text field like a horizontally scrolling
-	// viewport. If 0 or less this setting is ignored.
+	// viewport. If 0 or less this setting is ignored. It can be changed at any
+	// time; the visible area follows the cursor.
 	Width int
 
+	// ShowOverflow shows ‹ and › at the edges of the input when Width is set
+	// and the value overflows to the left or the right. The left indicator
+	// takes up a cell of Width, the right one the cell after it.
+	ShowOverflow  bool
+	OverflowStyle lipgloss.Style
+
 	// Underlying text value.
 	value []rune
 
@@ -91,6 +132,35 @@ type Model struct {
 	// error returned by the function. If the function is not defined, all
 	// input is considered valid.
 	Validate ValidateFunc
+
+	// Strength is a function that scores the value as a password. If it's
+	// set, a strength meter and the rules the value passes are rendered below
+	// the input. See NewPassword.
+	Strength       StrengthFunc
+	StrengthStyles StrengthStyles
+
+	// Complete is a function that completes the text before the cursor when
+	// tab is pressed. If there's more than one completion, they're rendered
+	// below the input with CompletionStyle. See PathCompleter.
+	Complete        CompleteFunc
+	CompletionStyle lipgloss.Style
+
+	// The completions found the last time tab was pressed.
+	completions []string
+
+	// Prefix and Suffix are rendered before and after the value, such as a
+	// currency or a unit. They aren't part of the value and can't be edited.
+	Prefix     string
+	Suffix     string
+	AffixStyle lipgloss.Style
+
+	// NumberFormat, if set, restricts the input to numbers and formats them
+	// as they're entered. See NumberFormatForLocale.
+	NumberFormat *NumberFormat
+
+	// The ID of this text input, and the state of debouncing changes.
+	id       int
+	debounce debounceState
 }
 
 // New creates a new model with default settings.
@@ -100,7 +170,10 @@ func New() Model {
 		EchoCharacter:    '*',
 		CharLimit:        0,
 		PlaceholderStyle: lipgloss.NewStyle().Foreground(lipgloss.Color("240")),
+		CompletionStyle:  lipgloss.NewStyle().Foreground(lipgloss.Color("240")),
 		Cursor:           cursor.New(),
+		StrengthStyles:   DefaultStrengthStyles(),
+		id:               nextID(),
 
 		value: nil,
 		focus: false,
@@ -113,10 +186,24 @@ func New() Model {
 // Deprecated. Use New instead.
 var NewModel = New
 
-// SetValue sets the value of the text input.
+// SetValue sets the value of the text input. If a NumberFormat is set, it
+// takes a raw number, as returned by Value.
 func (m *Model) SetValue(s string) {
+	if f := m.NumberFormat; f != nil {
+		s = string(f.format(NumberFormat{Decimal: '.'}.raw([]rune(s))))
+	}
+	m.setValue(s)
+	m.formatNumber()
+}
+
+// setValue sets the value of the text input, without formatting it.
+func (m *Model) setValue(s string) {
 	if m.Validate != nil {
-		if err := m.Validate(s); err != nil {
+		v := s
+		if m.NumberFormat != nil {
+			v = string(m.NumberFormat.raw([]rune(s)))
+		}
+		if err := m.Validate(v); err != nil {
 			m.Err = err
 			return
 		}
@@ -136,8 +223,17 @@ func (m *Model) SetValue(s string) {
 	m.handleOverflow()
 }
 
-// Value returns the value of the text input.
+// ID returns the text input's unique ID.
+func (m Model) ID() int {
+	return m.id
+}
+
+// Value returns the value of the text input. If a NumberFormat is set, it's
+// the raw number, without the formatting.
 func (m Model) Value() string {
+	if m.NumberFormat != nil {
+		return string(m.NumberFormat.raw(m.value))
+	}
 	return string(m.value)
 }
 
@@ -185,6 +281,7 @@ func (m *Model) Blur() {
 // Reset sets the input to its default state with no input.
 func (m *Model) Reset() {
 	m.value = nil
+	m.completions = nil
 	m.SetCursor(0)
 }
 
@@ -230,7 +327,7 @@ func (m *Model) handlePaste(v string) {
 
 	// Put it all back together
 	value := append(head, tail...)
-	m.SetValue(string(value))
+	m.setValue(string(value))
 
 	if m.Err != nil {
 		m.pos = oldPos
@@ -248,40 +345,70 @@ func (m *Model) handleOverflow() {
 		return
 	}
 
-	// Correct right offset if we've deleted characters
+	// Correct the offsets if we've deleted characters
+	m.offset = min(m.offset, len(m.value))
 	m.offsetRight = min(m.offsetRight, len(m.value))
 
 	if m.pos < m.offset {
+		// Scroll left so the visible area starts at the cursor
 		m.offset = m.pos
+		m.offsetRight = max(m.fitRight(m.offset), min(m.pos+1, len(m.value)))
+		return
+	}
 
-		w := 0
-		i := 0
-		runes := m.value[m.offset:]
+	// Fill the visible area from where it starts, which makes up for changes
+	// to the width, and pull it left if there's room at the end
+	m.offsetRight = m.fitRight(m.offset)
+	if m.offsetRight == len(m.value) {
+		m.offset = min(m.offset, m.fitLeft(m.offsetRight))
+	}
 
-		for i < len(runes) && w <= m.Width {
-			w += rw.RuneWidth(runes[i])
-			if w <= m.Width+1 {
-				i++
-			}
-		}
+	if m.pos >= m.offsetRight && m.offsetRight < len(m.value) {
+		// Scroll right so the visible area ends at the cursor
+		m.offsetRight = min(m.pos+1, len(m.value))
+		m.offset = min(m.pos, m.fitLeft(m.offsetRight))
+	}
+}
 
-		m.offsetRight = m.offset + i
-	} else if m.pos >= m.offsetRight {
-		m.offsetRight = m.pos
+// fitRight returns where the visible area ends if it starts at the given
+// position.
+func (m Model) fitRight(start int) int {
+	width := m.Width
+	if m.ShowOverflow && start > 0 {
+		width-- // room for the left indicator
+	}
 
-		w := 0
-		runes := m.value[:m.offsetRight]
-		i := len(runes) - 1
+	end := start
+	for end < len(m.value) {
+		width -= rw.RuneWidth(m.value[end])
+		if width < 0 {
+			break
+		}
+		end++
+	}
+	return end
+}
 
-		for i > 0 && w < m.Width {
-			w += rw.RuneWidth(runes[i])
-			if w <= m.Width {
-				i--
+// fitLeft returns where the visible area starts if it ends at the given
+// position.
+func (m Model) fitLeft(end int) int {
+	scan := func(width int) int {
+		start := end
+		for start > 0 {
+			width -= rw.RuneWidth(m.value[start-1])
+			if width < 0 {
+				break
 			}
+			start--
 		}
+		return start
+	}
 
-		m.offset = m.offsetRight - (len(runes) - 1 - i)
+	start := scan(m.Width)
+	if m.ShowOverflow && start > 0 {
+		start = scan(m.Width - 1) // room for the left indicator
 	}
+	return start
 }
 
 // deleteBeforeCursor deletes all text before the cursor.
@@ -462,6 +589,10 @@ func (m Model) echoTransform(v string) string {
 
 // Update is the Bubble Tea update loop.
 func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if msg, ok := msg.(debounceMsg); ok {
+		return m, m.handleDebounce(msg)
+	}
+
 	if !m.focus {
 		return m, nil
 	}
@@ -472,7 +603,15 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.KeyMsg:
+		if msg.Type != tea.KeyTab {
+			m.completions = nil
+		}
+
 		switch msg.Type {
+		case tea.KeyTab: // complete the text before the cursor
+			if m.Complete != nil {
+				m.complete()
+			}
 		case tea.KeyBackspace, tea.KeyCtrlH: // delete character before cursor
 			m.Err = nil
 
@@ -541,7 +680,7 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 				value := make([]rune, len(m.value))
 				copy(value, m.value)
 				value = append(value[:m.pos], append(runes, value[m.pos:]...)...)
-				m.SetValue(string(value))
+				m.setValue(string(value))
 				if m.Err == nil {
 					m.SetCursor(m.pos + len(runes))
 				}
@@ -566,22 +705,50 @@ func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
 		cmds = append(cmds, m.Cursor.BlinkCmd())
 	}
 
+	m.formatNumber()
 	m.handleOverflow()
 	return m, tea.Batch(cmds...)
 }
 
 // View renders the textinput in its current state.
 func (m Model) View() string {
+	v := m.inputView()
+	if len(m.completions) > 0 {
+		v += "\n" + m.completionsView()
+	}
+	if m.Strength != nil {
+		v += "\n" + m.strengthView()
+	}
+	return v
+}
+
+// inputView renders the input line.
+func (m Model) inputView() string {
 	// Placeholder text
 	if len(m.value) == 0 && m.Placeholder != "" {
 		return m.placeholderView()
 	}
 
+	// The width may have changed since the last update
+	m.handleOverflow()
+
 	styleText := m.TextStyle.Inline(true).Render
 
 	value := m.value[m.offset:m.offsetRight]
 	pos := max(0, m.pos-m.offset)
-	v := styleText(m.echoTransform(string(value[:pos])))
+
+	var left, right string
+	if m.ShowOverflow && m.Width > 0 {
+		overflowStyle := m.OverflowStyle.Inline(true).Render
+		if m.offset > 0 {
+			left = overflowStyle("‹")
+		}
+		if m.offsetRight < len(m.value) {
+			right = overflowStyle("›")
+		}
+	}
+
+	v := left + styleText(m.echoTransform(string(value[:pos])))
 
 	if pos < len(value) {
 		char := m.echoTransform(string(value[pos]))
@@ -594,17 +761,31 @@ func (m Model) View() string {
 	}
 
 	// If a max width and background color were set fill the empty spaces with
-	// the background color.
-	valWidth := rw.StringWidth(string(value))
-	if m.Width > 0 && valWidth <= m.Width {
-		padding := max(0, m.Width-valWidth)
-		if valWidth+padding <= m.Width && pos < len(value) {
-			padding++
-		}
-		v += styleText(strings.Repeat(" ", padding))
+	// the background color. The input is a cell wider than Width to make room
+	// for the cursor at the end of the value.
+	var padding int
+	if m.Width > 0 {
+		padding = max(0, m.Width+1-lipgloss.Width(v)-lipgloss.Width(right))
 	}
+	v += m.suffixView() + styleText(strings.Repeat(" ", padding))
 
-	return m.PromptStyle.Render(m.Prompt) + v
+	return m.PromptStyle.Render(m.Prompt) + m.prefixView() + v + right
+}
+
+// prefixView renders the prefix, if any.
+func (m Model) prefixView() string {
+	if m.Prefix == "" {
+		return ""
+	}
+	return m.AffixStyle.Inline(true).Render(m.Prefix)
+}
+
+// suffixView renders the suffix, if any.
+func (m Model) suffixView() string {
+	if m.Suffix == "" {
+		return ""
+	}
+	return m.AffixStyle.Inline(true).Render(m.Suffix)
 }
 
 // placeholderView returns the prompt and placeholder view, if any.
@@ -622,7 +803,7 @@ func (m Model) placeholderView() string {
 	// The rest of the placeholder text
 	v += style(p[1:])
 
-	return m.PromptStyle.Render(m.Prompt) + v
+	return m.PromptStyle.Render(m.Prompt) + m.prefixView() + v + m.suffixView()
 }
 
 // Blink is a command used to initialize cursor blinking.
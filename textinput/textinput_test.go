@@ -0,0 +1,80 @@
+package textinput
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func newOverflowInput(width int, value string) Model {
+	m := New()
+	m.Prompt = ""
+	m.Width = width
+	m.SetValue(value)
+	m.CursorEnd()
+	return m
+}
+
+func TestOverflowWideRunes(t *testing.T) {
+	m := newOverflowInput(5, "你好世界abc")
+
+	v := m.View()
+	if w := lipgloss.Width(v); w != m.Width+1 {
+		t.Fatalf("expected the view to be %d cells wide, got %d: %q", m.Width+1, w, v)
+	}
+	if !strings.HasPrefix(v, "界abc") {
+		t.Fatalf("expected the end of the value to be visible, got %q", v)
+	}
+
+	m.CursorStart()
+	v = m.View()
+	if w := lipgloss.Width(v); w != m.Width+1 {
+		t.Fatalf("expected the view to be %d cells wide, got %d: %q", m.Width+1, w, v)
+	}
+	if !strings.HasPrefix(v, "你好") {
+		t.Fatalf("expected the start of the value to be visible, got %q", v)
+	}
+}
+
+func TestOverflowIndicators(t *testing.T) {
+	m := newOverflowInput(4, "abcdefgh")
+	m.ShowOverflow = true
+
+	if v := m.View(); v != "‹fgh " {
+		t.Fatalf("expected the left indicator, got %q", v)
+	}
+
+	m.SetCursor(2)
+	if v := m.View(); v != "‹cde›" {
+		t.Fatalf("expected both indicators, got %q", v)
+	}
+
+	m.CursorStart()
+	if v := m.View(); v != "abcd›" {
+		t.Fatalf("expected the right indicator, got %q", v)
+	}
+}
+
+func TestOverflowWidthChange(t *testing.T) {
+	m := newOverflowInput(4, "abcdefgh")
+	if v := m.View(); v != "efgh " {
+		t.Fatalf("expected the end of the value, got %q", v)
+	}
+
+	m.Width = 10
+	if v := m.View(); v != "abcdefgh   " {
+		t.Fatalf("expected the whole value after widening, got %q", v)
+	}
+}
+
+func TestCursorShapeBar(t *testing.T) {
+	m := newOverflowInput(0, "ab")
+	m.Cursor.Shape = CursorBar
+	m.Cursor.Blink = false
+	m.CursorStart()
+
+	if v := m.View(); v != "▏b" {
+		t.Fatalf("expected a bar cursor, got %q", v)
+	}
+}
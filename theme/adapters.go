@@ -0,0 +1,73 @@
+package theme
+
+import (
+	"github.com/charmbracelet/bubbles/calendar"
+	"github.com/charmbracelet/bubbles/help"
+	"github.com/charmbracelet/bubbles/progress"
+	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// TableStyles returns table styles derived from the theme.
+func (t Theme) TableStyles() table.Styles {
+	s := table.DefaultStyles()
+	s.Header = s.Header.Copy().
+		Foreground(t.Text).
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(t.Muted).
+		BorderBottom(true)
+	s.Cell = s.Cell.Copy().Foreground(t.Text)
+	s.Selected = s.Selected.Copy().
+		Foreground(t.OnSurface).
+		Background(t.Primary)
+	return s
+}
+
+// SpinnerStyle returns a spinner style derived from the theme.
+func (t Theme) SpinnerStyle() lipgloss.Style {
+	return lipgloss.NewStyle().Foreground(t.Accent)
+}
+
+// ProgressOption returns a progress option that fills the bar with a gradient
+// from the primary to the accent color of the theme.
+func (t Theme) ProgressOption() progress.Option {
+	return func(m *progress.Model) {
+		progress.WithGradient(Hex(t.Primary), Hex(t.Accent))(m)
+		m.EmptyColor = Hex(t.Muted)
+		m.PercentageStyle = lipgloss.NewStyle().Foreground(t.Subtle)
+	}
+}
+
+// HelpStyles returns help styles derived from the theme.
+func (t Theme) HelpStyles() help.Styles {
+	keyStyle := lipgloss.NewStyle().Foreground(t.Subtle)
+	descStyle := lipgloss.NewStyle().Foreground(t.Muted)
+	sepStyle := lipgloss.NewStyle().Foreground(t.Muted)
+
+	return help.Styles{
+		ShortKey:       keyStyle,
+		ShortDesc:      descStyle,
+		ShortSeparator: sepStyle,
+		Ellipsis:       sepStyle.Copy(),
+		FullKey:        keyStyle.Copy(),
+		FullDesc:       descStyle.Copy(),
+		FullSeparator:  sepStyle.Copy(),
+	}
+}
+
+// CalendarStyles returns calendar styles derived from the theme.
+func (t Theme) CalendarStyles() calendar.Styles {
+	s := calendar.DefaultStyles()
+	s.Title = s.Title.Copy().Foreground(t.Text)
+	s.Weekday = s.Weekday.Copy().Foreground(t.Subtle)
+	s.Day = s.Day.Copy().Foreground(t.Text)
+	s.Outside = s.Outside.Copy().Foreground(t.Muted)
+	s.Today = s.Today.Copy().Foreground(t.Accent)
+	s.Selected = lipgloss.NewStyle().Foreground(t.OnSurface).Background(t.Primary)
+	s.Marker = s.Marker.Copy().Foreground(t.Accent)
+	s.AgendaTitle = s.AgendaTitle.Copy().Foreground(t.Primary)
+	s.AgendaTime = s.AgendaTime.Copy().Foreground(t.Subtle)
+	s.AgendaEvent = s.AgendaEvent.Copy().Foreground(t.Text)
+	s.AgendaNoItems = s.AgendaNoItems.Copy().Foreground(t.Subtle)
+	return s
+}
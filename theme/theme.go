@@ -0,0 +1,138 @@
+// Package theme provides a shared color palette for Bubbles components. A
+// single Theme value can be used to derive the styles of tables, spinners,
+// progress bars, help views and so on, so an application only needs to pick
+// its colors once:
+//
+//	t := theme.Default
+//	tbl := table.New(table.WithStyles(t.TableStyles()))
+//	sp := spinner.New(spinner.WithStyle(t.SpinnerStyle()))
+//	bar := progress.New(t.ProgressOption())
+//
+// Colors are lipgloss.AdaptiveColors, so a theme can carry different values
+// for light and dark terminal backgrounds. See Adaptive for combining a light
+// and a dark theme into one.
+package theme
+
+import "github.com/charmbracelet/lipgloss"
+
+// Theme is a palette of semantic colors.
+type Theme struct {
+	// Primary is the main brand color. It's used for selections, active
+	// elements and the like.
+	Primary lipgloss.AdaptiveColor
+
+	// Accent complements Primary. It's used for highlights such as filter
+	// matches, markers and gradients.
+	Accent lipgloss.AdaptiveColor
+
+	// Surface is the background used for elevated areas such as title bars
+	// and selected rows.
+	Surface lipgloss.AdaptiveColor
+
+	// OnSurface is the foreground color used on top of Surface.
+	OnSurface lipgloss.AdaptiveColor
+
+	// Text is the regular foreground color.
+	Text lipgloss.AdaptiveColor
+
+	// Subtle is used for secondary text such as descriptions and help.
+	Subtle lipgloss.AdaptiveColor
+
+	// Muted is used for separators, borders and other very low emphasis
+	// elements.
+	Muted lipgloss.AdaptiveColor
+
+	Error   lipgloss.AdaptiveColor
+	Warning lipgloss.AdaptiveColor
+	Success lipgloss.AdaptiveColor
+}
+
+// Some themes to choose from. You could also make your own.
+var (
+	// Dark is a theme for terminals with dark backgrounds.
+	Dark = fixed(palette{
+		primary:   "#AD58B4",
+		accent:    "#EE6FF8",
+		surface:   "#3C3C3C",
+		onSurface: "#FFFDF5",
+		text:      "#DDDDDD",
+		subtle:    "#777777",
+		muted:     "#3C3C3C",
+		error:     "#FF5F87",
+		warning:   "#FFAF00",
+		success:   "#04B575",
+	})
+
+	// Light is a theme for terminals with light backgrounds.
+	Light = fixed(palette{
+		primary:   "#874BFD",
+		accent:    "#EE6FF8",
+		surface:   "#DDDADA",
+		onSurface: "#1A1A1A",
+		text:      "#1A1A1A",
+		subtle:    "#A49FA5",
+		muted:     "#DDDADA",
+		error:     "#D70000",
+		warning:   "#D78700",
+		success:   "#02A66A",
+	})
+
+	// Default adapts to the terminal background, using Light on light
+	// backgrounds and Dark on dark ones.
+	Default = Adaptive(Light, Dark)
+)
+
+// Adaptive combines two themes into one that picks the colors of light on
+// light terminal backgrounds and those of dark on dark ones.
+func Adaptive(light, dark Theme) Theme {
+	pick := func(l, d lipgloss.AdaptiveColor) lipgloss.AdaptiveColor {
+		return lipgloss.AdaptiveColor{Light: l.Light, Dark: d.Dark}
+	}
+	return Theme{
+		Primary:   pick(light.Primary, dark.Primary),
+		Accent:    pick(light.Accent, dark.Accent),
+		Surface:   pick(light.Surface, dark.Surface),
+		OnSurface: pick(light.OnSurface, dark.OnSurface),
+		Text:      pick(light.Text, dark.Text),
+		Subtle:    pick(light.Subtle, dark.Subtle),
+		Muted:     pick(light.Muted, dark.Muted),
+		Error:     pick(light.Error, dark.Error),
+		Warning:   pick(light.Warning, dark.Warning),
+		Success:   pick(light.Success, dark.Success),
+	}
+}
+
+// Hex returns the hex value of the given color for the current terminal
+// background. It's useful for components that take plain color strings, such
+// as the progress bar.
+func Hex(c lipgloss.AdaptiveColor) string {
+	if lipgloss.HasDarkBackground() {
+		return c.Dark
+	}
+	return c.Light
+}
+
+// palette is a helper for declaring themes that use the same colors
+// regardless of the terminal background.
+type palette struct {
+	primary, accent, surface, onSurface, text, subtle, muted string
+	error, warning, success                                  string
+}
+
+func fixed(p palette) Theme {
+	c := func(s string) lipgloss.AdaptiveColor {
+		return lipgloss.AdaptiveColor{Light: s, Dark: s}
+	}
+	return Theme{
+		Primary:   c(p.primary),
+		Accent:    c(p.accent),
+		Surface:   c(p.surface),
+		OnSurface: c(p.onSurface),
+		Text:      c(p.text),
+		Subtle:    c(p.subtle),
+		Muted:     c(p.muted),
+		Error:     c(p.error),
+		Warning:   c(p.warning),
+		Success:   c(p.success),
+	}
+}
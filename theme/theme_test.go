@@ -0,0 +1,17 @@
+package theme
+
+import "testing"
+
+func TestAdaptive(t *testing.T) {
+	th := Adaptive(Light, Dark)
+
+	if th.Primary.Light != Light.Primary.Light {
+		t.Errorf("expected light primary %q, got %q", Light.Primary.Light, th.Primary.Light)
+	}
+	if th.Primary.Dark != Dark.Primary.Dark {
+		t.Errorf("expected dark primary %q, got %q", Dark.Primary.Dark, th.Primary.Dark)
+	}
+	if th.Error.Light == th.Error.Dark {
+		t.Errorf("expected error color to differ between backgrounds")
+	}
+}
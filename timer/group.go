@@ -0,0 +1,159 @@
+package timer
+
+import (
+	"sort"
+	"strings"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Group manages several labeled timers, such as the deadlines of the stages
+// of a build, that are started and stopped independently. Pass every message
+// to the group's Update function and it'll route it to its timers.
+type Group struct {
+	timers []groupTimer
+}
+
+type groupTimer struct {
+	label string
+	timer Model
+}
+
+// NewGroup creates a new, empty group of timers.
+func NewGroup() Group {
+	return Group{}
+}
+
+// Add adds a running timer with the given label and timeout to the group,
+// replacing the timer with the same label, if any. It returns the command to
+// start it.
+func (g *Group) Add(label string, timeout time.Duration) tea.Cmd {
+	return g.AddTimer(label, New(timeout))
+}
+
+// AddTimer adds a timer with the given label to the group, replacing the
+// timer with the same label, if any. It returns the command to start it.
+func (g *Group) AddTimer(label string, t Model) tea.Cmd {
+	if i := g.index(label); i >= 0 {
+		g.timers[i].timer = t
+	} else {
+		g.timers = append(g.timers, groupTimer{label: label, timer: t})
+	}
+	return t.Init()
+}
+
+// Remove removes the timer with the given label from the group.
+func (g *Group) Remove(label string) {
+	if i := g.index(label); i >= 0 {
+		g.timers = append(g.timers[:i:i], g.timers[i+1:]...)
+	}
+}
+
+// Timer returns the timer with the given label.
+func (g Group) Timer(label string) (Model, bool) {
+	if i := g.index(label); i >= 0 {
+		return g.timers[i].timer, true
+	}
+	return Model{}, false
+}
+
+// Label returns the label of the timer with the given ID, such as the ID of
+// a TimeoutMsg.
+func (g Group) Label(id int) (string, bool) {
+	for _, t := range g.timers {
+		if t.timer.ID() == id {
+			return t.label, true
+		}
+	}
+	return "", false
+}
+
+// Labels returns the labels of the timers in the order they were added.
+func (g Group) Labels() []string {
+	labels := make([]string, len(g.timers))
+	for i, t := range g.timers {
+		labels[i] = t.label
+	}
+	return labels
+}
+
+// Start resumes the timer with the given label.
+func (g *Group) Start(label string) tea.Cmd {
+	if i := g.index(label); i >= 0 {
+		return g.timers[i].timer.Start()
+	}
+	return nil
+}
+
+// Stop pauses the timer with the given label.
+func (g *Group) Stop(label string) tea.Cmd {
+	if i := g.index(label); i >= 0 {
+		return g.timers[i].timer.Stop()
+	}
+	return nil
+}
+
+// Toggle stops the timer with the given label if it's running and starts it
+// if it's stopped.
+func (g *Group) Toggle(label string) tea.Cmd {
+	if i := g.index(label); i >= 0 {
+		return g.timers[i].timer.Toggle()
+	}
+	return nil
+}
+
+// Update routes messages to the timers of the group.
+func (g Group) Update(msg tea.Msg) (Group, tea.Cmd) {
+	switch msg.(type) {
+	case StartStopMsg, TickMsg:
+	default:
+		return g, nil
+	}
+
+	timers := make([]groupTimer, len(g.timers))
+	cmds := make([]tea.Cmd, len(g.timers))
+	for i, t := range g.timers {
+		timers[i] = t
+		timers[i].timer, cmds[i] = t.timer.Update(msg)
+	}
+	g.timers = timers
+	return g, tea.Batch(cmds...)
+}
+
+// View renders a line per timer with its label and the time left, the timer
+// that expires soonest first.
+func (g Group) View() string {
+	timers := append([]groupTimer(nil), g.timers...)
+	sort.SliceStable(timers, func(i, j int) bool {
+		return timers[i].timer.Timeout < timers[j].timer.Timeout
+	})
+
+	var width int
+	for _, t := range timers {
+		width = max(width, lipgloss.Width(t.label))
+	}
+	lines := make([]string, len(timers))
+	for i, t := range timers {
+		pad := strings.Repeat(" ", width-lipgloss.Width(t.label))
+		lines[i] = t.label + pad + "  " + t.timer.View()
+	}
+	return strings.Join(lines, "\n")
+}
+
+func (g Group) index(label string) int {
+	for i, t := range g.timers {
+		if t.label == label {
+			return i
+		}
+	}
+	return -1
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,65 @@
+package timer
+
+import (
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestGroupView(t *testing.T) {
+	g := NewGroup()
+	g.Add("build", 3*time.Minute)
+	g.Add("test", time.Minute)
+	g.Add("lint", time.Minute)
+
+	// The timer expiring soonest comes first, timers expiring at the same
+	// time in the order they were added.
+	want := "test   1m0s\nlint   1m0s\nbuild  3m0s"
+	if got := g.View(); got != want {
+		t.Fatalf("expected\n%s\ngot\n%s", want, got)
+	}
+	if got := g.Labels(); !reflect.DeepEqual(got, []string{"build", "test", "lint"}) {
+		t.Fatalf("expected the labels in the order they were added, got %v", got)
+	}
+}
+
+func TestGroupUpdate(t *testing.T) {
+	g := NewGroup()
+	g.Add("build", 3*time.Minute)
+	g.Add("test", time.Minute)
+
+	build, _ := g.Timer("build")
+	g, _ = g.Update(TickMsg{ID: build.ID()})
+	if build, _ = g.Timer("build"); build.Timeout != 3*time.Minute-time.Second {
+		t.Fatalf("expected the build timer to tick, got %s", build.Timeout)
+	}
+	if test, _ := g.Timer("test"); test.Timeout != time.Minute {
+		t.Fatalf("expected the test timer not to tick, got %s", test.Timeout)
+	}
+	if label, ok := g.Label(build.ID()); !ok || label != "build" {
+		t.Fatalf("expected the label of the build timer, got %q", label)
+	}
+}
+
+func TestGroupRemove(t *testing.T) {
+	g := NewGroup()
+	g.Add("build", 3*time.Minute)
+	g.Add("test", time.Minute)
+	g.Add("lint", time.Minute)
+
+	other := g
+	g.Remove("test")
+	g.Remove("deploy")
+	if got := g.Labels(); !reflect.DeepEqual(got, []string{"build", "lint"}) {
+		t.Fatalf("expected test to be removed, got %v", got)
+	}
+	if _, ok := g.Timer("test"); ok {
+		t.Fatal("expected no test timer")
+	}
+	if got := g.View(); got != "lint   1m0s\nbuild  3m0s" {
+		t.Fatalf("unexpected view\n%s", got)
+	}
+	if got := other.Labels(); len(got) != 3 {
+		t.Fatalf("expected a copy of the group to keep its timers, got %v", got)
+	}
+}
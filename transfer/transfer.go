@@ -0,0 +1,412 @@
+// Package transfer provides a transfer list: two lists side by side, of the
+// available items and the selected ones, with keybindings to move items
+// between them. It's the common UI for choosing a subset, such as the columns
+// of a table or the plugins to enable.
+//
+// Selected items keep the order they were selected in, and can be reordered.
+// A SubmittedMsg carries the final selection once the user submits it.
+package transfer
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/i18n"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/route"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/truncate"
+)
+
+// Pane is one of the two lists.
+type Pane int
+
+// The panes.
+const (
+	Available Pane = iota
+	Selected
+)
+
+// SubmittedMsg is sent when the user submits the selection.
+type SubmittedMsg struct {
+	// ID is the ID of the transfer list that sent the message.
+	ID int
+
+	// Selected are the selected items, in the order they were selected.
+	Selected []string
+}
+
+// KeyMap defines the keybindings for moving between items and panes,
+// transferring items, reordering the chosen ones and submitting the choice.
+// It satisfies the help.KeyMap interface, so the bindings can be listed with
+// the help bubble.
+type KeyMap struct {
+	Up         key.Binding
+	Down       key.Binding
+	SwitchPane key.Binding
+	Move       key.Binding
+	MoveAll    key.Binding
+
+	// Reordering the selected items.
+	MoveUp   key.Binding
+	MoveDown key.Binding
+
+	Submit key.Binding
+}
+
+// DefaultKeyMap returns a default set of keybindings.
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Up: key.NewBinding(
+			key.WithKeys("up", "k"),
+			key.WithHelp("↑/k", "up"),
+		),
+		Down: key.NewBinding(
+			key.WithKeys("down", "j"),
+			key.WithHelp("↓/j", "down"),
+		),
+		SwitchPane: key.NewBinding(
+			key.WithKeys("tab", "shift+tab", "left", "right", "h", "l"),
+			key.WithHelp("tab", "switch list"),
+		),
+		Move: key.NewBinding(
+			key.WithKeys(" "),
+			key.WithHelp("space", "move"),
+		),
+		MoveAll: key.NewBinding(
+			key.WithKeys("a"),
+			key.WithHelp("a", "move all"),
+		),
+		MoveUp: key.NewBinding(
+			key.WithKeys("K", "shift+up"),
+			key.WithHelp("K", "move up"),
+		),
+		MoveDown: key.NewBinding(
+			key.WithKeys("J", "shift+down"),
+			key.WithHelp("J", "move down"),
+		),
+		Submit: key.NewBinding(
+			key.WithKeys("enter"),
+			key.WithHelp("enter", "done"),
+		),
+	}
+}
+
+// ShortHelp implements the KeyMap interface.
+func (km KeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{km.SwitchPane, km.Move, km.MoveAll, km.Submit}
+}
+
+// FullHelp implements the KeyMap interface.
+func (km KeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{km.Up, km.Down, km.SwitchPane},
+		{km.Move, km.MoveAll},
+		{km.MoveUp, km.MoveDown, km.Submit},
+	}
+}
+
+// Styles contains the styles used to render the transfer list.
+type Styles struct {
+	Pane       lipgloss.Style
+	ActivePane lipgloss.Style
+	Title      lipgloss.Style
+	Item       lipgloss.Style
+
+	// Cursor is the item the cursor is on in the active pane.
+	Cursor lipgloss.Style
+}
+
+// DefaultStyles returns a set of default style definitions for the transfer
+// list.
+func DefaultStyles() Styles {
+	return Styles{
+		Pane: lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("240")).
+			Padding(0, 1),
+		ActivePane: lipgloss.NewStyle().
+			Border(lipgloss.RoundedBorder()).
+			BorderForeground(lipgloss.Color("212")).
+			Padding(0, 1),
+		Title:  lipgloss.NewStyle().Bold(true),
+		Item:   lipgloss.NewStyle(),
+		Cursor: lipgloss.NewStyle().Foreground(lipgloss.Color("212")),
+	}
+}
+
+// Model is the transfer list.
+type Model struct {
+	KeyMap KeyMap
+	Styles Styles
+
+	// Width and Height are the size of both panes together.
+	Width  int
+	Height int
+
+	// Titles of the panes.
+	AvailableTitle string
+	SelectedTitle  string
+
+	id    int
+	items []string
+
+	// The indices of the items in each pane. The available ones are kept in
+	// the order of the items.
+	panes  [2][]int
+	cursor [2]int
+	active Pane
+	focus  bool
+}
+
+// Option is used to set options in New.
+type Option func(*Model)
+
+// New creates a new transfer list of the given items, none of them selected.
+func New(items []string, opts ...Option) Model {
+	m := Model{
+		KeyMap:         DefaultKeyMap(),
+		Styles:         DefaultStyles(),
+		Width:          60,
+		Height:         10,
+		AvailableTitle: i18n.Text("Available"),
+		SelectedTitle:  i18n.Text("Selected"),
+		id:             route.NextID(),
+		items:          items,
+	}
+	for i := range items {
+		m.panes[Available] = append(m.panes[Available], i)
+	}
+
+	for _, opt := range opts {
+		opt(&m)
+	}
+
+	return m
+}
+
+// WithSelected selects items, in the given order.
+func WithSelected(items ...string) Option {
+	return func(m *Model) {
+		m.SetSelected(items)
+	}
+}
+
+// WithSize sets the size of both panes together.
+func WithSize(width, height int) Option {
+	return func(m *Model) {
+		m.Width = width
+		m.Height = height
+	}
+}
+
+// WithStyles sets the styles.
+func WithStyles(s Styles) Option {
+	return func(m *Model) {
+		m.Styles = s
+	}
+}
+
+// WithKeyMap sets the key map.
+func WithKeyMap(km KeyMap) Option {
+	return func(m *Model) {
+		m.KeyMap = km
+	}
+}
+
+// ID returns the transfer list's unique ID.
+func (m Model) ID() int {
+	return m.id
+}
+
+// Focus focuses the transfer list, so that it handles key presses.
+func (m *Model) Focus() {
+	m.focus = true
+}
+
+// Blur blurs the transfer list.
+func (m *Model) Blur() {
+	m.focus = false
+}
+
+// Focused returns whether the transfer list is focused.
+func (m Model) Focused() bool {
+	return m.focus
+}
+
+// ActivePane returns the pane the cursor is in.
+func (m Model) ActivePane() Pane {
+	return m.active
+}
+
+// Selected returns the selected items, in the order they were selected.
+func (m Model) Selected() []string {
+	return m.pane(Selected)
+}
+
+// AvailableItems returns the items that aren't selected.
+func (m Model) AvailableItems() []string {
+	return m.pane(Available)
+}
+
+func (m Model) pane(p Pane) []string {
+	items := make([]string, len(m.panes[p]))
+	for i, index := range m.panes[p] {
+		items[i] = m.items[index]
+	}
+	return items
+}
+
+// SetSelected selects the given items, in the given order, and deselects the
+// others. Items that aren't in the list are ignored.
+func (m *Model) SetSelected(items []string) {
+	m.panes[Available], m.panes[Selected] = nil, nil
+	selected := make(map[int]bool)
+	for _, item := range items {
+		for i, it := range m.items {
+			if it == item && !selected[i] {
+				selected[i] = true
+				m.panes[Selected] = append(m.panes[Selected], i)
+				break
+			}
+		}
+	}
+	for i := range m.items {
+		if !selected[i] {
+			m.panes[Available] = append(m.panes[Available], i)
+		}
+	}
+	m.cursor = [2]int{}
+}
+
+// Update handles key presses while the transfer list is focused.
+func (m Model) Update(msg tea.Msg) (Model, tea.Cmd) {
+	if !m.focus {
+		return m, nil
+	}
+	msg, ok := route.Accept(m.id, msg)
+	if !ok {
+		return m, nil
+	}
+	keyMsg, ok := msg.(tea.KeyMsg)
+	if !ok {
+		return m, nil
+	}
+
+	p := m.active
+	switch {
+	case key.Matches(keyMsg, m.KeyMap.Up):
+		m.cursor[p] = max(0, m.cursor[p]-1)
+	case key.Matches(keyMsg, m.KeyMap.Down):
+		m.cursor[p] = max(0, min(len(m.panes[p])-1, m.cursor[p]+1))
+	case key.Matches(keyMsg, m.KeyMap.SwitchPane):
+		m.active = 1 - m.active
+	case key.Matches(keyMsg, m.KeyMap.Move):
+		m.move(p, m.cursor[p])
+	case key.Matches(keyMsg, m.KeyMap.MoveAll):
+		for len(m.panes[p]) > 0 {
+			m.move(p, 0)
+		}
+	case key.Matches(keyMsg, m.KeyMap.MoveUp):
+		m.reorder(-1)
+	case key.Matches(keyMsg, m.KeyMap.MoveDown):
+		m.reorder(1)
+	case key.Matches(keyMsg, m.KeyMap.Submit):
+		id, selected := m.id, m.Selected()
+		return m, func() tea.Msg {
+			return SubmittedMsg{ID: id, Selected: selected}
+		}
+	}
+	return m, nil
+}
+
+// move moves the item at the given position of a pane to the other pane: to
+// the end of the selected items, or back in its place among the available
+// ones.
+func (m *Model) move(from Pane, pos int) {
+	if pos >= len(m.panes[from]) {
+		return
+	}
+	index := m.panes[from][pos]
+	m.panes[from] = append(m.panes[from][:pos:pos], m.panes[from][pos+1:]...)
+	m.cursor[from] = max(0, min(m.cursor[from], len(m.panes[from])-1))
+
+	to := 1 - from
+	m.panes[to] = append(m.panes[to], index)
+	if to == Available {
+		sort.Ints(m.panes[to])
+	}
+}
+
+// reorder moves the selected item at the cursor up or down.
+func (m *Model) reorder(delta int) {
+	if m.active != Selected {
+		return
+	}
+	pane, i := m.panes[Selected], m.cursor[Selected]
+	j := i + delta
+	if i >= len(pane) || j < 0 || j >= len(pane) {
+		return
+	}
+	pane[i], pane[j] = pane[j], pane[i]
+	m.cursor[Selected] = j
+}
+
+// View renders the panes side by side.
+func (m Model) View() string {
+	width := m.Width / 2
+	return lipgloss.JoinHorizontal(lipgloss.Top,
+		m.paneView(Available, m.AvailableTitle, width),
+		m.paneView(Selected, m.SelectedTitle, m.Width-width),
+	)
+}
+
+func (m Model) paneView(p Pane, title string, width int) string {
+	style := m.Styles.Pane
+	if m.focus && p == m.active {
+		style = m.Styles.ActivePane
+	}
+	innerWidth := max(0, width-style.GetHorizontalFrameSize())
+	innerHeight := max(1, m.Height-style.GetVerticalFrameSize())
+
+	header := m.Styles.Title.Render(title + " (" + strconv.Itoa(len(m.panes[p])) + ")")
+	lines := []string{header}
+
+	// Scroll to keep the cursor in view.
+	items := m.panes[p]
+	rows := innerHeight - 1
+	offset := 0
+	if rows > 0 && len(items) > rows {
+		offset = max(0, min(m.cursor[p]-rows/2, len(items)-rows))
+		items = items[offset : offset+rows]
+	}
+	for i, index := range items {
+		item := truncate.StringWithTail(m.items[index], uint(innerWidth), "…")
+		if m.focus && p == m.active && offset+i == m.cursor[p] {
+			lines = append(lines, m.Styles.Cursor.Render(item))
+		} else {
+			lines = append(lines, m.Styles.Item.Render(item))
+		}
+	}
+
+	content := lipgloss.NewStyle().
+		Width(innerWidth).Height(innerHeight).MaxHeight(innerHeight).
+		Render(strings.Join(lines, "\n"))
+	return style.Render(content)
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,61 @@
+package transfer
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func press(m Model, keys ...string) Model {
+	for _, k := range keys {
+		var msg tea.KeyMsg
+		switch k {
+		case "space":
+			msg = tea.KeyMsg{Type: tea.KeySpace, Runes: []rune{' '}}
+		case "tab":
+			msg = tea.KeyMsg{Type: tea.KeyTab}
+		case "down":
+			msg = tea.KeyMsg{Type: tea.KeyDown}
+		default:
+			msg = tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(k)}
+		}
+		m, _ = m.Update(msg)
+	}
+	return m
+}
+
+func TestTransfer(t *testing.T) {
+	m := New([]string{"name", "size", "modified", "owner"}, WithSelected("modified"))
+	m.Focus()
+
+	// Select owner, then size.
+	m = press(m, "down", "down", "space", "down", "space")
+	if exp := []string{"modified", "owner", "size"}; !reflect.DeepEqual(m.Selected(), exp) {
+		t.Fatalf("expected %q to be selected, got %q", exp, m.Selected())
+	}
+
+	// Move owner up, and modified back to the available items.
+	m = press(m, "tab", "down", "K", "down", "space")
+	if exp := []string{"owner", "size"}; !reflect.DeepEqual(m.Selected(), exp) {
+		t.Fatalf("expected %q to be selected, got %q", exp, m.Selected())
+	}
+	if exp := []string{"name", "modified"}; !reflect.DeepEqual(m.AvailableItems(), exp) {
+		t.Fatalf("expected %q to be available in their order, got %q", exp, m.AvailableItems())
+	}
+
+	m = press(m, "a")
+	if len(m.Selected()) != 0 || len(m.AvailableItems()) != 4 {
+		t.Fatalf("expected all items to be moved back, got %q", m.Selected())
+	}
+
+	if view := m.View(); !strings.Contains(view, "Available (4)") || !strings.Contains(view, "Selected (0)") {
+		t.Errorf("expected the panes to show their counts, got:\n%s", view)
+	}
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	if msg, ok := cmd().(SubmittedMsg); !ok || msg.ID != m.ID() || len(msg.Selected) != 0 {
+		t.Fatalf("expected an empty selection to be submitted, got %#v", msg)
+	}
+}
@@ -0,0 +1,116 @@
+package viewport
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/reflow/ansi"
+	"github.com/muesli/reflow/truncate"
+)
+
+// GutterFunc renders the gutter of a line, left of the content. The gutter is
+// as wide as the widest gutter of the content's lines; narrower gutters are
+// padded and wider ones of soft-wrapped lines truncated.
+type GutterFunc func(GutterContext) string
+
+// GutterContext describes the line a gutter is rendered for.
+type GutterContext struct {
+	// Index is the index of the content's line.
+	Index int
+
+	// TotalLines is the number of the content's lines.
+	TotalLines int
+
+	// Soft is set for the lines a line was soft-wrapped onto, after the
+	// first one.
+	Soft bool
+}
+
+// LineNumbers is a GutterFunc that numbers the content's lines.
+func LineNumbers(ctx GutterContext) string {
+	width := len(strconv.Itoa(ctx.TotalLines))
+	if ctx.Soft {
+		return strings.Repeat(" ", width+1)
+	}
+	return fmt.Sprintf("%*d ", width, ctx.Index+1)
+}
+
+// SetGutter sets the func that renders a gutter left of every line, such as
+// LineNumbers, or removes the gutter if it's nil. The gutter takes up part of
+// the width, which is left to the content. Its width is measured when the
+// gutter or the content is set; set the content again if the gutters of its
+// lines change otherwise.
+func (m *Model) SetGutter(gutter GutterFunc) {
+	m.gutter = gutter
+	m.measureGutter()
+	m.rewrap()
+	if m.YOffset > m.maxYOffset() {
+		m.GotoBottom()
+	}
+}
+
+// Gutter returns the func that renders the gutter, or nil if there's none.
+func (m Model) Gutter() GutterFunc {
+	return m.gutter
+}
+
+// gutterWidth returns the width of the gutter: the width of the widest gutter
+// of the content's lines, as measured by measureGutter.
+func (m Model) gutterWidth() int {
+	if m.gutter == nil {
+		return 0
+	}
+	return m.gutterW
+}
+
+// measureGutter measures the width of the gutter.
+func (m *Model) measureGutter() {
+	m.gutterW = 0
+	if m.gutter == nil {
+		return
+	}
+	for i := range m.lines {
+		g := m.gutter(GutterContext{Index: i, TotalLines: len(m.lines)})
+		m.gutterW = max(m.gutterW, ansi.PrintableRuneWidth(g))
+	}
+}
+
+// gutterView renders the gutters of the visible lines, padded to the given
+// height.
+func (m Model) gutterView(height int) string {
+	width := m.gutterWidth()
+	if width == 0 {
+		return ""
+	}
+
+	top, bottom := 0, 0
+	if len(m.contentLines()) > 0 {
+		top, bottom = m.window().Visible()
+	}
+	lines := make([]string, 0, height)
+	for display := top; display < bottom && len(lines) < height; display++ {
+		line := m.contentLine(display)
+		g := m.gutter(GutterContext{
+			Index:      line,
+			TotalLines: len(m.lines),
+			Soft:       display != m.displayLine(line),
+		})
+		g = truncate.String(g, uint(width))
+		lines = append(lines, g+strings.Repeat(" ", width-ansi.PrintableRuneWidth(g)))
+	}
+	for len(lines) < height {
+		lines = append(lines, strings.Repeat(" ", width))
+	}
+	return strings.Join(lines, "\n")
+}
+
+// withGutter renders the gutter of the visible lines left of their contents.
+func (m Model) withGutter(contents string, height int) string {
+	gutter := m.gutterView(height)
+	if gutter == "" {
+		return contents
+	}
+	return lipgloss.JoinHorizontal(lipgloss.Top, gutter, contents)
+}
@@ -0,0 +1,66 @@
+package viewport
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestGutter(t *testing.T) {
+	m := New(8, 4)
+	m.SetGutter(LineNumbers)
+	m.SetContent(strings.Repeat("abcdefgh\n", 9) + "ab")
+
+	expected := []string{
+		" 1 abcde",
+		" 2 abcde",
+		" 3 abcde",
+		" 4 abcde",
+	}
+	if view := m.View(); view != strings.Join(expected, "\n") {
+		t.Fatalf("expected:\n%s\ngot:\n%s", strings.Join(expected, "\n"), view)
+	}
+
+	m.SoftWrap = true
+	m.SetContent("abcdefgh\nab")
+	expected = []string{
+		"1 abcdef",
+		"  gh    ",
+		"2 ab    ",
+		"        ",
+	}
+	if view := m.View(); view != strings.Join(expected, "\n") {
+		t.Fatalf("expected:\n%s\ngot:\n%s", strings.Join(expected, "\n"), view)
+	}
+}
+
+func TestGutterMeasuredOnce(t *testing.T) {
+	var calls int
+	gutter := func(prefix string) GutterFunc {
+		return func(ctx GutterContext) string {
+			calls++
+			return prefix
+		}
+	}
+
+	m := New(20, 4)
+	m.SetContent(strings.Repeat("line\n", 999) + "line")
+	m.SoftWrap = true
+	m.SetGutter(gutter(">>>>>> "))
+	calls = 0
+	for i := 0; i < 3; i++ {
+		m.View()
+	}
+	if calls != 3*4 {
+		t.Fatalf("expected only the visible gutters to be rendered, got %d calls", calls)
+	}
+
+	m.SetGutter(gutter("> "))
+	if view := m.View(); !strings.HasPrefix(view, "> line") {
+		t.Fatalf("expected the new gutter to be measured, got %q", view)
+	}
+
+	m.SetGutter(nil)
+	if view := m.View(); !strings.HasPrefix(view, "line") {
+		t.Fatalf("expected the gutter to be removed, got %q", view)
+	}
+}
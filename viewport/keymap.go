@@ -15,6 +15,12 @@ type KeyMap struct {
 	HalfPageDown key.Binding
 	Down         key.Binding
 	Up           key.Binding
+
+	// SetMark and GotoMark are followed by the name of a mark, see
+	// Model.SetMark. They're disabled by default, as they take the key
+	// pressed after them; enable them with SetEnabled(true).
+	SetMark  key.Binding
+	GotoMark key.Binding
 }
 
 // DefaultKeyMap returns a set of pager-like default keybindings.
@@ -44,5 +50,15 @@ func DefaultKeyMap() KeyMap {
 			key.WithKeys("down", "j"),
 			key.WithHelp("↓/j", "down"),
 		),
+		SetMark: key.NewBinding(
+			key.WithKeys("m"),
+			key.WithHelp("m", "set mark"),
+			key.WithDisabled(),
+		),
+		GotoMark: key.NewBinding(
+			key.WithKeys("'"),
+			key.WithHelp("'", "go to mark"),
+			key.WithDisabled(),
+		),
 	}
 }
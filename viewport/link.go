@@ -0,0 +1,97 @@
+package viewport
+
+import (
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// Internal ID management. Used to tell linked viewports apart.
+var (
+	lastID int
+	idMtx  sync.Mutex
+)
+
+// Return the next ID we should use on the Model.
+func nextID() int {
+	idMtx.Lock()
+	defer idMtx.Unlock()
+	lastID++
+	return lastID
+}
+
+// OffsetMap maps the vertical offset of one viewport to the offset of
+// another, such as the matching line on the other side of a diff.
+type OffsetMap func(yOffset int) int
+
+// ScrollMsg is sent when a linked viewport was scrolled, so the viewports
+// linked to it scroll along. Pass it on to the Update function of every
+// linked viewport.
+type ScrollMsg struct {
+	ID      int
+	YOffset int
+}
+
+// link is a viewport this viewport scrolls along with.
+type link struct {
+	id      int
+	mapping OffsetMap
+}
+
+// ID returns the viewport's unique ID.
+func (m Model) ID() int {
+	return m.id
+}
+
+// Link links two viewports so they scroll together, for side-by-side views:
+// when one is scrolled with the keyboard or the mouse, the other scrolls to
+// the same offset.
+func Link(a, b *Model) {
+	LinkMapped(a, b, nil, nil)
+}
+
+// LinkMapped links two viewports like Link, but maps the offsets between them:
+// aToB maps the offsets of a to offsets of b, and bToA the other way around.
+// A nil mapping keeps the offset.
+func LinkMapped(a, b *Model, aToB, bToA OffsetMap) {
+	for _, m := range []*Model{a, b} {
+		if m.id == 0 {
+			m.id = nextID()
+		}
+	}
+	a.links = append(a.links, link{id: b.id, mapping: bToA})
+	b.links = append(b.links, link{id: a.id, mapping: aToB})
+}
+
+// Unlink removes all links of the viewport. The viewports it was linked to
+// keep following it until they're unlinked too.
+func (m *Model) Unlink() {
+	m.links = nil
+}
+
+// ScrollCmd returns a command that scrolls the viewports linked to this one
+// along with it. Scrolling with the keyboard or the mouse does so on its own;
+// call it after scrolling with methods like GotoTop.
+func (m Model) ScrollCmd() tea.Cmd {
+	if len(m.links) == 0 {
+		return nil
+	}
+	msg := ScrollMsg{ID: m.id, YOffset: m.YOffset}
+	return func() tea.Msg {
+		return msg
+	}
+}
+
+// handleScroll scrolls along with a linked viewport.
+func (m *Model) handleScroll(msg ScrollMsg) {
+	for _, l := range m.links {
+		if l.id != msg.ID {
+			continue
+		}
+		offset := msg.YOffset
+		if l.mapping != nil {
+			offset = l.mapping(offset)
+		}
+		m.SetYOffset(offset)
+	}
+}
@@ -0,0 +1,53 @@
+package viewport
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// scrollMsg returns the ScrollMsg sent by a command, if any.
+func scrollMsg(cmd tea.Cmd) (ScrollMsg, bool) {
+	if cmd == nil {
+		return ScrollMsg{}, false
+	}
+	msg, ok := cmd().(ScrollMsg)
+	return msg, ok
+}
+
+func TestLink(t *testing.T) {
+	left, right := New(10, 5), New(10, 5)
+	left.SetContent(numberedContent(50))
+	right.SetContent(numberedContent(50))
+	LinkMapped(&left, &right, func(y int) int { return y + 2 }, nil)
+
+	down := tea.KeyMsg{Type: tea.KeyDown}
+	left, cmd := left.Update(down)
+	msg, ok := scrollMsg(cmd)
+	if !ok || msg.ID != left.ID() || msg.YOffset != 1 {
+		t.Fatalf("expected a scroll message for offset 1, got %#v", msg)
+	}
+
+	right, cmd = right.Update(msg)
+	if right.YOffset != 3 {
+		t.Fatalf("expected the right viewport at the mapped offset 3, got %d", right.YOffset)
+	}
+	if _, ok := scrollMsg(cmd); ok {
+		t.Fatal("expected following a scroll not to echo it back")
+	}
+
+	right.GotoTop()
+	msg, _ = scrollMsg(right.ScrollCmd())
+	left, _ = left.Update(msg)
+	if left.YOffset != 0 {
+		t.Fatalf("expected the left viewport to follow, got %d", left.YOffset)
+	}
+
+	// Viewports that aren't linked ignore it.
+	other := New(10, 5)
+	other.SetContent(numberedContent(50))
+	other, _ = other.Update(ScrollMsg{ID: left.ID(), YOffset: 10})
+	if other.YOffset != 0 {
+		t.Fatalf("expected an unlinked viewport not to scroll, got %d", other.YOffset)
+	}
+}
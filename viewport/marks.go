@@ -0,0 +1,66 @@
+package viewport
+
+import tea "github.com/charmbracelet/bubbletea"
+
+// jumpBackMark is the mark that's set to where the viewport was before it
+// jumped to a mark, so it can jump back.
+const jumpBackMark = "'"
+
+// SetMark marks one of the content's lines with a name, so the viewport can
+// jump to it with GotoMark. When the SetMark and GotoMark keybindings are
+// enabled, m followed by a letter marks the top line, and ' followed by the
+// letter jumps to it, like in less.
+func (m *Model) SetMark(name string, line int) {
+	if m.marks == nil {
+		m.marks = make(map[string]int)
+	}
+	m.marks[name] = line
+}
+
+// Mark returns the line marked with a name, and whether there's such a mark.
+func (m Model) Mark(name string) (int, bool) {
+	line, ok := m.marks[name]
+	return line, ok
+}
+
+// ClearMarks removes all marks.
+func (m *Model) ClearMarks() {
+	m.marks = nil
+}
+
+// GotoMark scrolls the marked line to the top of the viewport, and returns
+// whether there's such a mark. The line that was at the top is marked with
+// ', so JumpBack or GotoMark("'") jumps back.
+func (m *Model) GotoMark(name string) bool {
+	line, ok := m.marks[name]
+	if !ok {
+		return false
+	}
+	from := m.contentLine(m.YOffset)
+	m.SetYOffset(m.displayLine(line))
+	m.SetMark(jumpBackMark, from)
+	return true
+}
+
+// JumpBack jumps back to where the viewport was before it last jumped to a
+// mark, and returns whether it had jumped.
+func (m *Model) JumpBack() bool {
+	return m.GotoMark(jumpBackMark)
+}
+
+// handleMarkKey finishes setting a mark, or jumping to one, with the name
+// typed after the SetMark or GotoMark keybinding.
+func (m *Model) handleMarkKey(msg tea.KeyMsg) {
+	pending := m.pendingMark
+	m.pendingMark = ""
+	if msg.Type != tea.KeyRunes || len(msg.Runes) != 1 {
+		return
+	}
+
+	name := string(msg.Runes)
+	if pending == "m" {
+		m.SetMark(name, m.contentLine(m.YOffset))
+		return
+	}
+	m.GotoMark(name)
+}
@@ -0,0 +1,85 @@
+package viewport
+
+import (
+	"strings"
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+func numberedContent(n int) string {
+	lines := make([]string, n)
+	for i := range lines {
+		lines[i] = strings.Repeat("x", i%7)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestMarks(t *testing.T) {
+	m := New(10, 5)
+	m.SetContent(numberedContent(50))
+
+	m.SetMark("section", 30)
+	if !m.GotoMark("section") || m.YOffset != 30 {
+		t.Fatalf("expected to jump to line 30, got %d", m.YOffset)
+	}
+	if m.GotoMark("missing") {
+		t.Fatal("expected no mark named missing")
+	}
+
+	if !m.JumpBack() || m.YOffset != 0 {
+		t.Fatalf("expected to jump back to the top, got %d", m.YOffset)
+	}
+	if !m.JumpBack() || m.YOffset != 30 {
+		t.Fatalf("expected to jump back to line 30 again, got %d", m.YOffset)
+	}
+}
+
+func TestMarkKeys(t *testing.T) {
+	m := New(10, 5)
+	m.SetContent(numberedContent(50))
+	press := func(s string) {
+		m, _ = m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)})
+	}
+
+	press("m")
+	press("j")
+	if m.YOffset != 1 {
+		t.Fatalf("expected the mark keybindings to be disabled by default, got %d", m.YOffset)
+	}
+
+	m.KeyMap.SetMark.SetEnabled(true)
+	m.KeyMap.GotoMark.SetEnabled(true)
+	m.SetYOffset(12)
+	press("m")
+	press("a")
+	if line, ok := m.Mark("a"); !ok || line != 12 {
+		t.Fatalf("expected mark a at line 12, got %d", line)
+	}
+
+	m.GotoTop()
+	press("'")
+	press("a")
+	if m.YOffset != 12 {
+		t.Fatalf("expected to jump to mark a, got %d", m.YOffset)
+	}
+	press("'")
+	press("'")
+	if m.YOffset != 0 {
+		t.Fatalf("expected '' to jump back, got %d", m.YOffset)
+	}
+}
+
+func TestMarksSoftWrap(t *testing.T) {
+	m := New(5, 2)
+	m.SoftWrap = true
+	m.SetContent("aaaa bbbb\ncc\ndd\nee")
+
+	m.SetMark("d", 2)
+	if !m.GotoMark("d") || m.YOffset != 3 {
+		t.Fatalf("expected the mark on the wrapped line 3, got %d", m.YOffset)
+	}
+	if line, _ := m.Mark(jumpBackMark); line != 0 {
+		t.Fatalf("expected the jump back mark on line 0, got %d", line)
+	}
+}
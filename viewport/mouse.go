@@ -0,0 +1,38 @@
+package viewport
+
+import (
+	"github.com/charmbracelet/bubbles/mouse"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// regions returns the regions of the visible lines, gutters included, with
+// the index of the content's line they show as IDs. The lines a line was
+// soft-wrapped onto are regions of the same line.
+func (m Model) regions() mouse.Regions {
+	var (
+		regions mouse.Regions
+		s       = m.Style
+		x       = s.GetMarginLeft() + s.GetBorderLeftSize() + s.GetPaddingLeft()
+		y       = s.GetMarginTop() + s.GetBorderTopWidth() + s.GetPaddingTop()
+		width   = m.gutterWidth() + m.contentWidth()
+		height  = m.Height
+	)
+	if sh := s.GetHeight(); sh != 0 {
+		height = min(height, sh)
+	}
+	height -= s.GetVerticalFrameSize()
+
+	top, bottom := m.window().Visible()
+	for i := top; i < bottom && i-top < height; i++ {
+		regions.Add(m.contentLine(i), x, y+i-top, width, 1)
+	}
+	return regions
+}
+
+// LineAt returns the index of the content's line under a mouse event, if
+// there's one. XPosition and YPosition must be set to the position of the
+// viewport in the terminal window.
+func (m Model) LineAt(msg tea.MouseMsg) (int, bool) {
+	ev, ok := m.regions().Hit(msg, m.XPosition, m.YPosition)
+	return ev.ID, ok
+}
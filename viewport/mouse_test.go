@@ -0,0 +1,49 @@
+package viewport
+
+import (
+	"testing"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestLineAt(t *testing.T) {
+	m := New(12, 4)
+	m.Style = lipgloss.NewStyle().Border(lipgloss.NormalBorder())
+	m.SoftWrap = true
+	m.XPosition, m.YPosition = 2, 4
+	m.SetContent("one\nthe quick brown\nthree\nfour")
+	m.SetYOffset(1)
+
+	tests := []struct {
+		x, y int
+		line int
+		ok   bool
+	}{
+		{3, 5, 1, true},   // "the quick"
+		{12, 6, 1, true},  // "brown", wrapped from the same line
+		{2, 5, 0, false},  // the left border
+		{13, 5, 0, false}, // the right border
+		{3, 7, 0, false},  // the bottom border
+	}
+	for _, tt := range tests {
+		line, ok := m.LineAt(tea.MouseMsg{X: tt.x, Y: tt.y})
+		if ok != tt.ok || ok && line != tt.line {
+			t.Errorf("(%d, %d): expected line %d (%t), got %d (%t)", tt.x, tt.y, tt.line, tt.ok, line, ok)
+		}
+	}
+}
+
+func TestMouseWheel(t *testing.T) {
+	m := New(10, 2)
+	m.SetContent("1\n2\n3\n4\n5\n6")
+
+	m, _ = m.Update(tea.MouseMsg{Type: tea.MouseWheelDown})
+	if m.YOffset != 3 {
+		t.Fatalf("expected to scroll down 3 lines, got an offset of %d", m.YOffset)
+	}
+	m, _ = m.Update(tea.MouseMsg{Type: tea.MouseWheelUp})
+	if m.YOffset != 0 {
+		t.Fatalf("expected to scroll back up, got an offset of %d", m.YOffset)
+	}
+}
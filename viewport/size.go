@@ -0,0 +1,30 @@
+package viewport
+
+import "github.com/muesli/reflow/ansi"
+
+// SetSize sets the width and the height of the viewport. It satisfies the
+// layout.Sizable interface.
+func (m *Model) SetSize(width, height int) {
+	m.Width, m.Height = width, height
+	m.rewrap()
+	if m.YOffset > m.maxYOffset() {
+		m.GotoBottom()
+	}
+}
+
+// MinSize returns the size of the style's frame and the gutter, with room
+// for a single cell of content.
+func (m Model) MinSize() (width, height int) {
+	return m.Style.GetHorizontalFrameSize() + m.gutterWidth() + 1,
+		m.Style.GetVerticalFrameSize() + 1
+}
+
+// PreferredSize returns the size that shows all of the content without
+// scrolling or wrapping.
+func (m Model) PreferredSize() (width, height int) {
+	for _, line := range m.lines {
+		width = max(width, ansi.PrintableRuneWidth(line))
+	}
+	return m.Style.GetHorizontalFrameSize() + m.gutterWidth() + width,
+		m.Style.GetVerticalFrameSize() + len(m.lines)
+}
@@ -0,0 +1,27 @@
+package viewport
+
+import (
+	"testing"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+func TestSize(t *testing.T) {
+	m := New(0, 0)
+	m.Style = lipgloss.NewStyle().Padding(0, 1)
+	m.SetGutter(LineNumbers)
+	m.SetContent("one\nthree\nfive")
+
+	if w, h := m.MinSize(); w != 5 || h != 1 {
+		t.Errorf("expected a minimum size of 5x1, got %dx%d", w, h)
+	}
+	if w, h := m.PreferredSize(); w != 9 || h != 3 {
+		t.Errorf("expected a preferred size of 9x3, got %dx%d", w, h)
+	}
+
+	m.SetYOffset(1)
+	m.SetSize(9, 3)
+	if m.Width != 9 || m.Height != 3 || m.YOffset != 0 {
+		t.Errorf("expected the viewport to be resized and scrolled back, got %dx%d at %d", m.Width, m.Height, m.YOffset)
+	}
+}
@@ -5,6 +5,8 @@ import (
 	"strings"
 
 	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/mouse"
+	"github.com/charmbracelet/bubbles/virtual"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
@@ -34,8 +36,10 @@ type Model struct {
 	// YOffset is the vertical scroll position.
 	YOffset int
 
-	// YPosition is the position of the viewport in relation to the terminal
-	// window. It's used in high performance rendering only.
+	// XPosition and YPosition are the position of the viewport in relation
+	// to the terminal window. They're used to find the line under the mouse
+	// with LineAt, and YPosition in high performance rendering.
+	XPosition int
 	YPosition int
 
 	// Style applies a lipgloss style to the viewport. Realistically, it's most
@@ -52,8 +56,34 @@ type Model struct {
 	// which is usually via the alternate screen buffer.
 	HighPerformanceRendering bool
 
+	// SoftWrap wraps lines that are wider than the viewport instead of
+	// truncating them. Styling is preserved across the wrapped lines, which
+	// are recalculated when the width changes.
+	SoftWrap bool
+
 	initialized bool
 	lines       []string
+
+	// The soft-wrapped lines, where each of the content's lines starts among
+	// them, and the width they were wrapped to.
+	wrapped    []string
+	wrapStarts []int
+	wrapWidth  int
+
+	// The gutter, see SetGutter, and its width.
+	gutter  GutterFunc
+	gutterW int
+
+	// Marks by name, see SetMark.
+	marks map[string]int
+
+	// The keybinding typed before the name of a mark, if any: "m" to set
+	// the mark, "'" to go to it.
+	pendingMark string
+
+	// The ID of the viewport, and the viewports it scrolls along with.
+	id    int
+	links []link
 }
 
 func (m *Model) setInitialValues() {
@@ -61,6 +91,9 @@ func (m *Model) setInitialValues() {
 	m.MouseWheelEnabled = true
 	m.MouseWheelDelta = 3
 	m.initialized = true
+	if m.id == 0 {
+		m.id = nextID()
+	}
 }
 
 // Init exists to satisfy the tea.Model interface for composability purposes.
@@ -87,12 +120,13 @@ func (m Model) PastBottom() bool {
 
 // ScrollPercent returns the amount scrolled as a float between 0 and 1.
 func (m Model) ScrollPercent() float64 {
-	if m.Height >= len(m.lines) {
+	lines := m.contentLines()
+	if m.Height >= len(lines) {
 		return 1.0
 	}
 	y := float64(m.YOffset)
 	h := float64(m.Height)
-	t := float64(len(m.lines) - 1)
+	t := float64(len(lines) - 1)
 	v := y / (t - h)
 	return math.Max(0.0, math.Min(1.0, v))
 }
@@ -102,25 +136,36 @@ func (m Model) ScrollPercent() float64 {
 func (m *Model) SetContent(s string) {
 	s = strings.ReplaceAll(s, "\r\n", "\n") // normalize line endings
 	m.lines = strings.Split(s, "\n")
+	m.wrapped = nil
+	m.measureGutter()
+	m.rewrap()
 
-	if m.YOffset > len(m.lines)-1 {
+	if m.YOffset > len(m.contentLines())-1 {
 		m.GotoBottom()
 	}
 }
 
+// window returns the scroll window over the viewport's lines.
+func (m Model) window() virtual.Window {
+	return virtual.Window{
+		Total:  len(m.contentLines()),
+		Size:   m.Height,
+		Offset: m.YOffset,
+	}
+}
+
 // maxYOffset returns the maximum possible value of the y-offset based on the
 // viewport's content and set height.
 func (m Model) maxYOffset() int {
-	return max(0, len(m.lines)-m.Height)
+	return m.window().MaxOffset()
 }
 
 // visibleLines returns the lines that should currently be visible in the
 // viewport.
 func (m Model) visibleLines() (lines []string) {
-	if len(m.lines) > 0 {
-		top := max(0, m.YOffset)
-		bottom := clamp(m.YOffset+m.Height, top, len(m.lines))
-		lines = m.lines[top:bottom]
+	if all := m.contentLines(); len(all) > 0 {
+		top, bottom := m.window().Visible()
+		lines = all[top:bottom]
 	}
 	return lines
 }
@@ -137,7 +182,9 @@ func (m Model) scrollArea() (top, bottom int) {
 
 // SetYOffset sets the Y offset.
 func (m *Model) SetYOffset(n int) {
-	m.YOffset = clamp(n, 0, m.maxYOffset())
+	w := m.window()
+	w.SetOffset(n)
+	m.YOffset = w.Offset
 }
 
 // ViewDown moves the view down by the number of lines in the viewport.
@@ -274,12 +321,29 @@ func (m Model) updateAsModel(msg tea.Msg) (Model, tea.Cmd) {
 	if !m.initialized {
 		m.setInitialValues()
 	}
+	m.rewrap()
 
 	var cmd tea.Cmd
+	yOffset := m.YOffset
 
 	switch msg := msg.(type) {
+	case ScrollMsg:
+		m.handleScroll(msg)
+		return m, nil
+
 	case tea.KeyMsg:
+		if m.pendingMark != "" {
+			m.handleMarkKey(msg)
+			break
+		}
+
 		switch {
+		case key.Matches(msg, m.KeyMap.SetMark):
+			m.pendingMark = "m"
+
+		case key.Matches(msg, m.KeyMap.GotoMark):
+			m.pendingMark = jumpBackMark
+
 		case key.Matches(msg, m.KeyMap.PageDown):
 			lines := m.ViewDown()
 			if m.HighPerformanceRendering {
@@ -321,21 +385,28 @@ func (m Model) updateAsModel(msg tea.Msg) (Model, tea.Cmd) {
 		if !m.MouseWheelEnabled {
 			break
 		}
-		switch msg.Type {
-		case tea.MouseWheelUp:
-			lines := m.LineUp(m.MouseWheelDelta)
+		switch n := mouse.Wheel(msg, m.MouseWheelDelta); {
+		case n < 0:
+			lines := m.LineUp(-n)
 			if m.HighPerformanceRendering {
 				cmd = ViewUp(m, lines)
 			}
 
-		case tea.MouseWheelDown:
-			lines := m.LineDown(m.MouseWheelDelta)
+		case n > 0:
+			lines := m.LineDown(n)
 			if m.HighPerformanceRendering {
 				cmd = ViewDown(m, lines)
 			}
 		}
 	}
 
+	if m.YOffset != yOffset && len(m.links) > 0 {
+		if cmd == nil {
+			cmd = m.ScrollCmd()
+		} else {
+			cmd = tea.Batch(cmd, m.ScrollCmd())
+		}
+	}
 	return m, cmd
 }
 
@@ -349,32 +420,27 @@ func (m Model) View() string {
 		return strings.Repeat("\n", max(0, m.Height-1))
 	}
 
-	w, h := m.Width, m.Height
-	if sw := m.Style.GetWidth(); sw != 0 {
-		w = min(w, sw)
-	}
+	// Wrap the lines once for this view, in case SoftWrap or the size
+	// changed since.
+	m.rewrap()
+
+	h := m.Height
 	if sh := m.Style.GetHeight(); sh != 0 {
 		h = min(h, sh)
 	}
-	contentWidth := w - m.Style.GetHorizontalFrameSize()
+	contentWidth := m.contentWidth()
 	contentHeight := h - m.Style.GetVerticalFrameSize()
 	contents := lipgloss.NewStyle().
 		Height(contentHeight).    // pad to height.
 		MaxHeight(contentHeight). // truncate height if taller.
 		MaxWidth(contentWidth).   // truncate width.
 		Render(strings.Join(m.visibleLines(), "\n"))
+	contents = m.withGutter(contents, contentHeight)
 	return m.Style.Copy().
 		UnsetWidth().UnsetHeight(). // Style size already applied in contents.
 		Render(contents)
 }
 
-func clamp(v, low, high int) int {
-	if high < low {
-		low, high = high, low
-	}
-	return min(high, max(low, v))
-}
-
 func min(a, b int) int {
 	if a < b {
 		return a
@@ -0,0 +1,49 @@
+package viewport
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestSoftWrap(t *testing.T) {
+	m := New(10, 3)
+	m.SoftWrap = true
+	m.SetContent("the quick brown fox jumps\nshort")
+
+	want := []string{"the quick", "brown fox", "jumps", "short"}
+	if got := m.contentLines(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+	if m.GotoBottom(); m.YOffset != 1 {
+		t.Fatalf("expected to scroll through the wrapped lines, got an offset of %d", m.YOffset)
+	}
+
+	// Resizing wraps the lines again.
+	m.Width = 20
+	want = []string{"the quick brown fox", "jumps", "short"}
+	if got := m.contentLines(); !reflect.DeepEqual(got, want) {
+		t.Fatalf("expected %q after resizing, got %q", want, got)
+	}
+	m, _ = m.Update(nil)
+	if m.wrapWidth != 20 {
+		t.Errorf("expected the wrapped lines to be cached for the new width, got %d", m.wrapWidth)
+	}
+}
+
+func TestSoftWrapCarriesStyles(t *testing.T) {
+	const red = "\x1b[31m"
+	lines, _ := wrapLines([]string{red + "aaaa bbbb cccc\x1b[0m plain"}, 5)
+
+	want := []string{
+		red + "aaaa\x1b[0m",
+		red + "bbbb\x1b[0m",
+		red + "cccc\x1b[0m",
+		"plain",
+	}
+	for i, line := range want {
+		if i >= len(lines) || strings.TrimSpace(lines[i]) != line {
+			t.Fatalf("expected %q, got %q", want, lines)
+		}
+	}
+}
@@ -0,0 +1,141 @@
+package viewport
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/muesli/reflow/ansi"
+	"github.com/muesli/reflow/wordwrap"
+	"github.com/muesli/reflow/wrap"
+)
+
+// contentWidth returns the width available to the content, right of the
+// gutter.
+func (m Model) contentWidth() int {
+	w := m.Width
+	if sw := m.Style.GetWidth(); sw != 0 {
+		w = min(w, sw)
+	}
+	return w - m.Style.GetHorizontalFrameSize() - m.gutterWidth()
+}
+
+// contentLines returns the lines to scroll through: the content's lines, or
+// their soft-wrapped lines if SoftWrap is enabled.
+func (m Model) contentLines() []string {
+	lines, _ := m.wrappedLines()
+	return lines
+}
+
+// wrappedLines returns the lines to scroll through and the index of the
+// first of them of each of the content's lines.
+func (m Model) wrappedLines() (lines []string, starts []int) {
+	if !m.SoftWrap {
+		return m.lines, nil
+	}
+	if w := m.contentWidth(); m.wrapped == nil || m.wrapWidth != w {
+		return wrapLines(m.lines, w)
+	}
+	return m.wrapped, m.wrapStarts
+}
+
+// displayLine returns the index of the first line to scroll through of one of
+// the content's lines.
+func (m Model) displayLine(line int) int {
+	_, starts := m.wrappedLines()
+	if starts == nil {
+		return line
+	}
+	if line >= len(starts) {
+		return len(m.contentLines())
+	}
+	return starts[max(0, line)]
+}
+
+// contentLine returns which of the content's lines a line to scroll through
+// belongs to.
+func (m Model) contentLine(display int) int {
+	_, starts := m.wrappedLines()
+	if starts == nil {
+		return display
+	}
+	return max(0, sort.SearchInts(starts, display+1)-1)
+}
+
+// rewrap soft-wraps the content's lines to the content width, if SoftWrap is
+// enabled and they haven't been wrapped to that width yet.
+func (m *Model) rewrap() {
+	if !m.SoftWrap {
+		m.wrapped, m.wrapStarts = nil, nil
+		return
+	}
+	if w := m.contentWidth(); m.wrapped == nil || m.wrapWidth != w {
+		m.wrapped, m.wrapStarts = wrapLines(m.lines, w)
+		m.wrapWidth = w
+	}
+}
+
+// wrapLines soft-wraps lines to the given width, at spaces where possible,
+// and returns the index of the first wrapped line of every line. Styling
+// carries over to the wrapped lines.
+func wrapLines(lines []string, width int) (wrapped []string, starts []int) {
+	starts = make([]int, len(lines))
+	if width <= 0 {
+		for i := range starts {
+			starts[i] = i
+		}
+		return lines, starts
+	}
+	wrapped = make([]string, 0, len(lines))
+	for i, line := range lines {
+		starts[i] = len(wrapped)
+		s := wrap.String(wordwrap.String(line, width), width)
+		wrapped = append(wrapped, carryStyles(strings.Split(s, "\n"))...)
+	}
+	return wrapped, starts
+}
+
+// carryStyles makes the styling active at the end of every line apply to the
+// next line too, so every line renders the same on its own. Lines that end
+// with styling active are reset.
+func carryStyles(lines []string) []string {
+	var active []string
+	for i, line := range lines {
+		prefix := strings.Join(active, "")
+		active = activeStyles(active, line)
+		if prefix != "" {
+			line = prefix + line
+		}
+		if len(active) > 0 {
+			line += "\x1b[0m"
+		}
+		lines[i] = line
+	}
+	return lines
+}
+
+// activeStyles returns the SGR sequences active after a line, given the ones
+// active before it.
+func activeStyles(active []string, line string) []string {
+	for i := 0; i < len(line); i++ {
+		if line[i] != ansi.Marker {
+			continue
+		}
+		j := i + 1
+		for j < len(line) && !ansi.IsTerminator(rune(line[j])) {
+			j++
+		}
+		if j >= len(line) {
+			break
+		}
+
+		seq := line[i : j+1]
+		switch {
+		case seq == "\x1b[0m" || seq == "\x1b[m":
+			active = nil
+		case line[j] == 'm':
+			active = append(active, seq)
+		}
+		i = j
+	}
+	return active
+}
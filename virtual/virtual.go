@@ -0,0 +1,119 @@
+// Package virtual provides the math for virtualized scrolling: given the total
+// number of items in a collection and how many of them fit on screen, it
+// computes which items are visible, keeps offsets in bounds and scrolls just
+// enough to bring a given item into view.
+//
+// It's used by scrolling bubbles like the table, the viewport and the list so
+// that they all share the same, tested clamping logic.
+package virtual
+
+// Window is the visible portion of a scrollable collection of items. The
+// zero value is an empty window.
+type Window struct {
+	// Total is the number of items in the collection.
+	Total int
+
+	// Size is the number of items that fit on screen.
+	Size int
+
+	// Offset is the index of the first visible item.
+	Offset int
+
+	// Overscan is the number of extra items above and below the visible
+	// range to include in Rendered. Rendering a few items beyond the edges
+	// can make scrolling smoother when rendering is expensive.
+	Overscan int
+}
+
+// MaxOffset returns the largest offset at which the window is still filled
+// with items, or 0 if all items fit.
+func (w Window) MaxOffset() int {
+	return max(0, w.Total-w.Size)
+}
+
+// AtTop returns whether the window shows the first item.
+func (w Window) AtTop() bool {
+	return w.Offset <= 0
+}
+
+// AtBottom returns whether the window is at or past the last offset.
+func (w Window) AtBottom() bool {
+	return w.Offset >= w.MaxOffset()
+}
+
+// SetOffset sets the offset, clamped between 0 and MaxOffset.
+func (w *Window) SetOffset(n int) {
+	w.Offset = clamp(n, 0, w.MaxOffset())
+}
+
+// ScrollBy moves the offset by n items. Negative values scroll up.
+func (w *Window) ScrollBy(n int) {
+	w.SetOffset(w.Offset + n)
+}
+
+// EnsureVisible scrolls the window by the least amount necessary for the item
+// at index i to be visible. Indices outside the collection are clamped.
+func (w *Window) EnsureVisible(i int) {
+	if w.Total == 0 {
+		w.Offset = 0
+		return
+	}
+	i = clamp(i, 0, w.Total-1)
+
+	switch {
+	case i < w.Offset:
+		w.Offset = i
+	case w.Size > 0 && i >= w.Offset+w.Size:
+		w.Offset = i - w.Size + 1
+	}
+	w.SetOffset(w.Offset)
+}
+
+// Visible returns the bounds of the visible items, suitable for slicing:
+//
+//	start, end := w.Visible()
+//	visible := items[start:end]
+//
+// Note that the offset isn't clamped to MaxOffset here, so if a window is
+// scrolled past the bottom fewer than Size items are returned.
+func (w Window) Visible() (start, end int) {
+	start = clamp(w.Offset, 0, w.Total)
+	end = clamp(w.Offset+w.Size, start, w.Total)
+	return start, end
+}
+
+// Rendered returns the bounds of the visible items extended by Overscan items
+// in both directions.
+func (w Window) Rendered() (start, end int) {
+	start, end = w.Visible()
+	start = max(0, start-w.Overscan)
+	end = min(w.Total, end+w.Overscan)
+	return start, end
+}
+
+// Contains returns whether the item at index i is visible.
+func (w Window) Contains(i int) bool {
+	start, end := w.Visible()
+	return i >= start && i < end
+}
+
+func clamp(v, low, high int) int {
+	if high < low {
+		low, high = high, low
+	}
+	return min(high, max(low, v))
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
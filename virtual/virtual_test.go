@@ -0,0 +1,54 @@
+package virtual
+
+import "testing"
+
+func TestEnsureVisible(t *testing.T) {
+	tests := []struct {
+		name   string
+		w      Window
+		index  int
+		offset int
+	}{
+		{"already visible", Window{Total: 100, Size: 10, Offset: 5}, 8, 5},
+		{"above", Window{Total: 100, Size: 10, Offset: 50}, 20, 20},
+		{"below", Window{Total: 100, Size: 10, Offset: 0}, 25, 16},
+		{"past the end", Window{Total: 100, Size: 10, Offset: 0}, 500, 90},
+		{"negative", Window{Total: 100, Size: 10, Offset: 40}, -3, 0},
+		{"fewer items than size", Window{Total: 3, Size: 10, Offset: 2}, 2, 0},
+		{"empty", Window{Total: 0, Size: 10, Offset: 4}, 0, 0},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			w := tc.w
+			w.EnsureVisible(tc.index)
+			if w.Offset != tc.offset {
+				t.Fatalf("expected offset %d, got %d", tc.offset, w.Offset)
+			}
+		})
+	}
+}
+
+func TestVisibleAndRendered(t *testing.T) {
+	w := Window{Total: 20, Size: 5, Offset: 10, Overscan: 2}
+
+	if start, end := w.Visible(); start != 10 || end != 15 {
+		t.Fatalf("expected visible range [10, 15), got [%d, %d)", start, end)
+	}
+	if start, end := w.Rendered(); start != 8 || end != 17 {
+		t.Fatalf("expected rendered range [8, 17), got [%d, %d)", start, end)
+	}
+
+	w.Offset = 18
+	if start, end := w.Visible(); start != 18 || end != 20 {
+		t.Fatalf("expected visible range [18, 20), got [%d, %d)", start, end)
+	}
+	if !w.AtBottom() {
+		t.Fatal("expected window to be at the bottom")
+	}
+
+	w.SetOffset(18)
+	if w.Offset != 15 {
+		t.Fatalf("expected offset to be clamped to 15, got %d", w.Offset)
+	}
+}